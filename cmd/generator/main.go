@@ -9,6 +9,7 @@ import (
 	"os/exec"
 	"path"
 	"strings"
+	"unicode"
 )
 
 var (
@@ -16,18 +17,22 @@ var (
 	pkg              string
 	elementTypes     string
 	accumulatorTypes string
+	mapTypes         string
+	keyTypes         string
 
 	config = map[string]func(elements []string, accumulators []string) string{
 		"iterator.go": func(elements []string, accumulators []string) string {
-			return fmt.Sprintf("Element=%s", strings.Join(elements, ","))
+			return fmt.Sprintf("Element=%s", strings.Join(withDerived(elements), ","))
 		},
 		"iterators.go": func(elements []string, accumulators []string) string {
-			return fmt.Sprintf("Element=%s", strings.Join(elements, ","))
+			return fmt.Sprintf("Element=%s", strings.Join(withDerived(elements), ","))
 		},
 		"option.go": func(elements []string, accumulators []string) string {
-			return fmt.Sprintf("Element=%s", strings.Join(append(elements, "uint"), ","))
+			return fmt.Sprintf("Element=%s", strings.Join(append(withResults(withDerived(elements)), "uint"), ","))
 		},
 		"folding.go": func(elements []string, accumulators []string) string {
+			elements = withDerived(elements)
+
 			types := append([]string{"uint", "Empty"}, elements...)
 			for _, element := range elements {
 				types = append(types, fmt.Sprintf("OptionFor%s", strings.Title(element)))
@@ -39,7 +44,49 @@ var (
 				strings.Join(removeDuplicates(append(accumulators, types...)), ","),
 			)
 		},
+		"parfold.go": func(elements []string, accumulators []string) string {
+			return fmt.Sprintf("Element=%s Accumulator=%s", strings.Join(withDerived(elements), ","), strings.Join(accumulators, ","))
+		},
+		"foldcontext.go": func(elements []string, accumulators []string) string {
+			return fmt.Sprintf("Element=%s Accumulator=%s", strings.Join(withDerived(elements), ","), strings.Join(accumulators, ","))
+		},
+		"unfold.go": func(elements []string, accumulators []string) string {
+			return fmt.Sprintf("Element=%s Accumulator=%s", strings.Join(withDerived(elements), ","), strings.Join(accumulators, ","))
+		},
 		"vector.go": func(elements []string, accumulators []string) string {
+			return fmt.Sprintf("Element=%s", strings.Join(withDerived(elements), ","))
+		},
+		"sources.go": func(elements []string, accumulators []string) string {
+			return fmt.Sprintf("Element=%s", strings.Join(withDerived(elements), ","))
+		},
+		"channel.go": func(elements []string, accumulators []string) string {
+			return fmt.Sprintf("Element=%s", strings.Join(withDerived(elements), ","))
+		},
+		"seq.go": func(elements []string, accumulators []string) string {
+			return fmt.Sprintf("Element=%s", strings.Join(withDerived(elements), ","))
+		},
+		"result.go": func(elements []string, accumulators []string) string {
+			return fmt.Sprintf("Element=%s", strings.Join(withDerived(elements), ","))
+		},
+		"try.go": func(elements []string, accumulators []string) string {
+			return fmt.Sprintf("Element=%s", strings.Join(withDerived(elements), ","))
+		},
+		"context.go": func(elements []string, accumulators []string) string {
+			return fmt.Sprintf("Element=%s", strings.Join(withDerived(elements), ","))
+		},
+		"parallel.go": func(elements []string, accumulators []string) string {
+			return fmt.Sprintf("Element=%s", strings.Join(withDerived(elements), ","))
+		},
+		"json.go": func(elements []string, accumulators []string) string {
+			return fmt.Sprintf("Element=%s", strings.Join(withDerived(elements), ","))
+		},
+		"writers.go": func(elements []string, accumulators []string) string {
+			return fmt.Sprintf("Element=%s", strings.Join(withDerived(elements), ","))
+		},
+		"sql.go": func(elements []string, accumulators []string) string {
+			return fmt.Sprintf("Element=%s", strings.Join(withDerived(elements), ","))
+		},
+		"chunks.go": func(elements []string, accumulators []string) string {
 			return fmt.Sprintf("Element=%s", strings.Join(elements, ","))
 		},
 	}
@@ -50,6 +97,8 @@ func main() {
 	flag.StringVar(&pkg, "pkg", "iter", "package name to be adopted by generated files")
 	flag.StringVar(&elementTypes, "items", "int", "comma separated types to create iterators for")
 	flag.StringVar(&accumulatorTypes, "accs", "int", "comma separated types to support folding over")
+	flag.StringVar(&keyTypes, "keys", "", "comma separated comparable types to support sets, maps and equality over")
+	flag.StringVar(&mapTypes, "maps", "", "comma separated key:value map types to create sources for")
 	flag.Parse()
 
 	in := path.Join(os.Getenv("GOPATH"), "src", "github.com", "juliendoutre", "go-iter", "pkg", "templates")
@@ -57,33 +106,164 @@ func main() {
 	elements := removeDuplicates(strings.Split(elementTypes, ","))
 	accumulators := removeDuplicates(strings.Split(accumulatorTypes, ","))
 
+	maps, err := parseMaps(mapTypes)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	keys := []string{}
+	if keyTypes != "" {
+		keys = strings.Split(keyTypes, ",")
+	}
+
+	for _, m := range maps {
+		keys = append(keys, m[0])
+		elements = append(elements, m[0], m[1], fmt.Sprintf("EntryFor%sTo%s", strings.Title(m[0]), strings.Title(m[1])))
+	}
+
+	keys = removeDuplicates(keys)
+	elements = removeDuplicates(append(elements, keys...))
+
 	for file, generateExpression := range config {
 		if err := genny(path.Join(in, file), path.Join(out, file), pkg, generateExpression(elements, accumulators)); err != nil {
 			log.Fatal(err)
 		}
 	}
 
-	for _, file := range []string{"types.go", "range.go"} {
+	for _, m := range maps {
+		file := fmt.Sprintf("maps_%s_%s.go", fileName(m[0]), fileName(m[1]))
+		if err := genny(path.Join(in, "maps.go"), path.Join(out, file), pkg, fmt.Sprintf("MapKey=%s MapValue=%s", m[0], m[1])); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	ordered, unordered := []string{}, []string{}
+	for _, m := range maps {
+		if isOrdered(m[0]) {
+			ordered = append(ordered, m[0])
+		} else {
+			unordered = append(unordered, m[0])
+		}
+	}
+
+	for file, types := range map[string][]string{"ordered.go": removeDuplicates(ordered), "unordered.go": removeDuplicates(unordered)} {
+		if len(types) == 0 {
+			continue
+		}
+
+		if err := genny(path.Join(in, file), path.Join(out, file), pkg, fmt.Sprintf("MapKey=%s", strings.Join(types, ","))); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if len(keys) > 0 {
+		if err := genny(path.Join(in, "collectors.go"), path.Join(out, "collectors.go"), pkg, fmt.Sprintf("Element=%s MapKey=%s", strings.Join(withDerived(elements), ","), strings.Join(keys, ","))); err != nil {
+			log.Fatal(err)
+		}
+
+		if err := genny(path.Join(in, "comparable.go"), path.Join(out, "comparable.go"), pkg, fmt.Sprintf("Element=%s", strings.Join(keys, ","))); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	files := []string{"types.go", "range.go", "jsonpointer.go"}
+	if contains(elements, "string") {
+		files = append(files, "io.go", "csv.go", "walk.go", "strings.go")
+	}
+
+	for _, file := range files {
 		if err := copy(path.Join(in, file), path.Join(out, file), pkg); err != nil {
 			log.Fatal(err)
 		}
 	}
 }
 
-func removeDuplicates(data []string) []string {
-	cache := map[string]struct{}{}
+func contains(data []string, value string) bool {
 	for _, entry := range data {
-		cache[entry] = struct{}{}
+		if entry == value {
+			return true
+		}
 	}
 
+	return false
+}
+
+// parseMaps parses comma separated key:value map types.
+func parseMaps(types string) ([][2]string, error) {
+	maps := [][2]string{}
+	if types == "" {
+		return maps, nil
+	}
+
+	for _, entry := range removeDuplicates(strings.Split(types, ",")) {
+		parts := strings.Split(entry, ":")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid map type %q, expected key:value", entry)
+		}
+
+		maps = append(maps, [2]string{parts[0], parts[1]})
+	}
+
+	return maps, nil
+}
+
+// isOrdered checks if a type supports the < operator.
+func isOrdered(t string) bool {
+	switch t {
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+		"float32", "float64", "byte", "rune", "string":
+		return true
+	default:
+		return false
+	}
+}
+
+// fileName keeps the letters and digits of a type to use it in a file name.
+func fileName(t string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+
+		return -1
+	}, t)
+}
+
+func removeDuplicates(data []string) []string {
+	cache := map[string]struct{}{}
+
 	output := []string{}
-	for key := range cache {
-		output = append(output, key)
+	for _, entry := range data {
+		if _, ok := cache[entry]; !ok {
+			cache[entry] = struct{}{}
+			output = append(output, entry)
+		}
 	}
 
 	return output
 }
 
+// withDerived adds to elements the types yielded by the generated adapters and sources.
+func withDerived(elements []string) []string {
+	types := withSlices(elements)
+	if contains(elements, "string") {
+		types = append(types, "CSVRecord")
+	}
+
+	return types
+}
+
+// withSlices adds the SliceOf types yielded by Chunks and Windows to elements.
+func withSlices(elements []string) []string {
+	types := append([]string{}, elements...)
+	for _, element := range elements {
+		types = append(types, fmt.Sprintf("SliceOf%s", strings.Title(element)))
+	}
+
+	return types
+}
+
 func genny(in, out, pkg, types string) error {
 	cmd := exec.Command("genny", "-in", in, "-out", out, "-pkg", pkg, "gen", types)
 	cmd.Stderr = os.Stderr
@@ -121,3 +301,13 @@ func copy(in, out, pkg string) error {
 
 	return scanner.Err()
 }
+
+// withResults adds the ResultFor types yielded by TryIterators to elements.
+func withResults(elements []string) []string {
+	types := append([]string{}, elements...)
+	for _, element := range elements {
+		types = append(types, fmt.Sprintf("ResultFor%s", strings.Title(element)))
+	}
+
+	return types
+}
@@ -0,0 +1,476 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package iter
+
+import "sync"
+
+// FromChannelInt builds an Iterator receiving its elements from a channel.
+// The Iterator ends when the channel is closed.
+func FromChannelInt(channel <-chan int) IteratorForInt {
+	return IteratorForInt{
+		iter: &channelForInt{channel: channel},
+	}
+}
+
+type channelForInt struct {
+	channel <-chan int
+}
+
+func (c *channelForInt) Next() OptionForInt {
+	item, ok := <-c.channel
+	if !ok {
+		return NoneInt()
+	}
+
+	return SomeInt(item)
+}
+
+func (c *channelForInt) SizeHint() (uint, OptionForUint) {
+	return uint(len(c.channel)), NoneUint()
+}
+
+var _ IterableForInt = &channelForInt{}
+
+// IntoChannel sends the elements of the Iterator to a channel from a new goroutine.
+// The channel and the Iterator are closed once it is exhausted or the returned cancel function is called.
+// Consumers stopping before the end must call cancel so that the goroutine does not leak.
+func (i IteratorForInt) IntoChannel(buffer int) (<-chan int, func()) {
+	channel := make(chan int, buffer)
+	done := make(chan Empty)
+
+	go func() {
+		defer close(channel)
+		defer i.Close()
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			item := i.Next()
+			if item.IsNone() {
+				return
+			}
+
+			select {
+			case channel <- item.Unwrap():
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+
+	return channel, func() {
+		once.Do(func() {
+			close(done)
+		})
+	}
+}
+
+// FromChannelString builds an Iterator receiving its elements from a channel.
+// The Iterator ends when the channel is closed.
+func FromChannelString(channel <-chan string) IteratorForString {
+	return IteratorForString{
+		iter: &channelForString{channel: channel},
+	}
+}
+
+type channelForString struct {
+	channel <-chan string
+}
+
+func (c *channelForString) Next() OptionForString {
+	item, ok := <-c.channel
+	if !ok {
+		return NoneString()
+	}
+
+	return SomeString(item)
+}
+
+func (c *channelForString) SizeHint() (uint, OptionForUint) {
+	return uint(len(c.channel)), NoneUint()
+}
+
+var _ IterableForString = &channelForString{}
+
+// IntoChannel sends the elements of the Iterator to a channel from a new goroutine.
+// The channel and the Iterator are closed once it is exhausted or the returned cancel function is called.
+// Consumers stopping before the end must call cancel so that the goroutine does not leak.
+func (i IteratorForString) IntoChannel(buffer int) (<-chan string, func()) {
+	channel := make(chan string, buffer)
+	done := make(chan Empty)
+
+	go func() {
+		defer close(channel)
+		defer i.Close()
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			item := i.Next()
+			if item.IsNone() {
+				return
+			}
+
+			select {
+			case channel <- item.Unwrap():
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+
+	return channel, func() {
+		once.Do(func() {
+			close(done)
+		})
+	}
+}
+
+// FromChannelEntryForStringToInt builds an Iterator receiving its elements from a channel.
+// The Iterator ends when the channel is closed.
+func FromChannelEntryForStringToInt(channel <-chan EntryForStringToInt) IteratorForEntryForStringToInt {
+	return IteratorForEntryForStringToInt{
+		iter: &channelForEntryForStringToInt{channel: channel},
+	}
+}
+
+type channelForEntryForStringToInt struct {
+	channel <-chan EntryForStringToInt
+}
+
+func (c *channelForEntryForStringToInt) Next() OptionForEntryForStringToInt {
+	item, ok := <-c.channel
+	if !ok {
+		return NoneEntryForStringToInt()
+	}
+
+	return SomeEntryForStringToInt(item)
+}
+
+func (c *channelForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	return uint(len(c.channel)), NoneUint()
+}
+
+var _ IterableForEntryForStringToInt = &channelForEntryForStringToInt{}
+
+// IntoChannel sends the elements of the Iterator to a channel from a new goroutine.
+// The channel and the Iterator are closed once it is exhausted or the returned cancel function is called.
+// Consumers stopping before the end must call cancel so that the goroutine does not leak.
+func (i IteratorForEntryForStringToInt) IntoChannel(buffer int) (<-chan EntryForStringToInt, func()) {
+	channel := make(chan EntryForStringToInt, buffer)
+	done := make(chan Empty)
+
+	go func() {
+		defer close(channel)
+		defer i.Close()
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			item := i.Next()
+			if item.IsNone() {
+				return
+			}
+
+			select {
+			case channel <- item.Unwrap():
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+
+	return channel, func() {
+		once.Do(func() {
+			close(done)
+		})
+	}
+}
+
+// FromChannelSliceOfInt builds an Iterator receiving its elements from a channel.
+// The Iterator ends when the channel is closed.
+func FromChannelSliceOfInt(channel <-chan SliceOfInt) IteratorForSliceOfInt {
+	return IteratorForSliceOfInt{
+		iter: &channelForSliceOfInt{channel: channel},
+	}
+}
+
+type channelForSliceOfInt struct {
+	channel <-chan SliceOfInt
+}
+
+func (c *channelForSliceOfInt) Next() OptionForSliceOfInt {
+	item, ok := <-c.channel
+	if !ok {
+		return NoneSliceOfInt()
+	}
+
+	return SomeSliceOfInt(item)
+}
+
+func (c *channelForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	return uint(len(c.channel)), NoneUint()
+}
+
+var _ IterableForSliceOfInt = &channelForSliceOfInt{}
+
+// IntoChannel sends the elements of the Iterator to a channel from a new goroutine.
+// The channel and the Iterator are closed once it is exhausted or the returned cancel function is called.
+// Consumers stopping before the end must call cancel so that the goroutine does not leak.
+func (i IteratorForSliceOfInt) IntoChannel(buffer int) (<-chan SliceOfInt, func()) {
+	channel := make(chan SliceOfInt, buffer)
+	done := make(chan Empty)
+
+	go func() {
+		defer close(channel)
+		defer i.Close()
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			item := i.Next()
+			if item.IsNone() {
+				return
+			}
+
+			select {
+			case channel <- item.Unwrap():
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+
+	return channel, func() {
+		once.Do(func() {
+			close(done)
+		})
+	}
+}
+
+// FromChannelSliceOfString builds an Iterator receiving its elements from a channel.
+// The Iterator ends when the channel is closed.
+func FromChannelSliceOfString(channel <-chan SliceOfString) IteratorForSliceOfString {
+	return IteratorForSliceOfString{
+		iter: &channelForSliceOfString{channel: channel},
+	}
+}
+
+type channelForSliceOfString struct {
+	channel <-chan SliceOfString
+}
+
+func (c *channelForSliceOfString) Next() OptionForSliceOfString {
+	item, ok := <-c.channel
+	if !ok {
+		return NoneSliceOfString()
+	}
+
+	return SomeSliceOfString(item)
+}
+
+func (c *channelForSliceOfString) SizeHint() (uint, OptionForUint) {
+	return uint(len(c.channel)), NoneUint()
+}
+
+var _ IterableForSliceOfString = &channelForSliceOfString{}
+
+// IntoChannel sends the elements of the Iterator to a channel from a new goroutine.
+// The channel and the Iterator are closed once it is exhausted or the returned cancel function is called.
+// Consumers stopping before the end must call cancel so that the goroutine does not leak.
+func (i IteratorForSliceOfString) IntoChannel(buffer int) (<-chan SliceOfString, func()) {
+	channel := make(chan SliceOfString, buffer)
+	done := make(chan Empty)
+
+	go func() {
+		defer close(channel)
+		defer i.Close()
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			item := i.Next()
+			if item.IsNone() {
+				return
+			}
+
+			select {
+			case channel <- item.Unwrap():
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+
+	return channel, func() {
+		once.Do(func() {
+			close(done)
+		})
+	}
+}
+
+// FromChannelSliceOfEntryForStringToInt builds an Iterator receiving its elements from a channel.
+// The Iterator ends when the channel is closed.
+func FromChannelSliceOfEntryForStringToInt(channel <-chan SliceOfEntryForStringToInt) IteratorForSliceOfEntryForStringToInt {
+	return IteratorForSliceOfEntryForStringToInt{
+		iter: &channelForSliceOfEntryForStringToInt{channel: channel},
+	}
+}
+
+type channelForSliceOfEntryForStringToInt struct {
+	channel <-chan SliceOfEntryForStringToInt
+}
+
+func (c *channelForSliceOfEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	item, ok := <-c.channel
+	if !ok {
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	return SomeSliceOfEntryForStringToInt(item)
+}
+
+func (c *channelForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	return uint(len(c.channel)), NoneUint()
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &channelForSliceOfEntryForStringToInt{}
+
+// IntoChannel sends the elements of the Iterator to a channel from a new goroutine.
+// The channel and the Iterator are closed once it is exhausted or the returned cancel function is called.
+// Consumers stopping before the end must call cancel so that the goroutine does not leak.
+func (i IteratorForSliceOfEntryForStringToInt) IntoChannel(buffer int) (<-chan SliceOfEntryForStringToInt, func()) {
+	channel := make(chan SliceOfEntryForStringToInt, buffer)
+	done := make(chan Empty)
+
+	go func() {
+		defer close(channel)
+		defer i.Close()
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			item := i.Next()
+			if item.IsNone() {
+				return
+			}
+
+			select {
+			case channel <- item.Unwrap():
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+
+	return channel, func() {
+		once.Do(func() {
+			close(done)
+		})
+	}
+}
+
+// FromChannelCSVRecord builds an Iterator receiving its elements from a channel.
+// The Iterator ends when the channel is closed.
+func FromChannelCSVRecord(channel <-chan CSVRecord) IteratorForCSVRecord {
+	return IteratorForCSVRecord{
+		iter: &channelForCSVRecord{channel: channel},
+	}
+}
+
+type channelForCSVRecord struct {
+	channel <-chan CSVRecord
+}
+
+func (c *channelForCSVRecord) Next() OptionForCSVRecord {
+	item, ok := <-c.channel
+	if !ok {
+		return NoneCSVRecord()
+	}
+
+	return SomeCSVRecord(item)
+}
+
+func (c *channelForCSVRecord) SizeHint() (uint, OptionForUint) {
+	return uint(len(c.channel)), NoneUint()
+}
+
+var _ IterableForCSVRecord = &channelForCSVRecord{}
+
+// IntoChannel sends the elements of the Iterator to a channel from a new goroutine.
+// The channel and the Iterator are closed once it is exhausted or the returned cancel function is called.
+// Consumers stopping before the end must call cancel so that the goroutine does not leak.
+func (i IteratorForCSVRecord) IntoChannel(buffer int) (<-chan CSVRecord, func()) {
+	channel := make(chan CSVRecord, buffer)
+	done := make(chan Empty)
+
+	go func() {
+		defer close(channel)
+		defer i.Close()
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			item := i.Next()
+			if item.IsNone() {
+				return
+			}
+
+			select {
+			case channel <- item.Unwrap():
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+
+	return channel, func() {
+		once.Do(func() {
+			close(done)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package iter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFromChannel(t *testing.T) {
+	channel := make(chan int, 3)
+	channel <- 0
+	channel <- 1
+	channel <- 2
+	close(channel)
+
+	got := FromChannelInt(channel).Map(func(item int) int {
+		return item * item
+	}).Collect()
+
+	want := []int{0, 1, 4}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; expected: %v", got, want)
+	}
+}
+
+func TestFromChannelTake(t *testing.T) {
+	channel := make(chan int, 3)
+	channel <- 0
+	channel <- 1
+	channel <- 2
+	close(channel)
+
+	got := FromChannelInt(channel).Take(2).Collect()
+
+	want := []int{0, 1}
+	if !reflect.DeepEqual(got, want) || <-channel != 2 {
+		t.Errorf("got: %v; expected: %v and the next message to be left in the channel", got, want)
+	}
+}
+
+func TestIntoChannel(t *testing.T) {
+	testCases := map[IteratorForInt][]int{
+		Range(0, 0, 1):                  {},
+		Range(0, 4, 1):                  {0, 1, 2, 3},
+		VectorOfInt([]int{0, 1, -2, 3}): {0, 1, -2, 3},
+	}
+
+	for iter, want := range testCases {
+		channel, cancel := iter.IntoChannel(2)
+
+		got := []int{}
+		for item := range channel {
+			got = append(got, item)
+		}
+
+		cancel()
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("case: %s; got: %v; expected: %v", iter, got, want)
+		}
+	}
+}
+
+func TestIntoChannelCancel(t *testing.T) {
+	channel, cancel := RepeatInt(1).IntoChannel(1)
+
+	if got := <-channel; got != 1 {
+		t.Errorf("got: %d; expected: %d", got, 1)
+	}
+
+	cancel()
+	cancel()
+
+	for range channel {
+	}
+}
+
+func TestChannelRoundTrip(t *testing.T) {
+	channel, cancel := Range(0, 10, 1).IntoChannel(0)
+	defer cancel()
+
+	got := FromChannelInt(channel).Filter(isEven).Collect()
+
+	want := []int{0, 2, 4, 6, 8}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; expected: %v", got, want)
+	}
+}
@@ -0,0 +1,749 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package iter
+
+// SliceOfInt is a batch of Ints yielded by Chunks and Windows.
+type SliceOfInt []int
+
+// Chunks returns a new Iterator yielding non-overlapping chunks of n elements.
+// The last chunk may be shorter. Chunks of a Vector are sub-slices of it.
+func (i IteratorForInt) Chunks(n uint) IteratorForSliceOfInt {
+	if n == 0 {
+		panic("Called `Chunks` with a zero size.")
+	}
+
+	return IteratorForSliceOfInt{iter: &chunksForInt{iter: i.iter, size: n, exact: false, flag: false}}
+}
+
+// ChunksExact returns a new Iterator yielding non-overlapping chunks of exactly n elements.
+// The elements left over are available through Remainder.
+func (i IteratorForInt) ChunksExact(n uint) ChunksExactForInt {
+	if n == 0 {
+		panic("Called `ChunksExact` with a zero size.")
+	}
+
+	chunks := &chunksForInt{iter: i.iter, size: n, exact: true, flag: false}
+
+	return ChunksExactForInt{IteratorForSliceOfInt: IteratorForSliceOfInt{iter: chunks}, chunks: chunks}
+}
+
+// Windows returns a new Iterator yielding overlapping windows of n elements.
+// Windows of a Vector are sub-slices of it.
+func (i IteratorForInt) Windows(n uint) IteratorForSliceOfInt {
+	if n == 0 {
+		panic("Called `Windows` with a zero size.")
+	}
+
+	return IteratorForSliceOfInt{iter: &windowsForInt{iter: i.iter, size: n, flag: false}}
+}
+
+// ChunksExactForInt is an Iterator over chunks of exactly n elements.
+type ChunksExactForInt struct {
+	IteratorForSliceOfInt
+	chunks *chunksForInt
+}
+
+// Remainder returns the last elements which did not fit in a chunk.
+// Unless the chunks are taken from a Vector, it is only known once they are all consumed.
+func (c ChunksExactForInt) Remainder() SliceOfInt {
+	if v, ok := c.chunks.iter.(*vectorForInt); ok && !c.chunks.flag {
+		remaining := uint(len(v.slice)) - v.cursor
+		return SliceOfInt(v.slice[uint(len(v.slice))-remaining%c.chunks.size : len(v.slice) : len(v.slice)])
+	}
+
+	return c.chunks.remainder
+}
+
+type chunksForInt struct {
+	iter      IterableForInt
+	size      uint
+	exact     bool
+	remainder SliceOfInt
+	flag      bool
+}
+
+func (c *chunksForInt) Next() OptionForSliceOfInt {
+	if c.flag {
+		return NoneSliceOfInt()
+	}
+
+	if v, ok := c.iter.(*vectorForInt); ok {
+		return c.nextFromVector(v)
+	}
+
+	chunk := make(SliceOfInt, 0, c.size)
+	for uint(len(chunk)) < c.size {
+		item := c.iter.Next()
+		if item.IsNone() {
+			break
+		}
+
+		chunk = append(chunk, item.Unwrap())
+	}
+
+	if uint(len(chunk)) < c.size {
+		c.flag = true
+
+		if c.exact {
+			c.remainder = chunk
+			return NoneSliceOfInt()
+		}
+
+		if len(chunk) == 0 {
+			return NoneSliceOfInt()
+		}
+	}
+
+	return SomeSliceOfInt(chunk)
+}
+
+func (c *chunksForInt) nextFromVector(v *vectorForInt) OptionForSliceOfInt {
+	start := v.cursor
+	remaining := uint(len(v.slice)) - start
+
+	if remaining < c.size {
+		c.flag = true
+		v.cursor = uint(len(v.slice))
+
+		if c.exact {
+			c.remainder = SliceOfInt(v.slice[start:len(v.slice):len(v.slice)])
+			return NoneSliceOfInt()
+		}
+
+		if remaining == 0 {
+			return NoneSliceOfInt()
+		}
+
+		return SomeSliceOfInt(SliceOfInt(v.slice[start:len(v.slice):len(v.slice)]))
+	}
+
+	v.cursor += c.size
+
+	return SomeSliceOfInt(SliceOfInt(v.slice[start:v.cursor:v.cursor]))
+}
+
+func (c *chunksForInt) SizeHint() (uint, OptionForUint) {
+	if c.flag {
+		return 0, SomeUint(0)
+	}
+
+	lower, upper := c.iter.SizeHint()
+	if upper.IsNone() {
+		return c.count(lower), NoneUint()
+	}
+
+	return c.count(lower), SomeUint(c.count(upper.Unwrap()))
+}
+
+func (c *chunksForInt) Err() error {
+	return errOf(c.iter)
+}
+
+func (c *chunksForInt) Close() error {
+	return closeOf(c.iter)
+}
+
+func (c *chunksForInt) count(n uint) uint {
+	count := n / c.size
+	if !c.exact && n%c.size != 0 {
+		count++
+	}
+
+	return count
+}
+
+var _ IterableForSliceOfInt = &chunksForInt{}
+
+type windowsForInt struct {
+	iter   IterableForInt
+	size   uint
+	window SliceOfInt
+	flag   bool
+}
+
+func (w *windowsForInt) Next() OptionForSliceOfInt {
+	if w.flag {
+		return NoneSliceOfInt()
+	}
+
+	if v, ok := w.iter.(*vectorForInt); ok {
+		return w.nextFromVector(v)
+	}
+
+	if w.window == nil {
+		window := make(SliceOfInt, 0, w.size)
+		for uint(len(window)) < w.size {
+			item := w.iter.Next()
+			if item.IsNone() {
+				w.flag = true
+				return NoneSliceOfInt()
+			}
+
+			window = append(window, item.Unwrap())
+		}
+
+		w.window = window
+
+		return SomeSliceOfInt(window)
+	}
+
+	item := w.iter.Next()
+	if item.IsNone() {
+		w.flag = true
+		return NoneSliceOfInt()
+	}
+
+	window := make(SliceOfInt, w.size)
+	copy(window, w.window[1:])
+	window[w.size-1] = item.Unwrap()
+	w.window = window
+
+	return SomeSliceOfInt(window)
+}
+
+func (w *windowsForInt) nextFromVector(v *vectorForInt) OptionForSliceOfInt {
+	start := v.cursor
+	if uint(len(v.slice))-start < w.size {
+		w.flag = true
+		v.cursor = uint(len(v.slice))
+
+		return NoneSliceOfInt()
+	}
+
+	v.cursor++
+
+	return SomeSliceOfInt(SliceOfInt(v.slice[start : start+w.size : start+w.size]))
+}
+
+func (w *windowsForInt) SizeHint() (uint, OptionForUint) {
+	if w.flag {
+		return 0, SomeUint(0)
+	}
+
+	lower, upper := w.iter.SizeHint()
+	if upper.IsNone() {
+		return w.count(lower), NoneUint()
+	}
+
+	return w.count(lower), SomeUint(w.count(upper.Unwrap()))
+}
+
+func (w *windowsForInt) Err() error {
+	return errOf(w.iter)
+}
+
+func (w *windowsForInt) Close() error {
+	return closeOf(w.iter)
+}
+
+func (w *windowsForInt) count(n uint) uint {
+	if w.window != nil {
+		return n
+	}
+
+	if n < w.size {
+		return 0
+	}
+
+	return n - w.size + 1
+}
+
+var _ IterableForSliceOfInt = &windowsForInt{}
+
+// SliceOfString is a batch of Strings yielded by Chunks and Windows.
+type SliceOfString []string
+
+// Chunks returns a new Iterator yielding non-overlapping chunks of n elements.
+// The last chunk may be shorter. Chunks of a Vector are sub-slices of it.
+func (i IteratorForString) Chunks(n uint) IteratorForSliceOfString {
+	if n == 0 {
+		panic("Called `Chunks` with a zero size.")
+	}
+
+	return IteratorForSliceOfString{iter: &chunksForString{iter: i.iter, size: n, exact: false, flag: false}}
+}
+
+// ChunksExact returns a new Iterator yielding non-overlapping chunks of exactly n elements.
+// The elements left over are available through Remainder.
+func (i IteratorForString) ChunksExact(n uint) ChunksExactForString {
+	if n == 0 {
+		panic("Called `ChunksExact` with a zero size.")
+	}
+
+	chunks := &chunksForString{iter: i.iter, size: n, exact: true, flag: false}
+
+	return ChunksExactForString{IteratorForSliceOfString: IteratorForSliceOfString{iter: chunks}, chunks: chunks}
+}
+
+// Windows returns a new Iterator yielding overlapping windows of n elements.
+// Windows of a Vector are sub-slices of it.
+func (i IteratorForString) Windows(n uint) IteratorForSliceOfString {
+	if n == 0 {
+		panic("Called `Windows` with a zero size.")
+	}
+
+	return IteratorForSliceOfString{iter: &windowsForString{iter: i.iter, size: n, flag: false}}
+}
+
+// ChunksExactForString is an Iterator over chunks of exactly n elements.
+type ChunksExactForString struct {
+	IteratorForSliceOfString
+	chunks *chunksForString
+}
+
+// Remainder returns the last elements which did not fit in a chunk.
+// Unless the chunks are taken from a Vector, it is only known once they are all consumed.
+func (c ChunksExactForString) Remainder() SliceOfString {
+	if v, ok := c.chunks.iter.(*vectorForString); ok && !c.chunks.flag {
+		remaining := uint(len(v.slice)) - v.cursor
+		return SliceOfString(v.slice[uint(len(v.slice))-remaining%c.chunks.size : len(v.slice) : len(v.slice)])
+	}
+
+	return c.chunks.remainder
+}
+
+type chunksForString struct {
+	iter      IterableForString
+	size      uint
+	exact     bool
+	remainder SliceOfString
+	flag      bool
+}
+
+func (c *chunksForString) Next() OptionForSliceOfString {
+	if c.flag {
+		return NoneSliceOfString()
+	}
+
+	if v, ok := c.iter.(*vectorForString); ok {
+		return c.nextFromVector(v)
+	}
+
+	chunk := make(SliceOfString, 0, c.size)
+	for uint(len(chunk)) < c.size {
+		item := c.iter.Next()
+		if item.IsNone() {
+			break
+		}
+
+		chunk = append(chunk, item.Unwrap())
+	}
+
+	if uint(len(chunk)) < c.size {
+		c.flag = true
+
+		if c.exact {
+			c.remainder = chunk
+			return NoneSliceOfString()
+		}
+
+		if len(chunk) == 0 {
+			return NoneSliceOfString()
+		}
+	}
+
+	return SomeSliceOfString(chunk)
+}
+
+func (c *chunksForString) nextFromVector(v *vectorForString) OptionForSliceOfString {
+	start := v.cursor
+	remaining := uint(len(v.slice)) - start
+
+	if remaining < c.size {
+		c.flag = true
+		v.cursor = uint(len(v.slice))
+
+		if c.exact {
+			c.remainder = SliceOfString(v.slice[start:len(v.slice):len(v.slice)])
+			return NoneSliceOfString()
+		}
+
+		if remaining == 0 {
+			return NoneSliceOfString()
+		}
+
+		return SomeSliceOfString(SliceOfString(v.slice[start:len(v.slice):len(v.slice)]))
+	}
+
+	v.cursor += c.size
+
+	return SomeSliceOfString(SliceOfString(v.slice[start:v.cursor:v.cursor]))
+}
+
+func (c *chunksForString) SizeHint() (uint, OptionForUint) {
+	if c.flag {
+		return 0, SomeUint(0)
+	}
+
+	lower, upper := c.iter.SizeHint()
+	if upper.IsNone() {
+		return c.count(lower), NoneUint()
+	}
+
+	return c.count(lower), SomeUint(c.count(upper.Unwrap()))
+}
+
+func (c *chunksForString) Err() error {
+	return errOf(c.iter)
+}
+
+func (c *chunksForString) Close() error {
+	return closeOf(c.iter)
+}
+
+func (c *chunksForString) count(n uint) uint {
+	count := n / c.size
+	if !c.exact && n%c.size != 0 {
+		count++
+	}
+
+	return count
+}
+
+var _ IterableForSliceOfString = &chunksForString{}
+
+type windowsForString struct {
+	iter   IterableForString
+	size   uint
+	window SliceOfString
+	flag   bool
+}
+
+func (w *windowsForString) Next() OptionForSliceOfString {
+	if w.flag {
+		return NoneSliceOfString()
+	}
+
+	if v, ok := w.iter.(*vectorForString); ok {
+		return w.nextFromVector(v)
+	}
+
+	if w.window == nil {
+		window := make(SliceOfString, 0, w.size)
+		for uint(len(window)) < w.size {
+			item := w.iter.Next()
+			if item.IsNone() {
+				w.flag = true
+				return NoneSliceOfString()
+			}
+
+			window = append(window, item.Unwrap())
+		}
+
+		w.window = window
+
+		return SomeSliceOfString(window)
+	}
+
+	item := w.iter.Next()
+	if item.IsNone() {
+		w.flag = true
+		return NoneSliceOfString()
+	}
+
+	window := make(SliceOfString, w.size)
+	copy(window, w.window[1:])
+	window[w.size-1] = item.Unwrap()
+	w.window = window
+
+	return SomeSliceOfString(window)
+}
+
+func (w *windowsForString) nextFromVector(v *vectorForString) OptionForSliceOfString {
+	start := v.cursor
+	if uint(len(v.slice))-start < w.size {
+		w.flag = true
+		v.cursor = uint(len(v.slice))
+
+		return NoneSliceOfString()
+	}
+
+	v.cursor++
+
+	return SomeSliceOfString(SliceOfString(v.slice[start : start+w.size : start+w.size]))
+}
+
+func (w *windowsForString) SizeHint() (uint, OptionForUint) {
+	if w.flag {
+		return 0, SomeUint(0)
+	}
+
+	lower, upper := w.iter.SizeHint()
+	if upper.IsNone() {
+		return w.count(lower), NoneUint()
+	}
+
+	return w.count(lower), SomeUint(w.count(upper.Unwrap()))
+}
+
+func (w *windowsForString) Err() error {
+	return errOf(w.iter)
+}
+
+func (w *windowsForString) Close() error {
+	return closeOf(w.iter)
+}
+
+func (w *windowsForString) count(n uint) uint {
+	if w.window != nil {
+		return n
+	}
+
+	if n < w.size {
+		return 0
+	}
+
+	return n - w.size + 1
+}
+
+var _ IterableForSliceOfString = &windowsForString{}
+
+// SliceOfEntryForStringToInt is a batch of EntryForStringToInts yielded by Chunks and Windows.
+type SliceOfEntryForStringToInt []EntryForStringToInt
+
+// Chunks returns a new Iterator yielding non-overlapping chunks of n elements.
+// The last chunk may be shorter. Chunks of a Vector are sub-slices of it.
+func (i IteratorForEntryForStringToInt) Chunks(n uint) IteratorForSliceOfEntryForStringToInt {
+	if n == 0 {
+		panic("Called `Chunks` with a zero size.")
+	}
+
+	return IteratorForSliceOfEntryForStringToInt{iter: &chunksForEntryForStringToInt{iter: i.iter, size: n, exact: false, flag: false}}
+}
+
+// ChunksExact returns a new Iterator yielding non-overlapping chunks of exactly n elements.
+// The elements left over are available through Remainder.
+func (i IteratorForEntryForStringToInt) ChunksExact(n uint) ChunksExactForEntryForStringToInt {
+	if n == 0 {
+		panic("Called `ChunksExact` with a zero size.")
+	}
+
+	chunks := &chunksForEntryForStringToInt{iter: i.iter, size: n, exact: true, flag: false}
+
+	return ChunksExactForEntryForStringToInt{IteratorForSliceOfEntryForStringToInt: IteratorForSliceOfEntryForStringToInt{iter: chunks}, chunks: chunks}
+}
+
+// Windows returns a new Iterator yielding overlapping windows of n elements.
+// Windows of a Vector are sub-slices of it.
+func (i IteratorForEntryForStringToInt) Windows(n uint) IteratorForSliceOfEntryForStringToInt {
+	if n == 0 {
+		panic("Called `Windows` with a zero size.")
+	}
+
+	return IteratorForSliceOfEntryForStringToInt{iter: &windowsForEntryForStringToInt{iter: i.iter, size: n, flag: false}}
+}
+
+// ChunksExactForEntryForStringToInt is an Iterator over chunks of exactly n elements.
+type ChunksExactForEntryForStringToInt struct {
+	IteratorForSliceOfEntryForStringToInt
+	chunks *chunksForEntryForStringToInt
+}
+
+// Remainder returns the last elements which did not fit in a chunk.
+// Unless the chunks are taken from a Vector, it is only known once they are all consumed.
+func (c ChunksExactForEntryForStringToInt) Remainder() SliceOfEntryForStringToInt {
+	if v, ok := c.chunks.iter.(*vectorForEntryForStringToInt); ok && !c.chunks.flag {
+		remaining := uint(len(v.slice)) - v.cursor
+		return SliceOfEntryForStringToInt(v.slice[uint(len(v.slice))-remaining%c.chunks.size : len(v.slice) : len(v.slice)])
+	}
+
+	return c.chunks.remainder
+}
+
+type chunksForEntryForStringToInt struct {
+	iter      IterableForEntryForStringToInt
+	size      uint
+	exact     bool
+	remainder SliceOfEntryForStringToInt
+	flag      bool
+}
+
+func (c *chunksForEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	if c.flag {
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	if v, ok := c.iter.(*vectorForEntryForStringToInt); ok {
+		return c.nextFromVector(v)
+	}
+
+	chunk := make(SliceOfEntryForStringToInt, 0, c.size)
+	for uint(len(chunk)) < c.size {
+		item := c.iter.Next()
+		if item.IsNone() {
+			break
+		}
+
+		chunk = append(chunk, item.Unwrap())
+	}
+
+	if uint(len(chunk)) < c.size {
+		c.flag = true
+
+		if c.exact {
+			c.remainder = chunk
+			return NoneSliceOfEntryForStringToInt()
+		}
+
+		if len(chunk) == 0 {
+			return NoneSliceOfEntryForStringToInt()
+		}
+	}
+
+	return SomeSliceOfEntryForStringToInt(chunk)
+}
+
+func (c *chunksForEntryForStringToInt) nextFromVector(v *vectorForEntryForStringToInt) OptionForSliceOfEntryForStringToInt {
+	start := v.cursor
+	remaining := uint(len(v.slice)) - start
+
+	if remaining < c.size {
+		c.flag = true
+		v.cursor = uint(len(v.slice))
+
+		if c.exact {
+			c.remainder = SliceOfEntryForStringToInt(v.slice[start:len(v.slice):len(v.slice)])
+			return NoneSliceOfEntryForStringToInt()
+		}
+
+		if remaining == 0 {
+			return NoneSliceOfEntryForStringToInt()
+		}
+
+		return SomeSliceOfEntryForStringToInt(SliceOfEntryForStringToInt(v.slice[start:len(v.slice):len(v.slice)]))
+	}
+
+	v.cursor += c.size
+
+	return SomeSliceOfEntryForStringToInt(SliceOfEntryForStringToInt(v.slice[start:v.cursor:v.cursor]))
+}
+
+func (c *chunksForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if c.flag {
+		return 0, SomeUint(0)
+	}
+
+	lower, upper := c.iter.SizeHint()
+	if upper.IsNone() {
+		return c.count(lower), NoneUint()
+	}
+
+	return c.count(lower), SomeUint(c.count(upper.Unwrap()))
+}
+
+func (c *chunksForEntryForStringToInt) Err() error {
+	return errOf(c.iter)
+}
+
+func (c *chunksForEntryForStringToInt) Close() error {
+	return closeOf(c.iter)
+}
+
+func (c *chunksForEntryForStringToInt) count(n uint) uint {
+	count := n / c.size
+	if !c.exact && n%c.size != 0 {
+		count++
+	}
+
+	return count
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &chunksForEntryForStringToInt{}
+
+type windowsForEntryForStringToInt struct {
+	iter   IterableForEntryForStringToInt
+	size   uint
+	window SliceOfEntryForStringToInt
+	flag   bool
+}
+
+func (w *windowsForEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	if w.flag {
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	if v, ok := w.iter.(*vectorForEntryForStringToInt); ok {
+		return w.nextFromVector(v)
+	}
+
+	if w.window == nil {
+		window := make(SliceOfEntryForStringToInt, 0, w.size)
+		for uint(len(window)) < w.size {
+			item := w.iter.Next()
+			if item.IsNone() {
+				w.flag = true
+				return NoneSliceOfEntryForStringToInt()
+			}
+
+			window = append(window, item.Unwrap())
+		}
+
+		w.window = window
+
+		return SomeSliceOfEntryForStringToInt(window)
+	}
+
+	item := w.iter.Next()
+	if item.IsNone() {
+		w.flag = true
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	window := make(SliceOfEntryForStringToInt, w.size)
+	copy(window, w.window[1:])
+	window[w.size-1] = item.Unwrap()
+	w.window = window
+
+	return SomeSliceOfEntryForStringToInt(window)
+}
+
+func (w *windowsForEntryForStringToInt) nextFromVector(v *vectorForEntryForStringToInt) OptionForSliceOfEntryForStringToInt {
+	start := v.cursor
+	if uint(len(v.slice))-start < w.size {
+		w.flag = true
+		v.cursor = uint(len(v.slice))
+
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	v.cursor++
+
+	return SomeSliceOfEntryForStringToInt(SliceOfEntryForStringToInt(v.slice[start : start+w.size : start+w.size]))
+}
+
+func (w *windowsForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if w.flag {
+		return 0, SomeUint(0)
+	}
+
+	lower, upper := w.iter.SizeHint()
+	if upper.IsNone() {
+		return w.count(lower), NoneUint()
+	}
+
+	return w.count(lower), SomeUint(w.count(upper.Unwrap()))
+}
+
+func (w *windowsForEntryForStringToInt) Err() error {
+	return errOf(w.iter)
+}
+
+func (w *windowsForEntryForStringToInt) Close() error {
+	return closeOf(w.iter)
+}
+
+func (w *windowsForEntryForStringToInt) count(n uint) uint {
+	if w.window != nil {
+		return n
+	}
+
+	if n < w.size {
+		return 0
+	}
+
+	return n - w.size + 1
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &windowsForEntryForStringToInt{}
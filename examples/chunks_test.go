@@ -0,0 +1,132 @@
+package iter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChunks(t *testing.T) {
+	testCases := map[IteratorForInt][]SliceOfInt{
+		VectorOfInt([]int{}):               {},
+		VectorOfInt([]int{0}):              {{0}},
+		VectorOfInt([]int{0, 1, 2, 3}):     {{0, 1}, {2, 3}},
+		VectorOfInt([]int{0, 1, -2, 3, 4}): {{0, 1}, {-2, 3}, {4}},
+		Range(0, 0, 1):                     {},
+		Range(0, 4, 1):                     {{0, 1}, {2, 3}},
+		Range(0, 5, 1):                     {{0, 1}, {2, 3}, {4}},
+		Range(0, 5, 1).Filter(isEven):      {{0, 2}, {4}},
+	}
+
+	for iter, want := range testCases {
+		got := iter.Chunks(2).Collect()
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("case: %s; got: %v; expected: %v", iter, got, want)
+		}
+	}
+}
+
+func TestChunksExact(t *testing.T) {
+	type result struct {
+		chunks    []SliceOfInt
+		remainder SliceOfInt
+	}
+
+	testCases := map[IteratorForInt]result{
+		VectorOfInt([]int{}):               {chunks: []SliceOfInt{}, remainder: SliceOfInt{}},
+		VectorOfInt([]int{0}):              {chunks: []SliceOfInt{}, remainder: SliceOfInt{0}},
+		VectorOfInt([]int{0, 1, 2, 3}):     {chunks: []SliceOfInt{{0, 1}, {2, 3}}, remainder: SliceOfInt{}},
+		VectorOfInt([]int{0, 1, -2, 3, 4}): {chunks: []SliceOfInt{{0, 1}, {-2, 3}}, remainder: SliceOfInt{4}},
+		Range(0, 0, 1):                     {chunks: []SliceOfInt{}, remainder: SliceOfInt{}},
+		Range(0, 4, 1):                     {chunks: []SliceOfInt{{0, 1}, {2, 3}}, remainder: SliceOfInt{}},
+		Range(0, 5, 1):                     {chunks: []SliceOfInt{{0, 1}, {2, 3}}, remainder: SliceOfInt{4}},
+	}
+
+	for iter, want := range testCases {
+		chunks := iter.ChunksExact(2)
+		got := result{chunks: chunks.Collect(), remainder: chunks.Remainder()}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("case: %s; got: %v; expected: %v", iter, got, want)
+		}
+	}
+}
+
+func TestChunksExactRemainderOfVector(t *testing.T) {
+	got := VectorOfInt([]int{0, 1, 2, 3, 4}).ChunksExact(2).Remainder()
+	want := SliceOfInt{4}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; expected: %v", got, want)
+	}
+}
+
+func TestWindows(t *testing.T) {
+	testCases := map[IteratorForInt][]SliceOfInt{
+		VectorOfInt([]int{}):               {},
+		VectorOfInt([]int{0, 1}):           {},
+		VectorOfInt([]int{0, 1, 2}):        {{0, 1, 2}},
+		VectorOfInt([]int{0, 1, -2, 3, 4}): {{0, 1, -2}, {1, -2, 3}, {-2, 3, 4}},
+		Range(0, 2, 1):                     {},
+		Range(0, 5, 1):                     {{0, 1, 2}, {1, 2, 3}, {2, 3, 4}},
+		Range(0, 9, 1).Filter(isEven):      {{0, 2, 4}, {2, 4, 6}, {4, 6, 8}},
+	}
+
+	for iter, want := range testCases {
+		got := iter.Windows(3).Collect()
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("case: %s; got: %v; expected: %v", iter, got, want)
+		}
+	}
+}
+
+func TestChunksAndWindowsSizeHint(t *testing.T) {
+	testCases := map[IteratorForSliceOfInt]uint{
+		Range(0, 0, 1).Chunks(2):                  0,
+		Range(0, 5, 1).Chunks(2):                  3,
+		Range(0, 5, 1).ChunksExact(2).Skip(1):     1,
+		VectorOfInt([]int{0, 1, 2, 3}).Chunks(2):  2,
+		Range(0, 2, 1).Windows(3):                 0,
+		Range(0, 5, 1).Windows(3).Skip(1):         2,
+		VectorOfInt([]int{0, 1, 2, 3}).Windows(2): 3,
+	}
+
+	for iter, want := range testCases {
+		lower, upper := iter.SizeHint()
+
+		if lower != want || !reflect.DeepEqual(upper, SomeUint(want)) {
+			t.Errorf("case: %s; got: (%d, %v); expected: (%d, %v)", iter, lower, upper, want, SomeUint(want))
+		}
+	}
+}
+
+func TestChunksOfVectorDoNotCopy(t *testing.T) {
+	slice := []int{0, 1, 2, 3, 4}
+
+	chunks := VectorOfInt(slice).Chunks(2).Collect()
+	windows := VectorOfInt(slice).Windows(2).Collect()
+
+	if &chunks[1][0] != &slice[2] || &windows[3][0] != &slice[3] {
+		t.Errorf("chunks and windows of a Vector should share its underlying slice")
+	}
+
+	big := []int{0, 1, 2, 3, 4, 99}
+
+	last := VectorOfInt(big[:5]).Chunks(2).Last().Unwrap()
+	_ = append(last, 7)
+
+	exact := VectorOfInt(big[:5]).ChunksExact(2)
+	_ = append(exact.Remainder(), 8)
+
+	exact.Collect()
+	_ = append(exact.Remainder(), 9)
+
+	if big[5] != 99 {
+		t.Errorf("got: %d; expected: %d, appending to chunks of a Vector should not overwrite elements past it", big[5], 99)
+	}
+}
+
+func isEven(item int) bool {
+	return item%2 == 0
+}
@@ -0,0 +1,73 @@
+package iter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestClone(t *testing.T) {
+	testCases := map[IteratorForInt][]int{
+		VectorOfInt([]int{0, 1, -2, 3}):                    {1, -2, 3},
+		Range(0, 5, 2):                                     {2, 4},
+		Range(0, 4, 1).Map(square):                         {1, 4, 9},
+		Range(0, 10, 1).Take(3):                            {1, 2},
+		Range(0, 10, 1).Filter(isEven):                     {2, 4, 6, 8},
+		VectorOfInt([]int{0}).Chain(Range(1, 3, 1)):        {1, 2},
+		Range(0, 10, 1).Filter(isEven).Map(square).Take(3): {4, 16},
+	}
+
+	for iter, want := range testCases {
+		iter.Next()
+
+		clone, ok := iter.Clone()
+		if !ok {
+			t.Errorf("case: %s; should be cloneable", iter)
+			continue
+		}
+
+		got := clone.Collect()
+		original := iter.Collect()
+
+		if !reflect.DeepEqual(got, want) || !reflect.DeepEqual(original, want) {
+			t.Errorf("case: %s; got: (%v, %v); expected: (%v, %v)", iter, got, original, want, want)
+		}
+	}
+}
+
+func TestCloneNotCloneable(t *testing.T) {
+	testCases := []IteratorForInt{
+		RepeatInt(1),
+		Range(0, 4, 1).Cycle(),
+		Range(0, 4, 1).Chain(RepeatInt(1)),
+		RepeatNInt(1, 3).Map(square),
+	}
+
+	for _, iter := range testCases {
+		if _, ok := iter.Clone(); ok {
+			t.Errorf("case: %s; should not be cloneable", iter)
+		}
+	}
+}
+
+func TestReset(t *testing.T) {
+	testCases := map[IteratorForInt][]int{
+		VectorOfInt([]int{0, 1, -2, 3}): {0, 1, -2, 3},
+		Range(0, 5, 2):                  {0, 2, 4},
+	}
+
+	for iter, want := range testCases {
+		iter.Skip(2).Collect()
+
+		if !iter.Reset() {
+			t.Errorf("case: %s; should be resettable", iter)
+		}
+
+		if got := iter.Collect(); !reflect.DeepEqual(got, want) {
+			t.Errorf("case: %s; got: %v; expected: %v", iter, got, want)
+		}
+	}
+
+	if Range(0, 5, 2).Map(square).Reset() {
+		t.Errorf("adapters should not be resettable")
+	}
+}
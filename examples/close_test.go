@@ -0,0 +1,182 @@
+package iter
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+type closeableInts struct {
+	items  []int
+	cursor int
+	closes int
+}
+
+func (c *closeableInts) Next() OptionForInt {
+	if c.cursor >= len(c.items) {
+		return NoneInt()
+	}
+
+	c.cursor++
+
+	return SomeInt(c.items[c.cursor-1])
+}
+
+func (c *closeableInts) SizeHint() (uint, OptionForUint) {
+	return 0, NoneUint()
+}
+
+func (c *closeableInts) Close() error {
+	c.closes++
+	return nil
+}
+
+func TestTerminalOperationsClose(t *testing.T) {
+	testCases := map[string]func(iter IteratorForInt){
+		"Collect":   func(iter IteratorForInt) { iter.Collect() },
+		"Count":     func(iter IteratorForInt) { iter.Count() },
+		"Last":      func(iter IteratorForInt) { iter.Last() },
+		"FoldFirst": func(iter IteratorForInt) { iter.FoldFirst(func(acc, item int) int { return acc + item }) },
+		"Find":      func(iter IteratorForInt) { iter.Find(isEven) },
+		"Any":       func(iter IteratorForInt) { iter.Any(isEven) },
+		"All":       func(iter IteratorForInt) { iter.All(isEven) },
+		"Position":  func(iter IteratorForInt) { iter.Position(isEven) },
+		"ForEach":   func(iter IteratorForInt) { iter.ForEach(func(item int) {}) },
+		"Take":      func(iter IteratorForInt) { iter.Take(1).Collect() },
+		"TakeWhile": func(iter IteratorForInt) { iter.TakeWhile(isEven).Collect() },
+		"Filter":    func(iter IteratorForInt) { iter.Filter(isEven).Count() },
+		"Map":       func(iter IteratorForInt) { iter.Map(square).Find(isEven) },
+		"Chain":     func(iter IteratorForInt) { OnceInt(0).Chain(iter).Collect() },
+		"Cycle":     func(iter IteratorForInt) { iter.Cycle().Take(10).Collect() },
+		"Chunks":    func(iter IteratorForInt) { iter.Chunks(2).Collect() },
+		"Windows":   func(iter IteratorForInt) { iter.Windows(2).Collect() },
+		"Tee": func(iter IteratorForInt) {
+			branches := iter.Tee(2)
+			branches[0].Collect()
+			branches[1].Take(1).Collect()
+		},
+		"Tee with no branch": func(iter IteratorForInt) { iter.Tee(0) },
+		"Seq": func(iter IteratorForInt) {
+			for range iter.Seq() {
+				break
+			}
+		},
+		"IntoChannel": func(iter IteratorForInt) {
+			channel, _ := iter.IntoChannel(0)
+			for range channel {
+			}
+		},
+		"CollectContext": func(iter IteratorForInt) { iter.CollectContext(context.Background()) },
+		"ParMap":         func(iter IteratorForInt) { iter.ParMap(2, square).Take(1).Collect() },
+		"ParFold": func(iter IteratorForInt) {
+			iter.ParFoldForInt(0, func(acc, item int) int { return acc + item }, func(left, right int) int { return left + right }, 2)
+		},
+		"Synchronized": func(iter IteratorForInt) { iter.Synchronized().Collect() },
+		"Synchronized with concurrent consumers": func(iter IteratorForInt) {
+			shared := iter.Synchronized()
+
+			var wg sync.WaitGroup
+			wg.Add(2)
+
+			go func() {
+				defer wg.Done()
+				shared.Find(func(item int) bool { return item == 3 })
+			}()
+
+			go func() {
+				defer wg.Done()
+				shared.Count()
+			}()
+
+			wg.Wait()
+		},
+		"Share": func(iter IteratorForInt) {
+			shared := iter.Share(2)
+			shared[0].Find(isEven)
+			shared[1].Take(1).Collect()
+		},
+		"Share with no consumer": func(iter IteratorForInt) { iter.Share(0) },
+	}
+
+	for name, operation := range testCases {
+		source := &closeableInts{items: []int{0, 1, 2, 3}}
+		operation(FromIterableInt(source))
+
+		if source.closes != 1 {
+			t.Errorf("case: %s; got: %d closes; expected: %d", name, source.closes, 1)
+		}
+	}
+}
+
+func TestShareClosesOnce(t *testing.T) {
+	source := &closeableInts{items: []int{0, 1, 2, 3}}
+	shared := FromIterableInt(source).Share(2)
+
+	shared[0].Find(isEven)
+
+	if source.closes != 0 {
+		t.Errorf("got: %d closes after the first consumer stopped; expected: %d", source.closes, 0)
+	}
+
+	got := shared[1].Collect()
+
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) || source.closes != 1 {
+		t.Errorf("got: (%v, %d closes); expected: (%v, %d)", got, source.closes, want, 1)
+	}
+
+	shared[0].Close()
+	shared[1].Close()
+
+	if source.closes != 1 {
+		t.Errorf("got: %d closes; expected: %d", source.closes, 1)
+	}
+}
+
+func TestAdaptersDoNotClose(t *testing.T) {
+	source := &closeableInts{items: []int{0, 1, 2, 3, 4}}
+	iter := FromIterableInt(source)
+
+	iter.Nth(0)
+	iter.Skip(1)
+	iter.SkipWhile(isEven)
+	filtered := iter.Filter(isEven)
+
+	if got := filtered.Next(); !reflect.DeepEqual(got, SomeInt(4)) || source.closes != 0 {
+		t.Errorf("got: (%v, %d closes); expected: (%v, %d closes)", got, source.closes, SomeInt(4), 0)
+	}
+
+	if err := filtered.Map(square).Take(3).Close(); err != nil || source.closes != 1 {
+		t.Errorf("got: (%v, %d closes); expected: (%v, %d closes)", err, source.closes, nil, 1)
+	}
+}
+
+type closeableResults struct {
+	closeableInts
+}
+
+func (c *closeableResults) Next() OptionForResultForInt {
+	item := c.closeableInts.Next()
+	if item.IsNone() {
+		return NoneResultForInt()
+	}
+
+	return SomeResultForInt(OkInt(item.Unwrap()))
+}
+
+func TestTryIteratorsClose(t *testing.T) {
+	testCases := map[string]func(iter TryIteratorForInt){
+		"TryCollect": func(iter TryIteratorForInt) { iter.TryCollect() },
+		"TryForEach": func(iter TryIteratorForInt) { iter.TryForEach(func(item int) error { return errPage }) },
+		"Unwrapped":  func(iter TryIteratorForInt) { iter.Unwrapped().Take(1).Collect() },
+	}
+
+	for name, operation := range testCases {
+		source := &closeableResults{closeableInts{items: []int{0, 1, 2, 3}}}
+		operation(FromTryIterableInt(source))
+
+		if source.closes != 1 {
+			t.Errorf("case: %s; got: %d closes; expected: %d", name, source.closes, 1)
+		}
+	}
+}
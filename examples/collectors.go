@@ -0,0 +1,523 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package iter
+
+// ToMapByInt returns a map indexing the elements of the Iterator by the key computed for each of them.
+// When several elements share a key, the last one is kept.
+func (i IteratorForInt) ToMapByInt(key func(item int) int) map[int]int {
+	lower, _ := i.SizeHint()
+	collected := make(map[int]int, lower)
+
+	i.ForEach(func(item int) {
+		collected[key(item)] = item
+	})
+
+	return collected
+}
+
+// GroupByInt returns a map grouping the elements of the Iterator by the key computed for each of them.
+// The elements of a group keep the order in which they were yielded.
+func (i IteratorForInt) GroupByInt(key func(item int) int) map[int][]int {
+	groups := map[int][]int{}
+
+	i.ForEach(func(item int) {
+		k := key(item)
+		groups[k] = append(groups[k], item)
+	})
+
+	return groups
+}
+
+// CountByInt returns a map counting the elements of the Iterator by the key computed for each of them.
+func (i IteratorForInt) CountByInt(key func(item int) int) map[int]uint {
+	counts := map[int]uint{}
+
+	i.ForEach(func(item int) {
+		counts[key(item)]++
+	})
+
+	return counts
+}
+
+// ToMapByString returns a map indexing the elements of the Iterator by the key computed for each of them.
+// When several elements share a key, the last one is kept.
+func (i IteratorForInt) ToMapByString(key func(item int) string) map[string]int {
+	lower, _ := i.SizeHint()
+	collected := make(map[string]int, lower)
+
+	i.ForEach(func(item int) {
+		collected[key(item)] = item
+	})
+
+	return collected
+}
+
+// GroupByString returns a map grouping the elements of the Iterator by the key computed for each of them.
+// The elements of a group keep the order in which they were yielded.
+func (i IteratorForInt) GroupByString(key func(item int) string) map[string][]int {
+	groups := map[string][]int{}
+
+	i.ForEach(func(item int) {
+		k := key(item)
+		groups[k] = append(groups[k], item)
+	})
+
+	return groups
+}
+
+// CountByString returns a map counting the elements of the Iterator by the key computed for each of them.
+func (i IteratorForInt) CountByString(key func(item int) string) map[string]uint {
+	counts := map[string]uint{}
+
+	i.ForEach(func(item int) {
+		counts[key(item)]++
+	})
+
+	return counts
+}
+
+// ToMapByInt returns a map indexing the elements of the Iterator by the key computed for each of them.
+// When several elements share a key, the last one is kept.
+func (i IteratorForString) ToMapByInt(key func(item string) int) map[int]string {
+	lower, _ := i.SizeHint()
+	collected := make(map[int]string, lower)
+
+	i.ForEach(func(item string) {
+		collected[key(item)] = item
+	})
+
+	return collected
+}
+
+// GroupByInt returns a map grouping the elements of the Iterator by the key computed for each of them.
+// The elements of a group keep the order in which they were yielded.
+func (i IteratorForString) GroupByInt(key func(item string) int) map[int][]string {
+	groups := map[int][]string{}
+
+	i.ForEach(func(item string) {
+		k := key(item)
+		groups[k] = append(groups[k], item)
+	})
+
+	return groups
+}
+
+// CountByInt returns a map counting the elements of the Iterator by the key computed for each of them.
+func (i IteratorForString) CountByInt(key func(item string) int) map[int]uint {
+	counts := map[int]uint{}
+
+	i.ForEach(func(item string) {
+		counts[key(item)]++
+	})
+
+	return counts
+}
+
+// ToMapByString returns a map indexing the elements of the Iterator by the key computed for each of them.
+// When several elements share a key, the last one is kept.
+func (i IteratorForString) ToMapByString(key func(item string) string) map[string]string {
+	lower, _ := i.SizeHint()
+	collected := make(map[string]string, lower)
+
+	i.ForEach(func(item string) {
+		collected[key(item)] = item
+	})
+
+	return collected
+}
+
+// GroupByString returns a map grouping the elements of the Iterator by the key computed for each of them.
+// The elements of a group keep the order in which they were yielded.
+func (i IteratorForString) GroupByString(key func(item string) string) map[string][]string {
+	groups := map[string][]string{}
+
+	i.ForEach(func(item string) {
+		k := key(item)
+		groups[k] = append(groups[k], item)
+	})
+
+	return groups
+}
+
+// CountByString returns a map counting the elements of the Iterator by the key computed for each of them.
+func (i IteratorForString) CountByString(key func(item string) string) map[string]uint {
+	counts := map[string]uint{}
+
+	i.ForEach(func(item string) {
+		counts[key(item)]++
+	})
+
+	return counts
+}
+
+// ToMapByInt returns a map indexing the elements of the Iterator by the key computed for each of them.
+// When several elements share a key, the last one is kept.
+func (i IteratorForEntryForStringToInt) ToMapByInt(key func(item EntryForStringToInt) int) map[int]EntryForStringToInt {
+	lower, _ := i.SizeHint()
+	collected := make(map[int]EntryForStringToInt, lower)
+
+	i.ForEach(func(item EntryForStringToInt) {
+		collected[key(item)] = item
+	})
+
+	return collected
+}
+
+// GroupByInt returns a map grouping the elements of the Iterator by the key computed for each of them.
+// The elements of a group keep the order in which they were yielded.
+func (i IteratorForEntryForStringToInt) GroupByInt(key func(item EntryForStringToInt) int) map[int][]EntryForStringToInt {
+	groups := map[int][]EntryForStringToInt{}
+
+	i.ForEach(func(item EntryForStringToInt) {
+		k := key(item)
+		groups[k] = append(groups[k], item)
+	})
+
+	return groups
+}
+
+// CountByInt returns a map counting the elements of the Iterator by the key computed for each of them.
+func (i IteratorForEntryForStringToInt) CountByInt(key func(item EntryForStringToInt) int) map[int]uint {
+	counts := map[int]uint{}
+
+	i.ForEach(func(item EntryForStringToInt) {
+		counts[key(item)]++
+	})
+
+	return counts
+}
+
+// ToMapByString returns a map indexing the elements of the Iterator by the key computed for each of them.
+// When several elements share a key, the last one is kept.
+func (i IteratorForEntryForStringToInt) ToMapByString(key func(item EntryForStringToInt) string) map[string]EntryForStringToInt {
+	lower, _ := i.SizeHint()
+	collected := make(map[string]EntryForStringToInt, lower)
+
+	i.ForEach(func(item EntryForStringToInt) {
+		collected[key(item)] = item
+	})
+
+	return collected
+}
+
+// GroupByString returns a map grouping the elements of the Iterator by the key computed for each of them.
+// The elements of a group keep the order in which they were yielded.
+func (i IteratorForEntryForStringToInt) GroupByString(key func(item EntryForStringToInt) string) map[string][]EntryForStringToInt {
+	groups := map[string][]EntryForStringToInt{}
+
+	i.ForEach(func(item EntryForStringToInt) {
+		k := key(item)
+		groups[k] = append(groups[k], item)
+	})
+
+	return groups
+}
+
+// CountByString returns a map counting the elements of the Iterator by the key computed for each of them.
+func (i IteratorForEntryForStringToInt) CountByString(key func(item EntryForStringToInt) string) map[string]uint {
+	counts := map[string]uint{}
+
+	i.ForEach(func(item EntryForStringToInt) {
+		counts[key(item)]++
+	})
+
+	return counts
+}
+
+// ToMapByInt returns a map indexing the elements of the Iterator by the key computed for each of them.
+// When several elements share a key, the last one is kept.
+func (i IteratorForSliceOfInt) ToMapByInt(key func(item SliceOfInt) int) map[int]SliceOfInt {
+	lower, _ := i.SizeHint()
+	collected := make(map[int]SliceOfInt, lower)
+
+	i.ForEach(func(item SliceOfInt) {
+		collected[key(item)] = item
+	})
+
+	return collected
+}
+
+// GroupByInt returns a map grouping the elements of the Iterator by the key computed for each of them.
+// The elements of a group keep the order in which they were yielded.
+func (i IteratorForSliceOfInt) GroupByInt(key func(item SliceOfInt) int) map[int][]SliceOfInt {
+	groups := map[int][]SliceOfInt{}
+
+	i.ForEach(func(item SliceOfInt) {
+		k := key(item)
+		groups[k] = append(groups[k], item)
+	})
+
+	return groups
+}
+
+// CountByInt returns a map counting the elements of the Iterator by the key computed for each of them.
+func (i IteratorForSliceOfInt) CountByInt(key func(item SliceOfInt) int) map[int]uint {
+	counts := map[int]uint{}
+
+	i.ForEach(func(item SliceOfInt) {
+		counts[key(item)]++
+	})
+
+	return counts
+}
+
+// ToMapByString returns a map indexing the elements of the Iterator by the key computed for each of them.
+// When several elements share a key, the last one is kept.
+func (i IteratorForSliceOfInt) ToMapByString(key func(item SliceOfInt) string) map[string]SliceOfInt {
+	lower, _ := i.SizeHint()
+	collected := make(map[string]SliceOfInt, lower)
+
+	i.ForEach(func(item SliceOfInt) {
+		collected[key(item)] = item
+	})
+
+	return collected
+}
+
+// GroupByString returns a map grouping the elements of the Iterator by the key computed for each of them.
+// The elements of a group keep the order in which they were yielded.
+func (i IteratorForSliceOfInt) GroupByString(key func(item SliceOfInt) string) map[string][]SliceOfInt {
+	groups := map[string][]SliceOfInt{}
+
+	i.ForEach(func(item SliceOfInt) {
+		k := key(item)
+		groups[k] = append(groups[k], item)
+	})
+
+	return groups
+}
+
+// CountByString returns a map counting the elements of the Iterator by the key computed for each of them.
+func (i IteratorForSliceOfInt) CountByString(key func(item SliceOfInt) string) map[string]uint {
+	counts := map[string]uint{}
+
+	i.ForEach(func(item SliceOfInt) {
+		counts[key(item)]++
+	})
+
+	return counts
+}
+
+// ToMapByInt returns a map indexing the elements of the Iterator by the key computed for each of them.
+// When several elements share a key, the last one is kept.
+func (i IteratorForSliceOfString) ToMapByInt(key func(item SliceOfString) int) map[int]SliceOfString {
+	lower, _ := i.SizeHint()
+	collected := make(map[int]SliceOfString, lower)
+
+	i.ForEach(func(item SliceOfString) {
+		collected[key(item)] = item
+	})
+
+	return collected
+}
+
+// GroupByInt returns a map grouping the elements of the Iterator by the key computed for each of them.
+// The elements of a group keep the order in which they were yielded.
+func (i IteratorForSliceOfString) GroupByInt(key func(item SliceOfString) int) map[int][]SliceOfString {
+	groups := map[int][]SliceOfString{}
+
+	i.ForEach(func(item SliceOfString) {
+		k := key(item)
+		groups[k] = append(groups[k], item)
+	})
+
+	return groups
+}
+
+// CountByInt returns a map counting the elements of the Iterator by the key computed for each of them.
+func (i IteratorForSliceOfString) CountByInt(key func(item SliceOfString) int) map[int]uint {
+	counts := map[int]uint{}
+
+	i.ForEach(func(item SliceOfString) {
+		counts[key(item)]++
+	})
+
+	return counts
+}
+
+// ToMapByString returns a map indexing the elements of the Iterator by the key computed for each of them.
+// When several elements share a key, the last one is kept.
+func (i IteratorForSliceOfString) ToMapByString(key func(item SliceOfString) string) map[string]SliceOfString {
+	lower, _ := i.SizeHint()
+	collected := make(map[string]SliceOfString, lower)
+
+	i.ForEach(func(item SliceOfString) {
+		collected[key(item)] = item
+	})
+
+	return collected
+}
+
+// GroupByString returns a map grouping the elements of the Iterator by the key computed for each of them.
+// The elements of a group keep the order in which they were yielded.
+func (i IteratorForSliceOfString) GroupByString(key func(item SliceOfString) string) map[string][]SliceOfString {
+	groups := map[string][]SliceOfString{}
+
+	i.ForEach(func(item SliceOfString) {
+		k := key(item)
+		groups[k] = append(groups[k], item)
+	})
+
+	return groups
+}
+
+// CountByString returns a map counting the elements of the Iterator by the key computed for each of them.
+func (i IteratorForSliceOfString) CountByString(key func(item SliceOfString) string) map[string]uint {
+	counts := map[string]uint{}
+
+	i.ForEach(func(item SliceOfString) {
+		counts[key(item)]++
+	})
+
+	return counts
+}
+
+// ToMapByInt returns a map indexing the elements of the Iterator by the key computed for each of them.
+// When several elements share a key, the last one is kept.
+func (i IteratorForSliceOfEntryForStringToInt) ToMapByInt(key func(item SliceOfEntryForStringToInt) int) map[int]SliceOfEntryForStringToInt {
+	lower, _ := i.SizeHint()
+	collected := make(map[int]SliceOfEntryForStringToInt, lower)
+
+	i.ForEach(func(item SliceOfEntryForStringToInt) {
+		collected[key(item)] = item
+	})
+
+	return collected
+}
+
+// GroupByInt returns a map grouping the elements of the Iterator by the key computed for each of them.
+// The elements of a group keep the order in which they were yielded.
+func (i IteratorForSliceOfEntryForStringToInt) GroupByInt(key func(item SliceOfEntryForStringToInt) int) map[int][]SliceOfEntryForStringToInt {
+	groups := map[int][]SliceOfEntryForStringToInt{}
+
+	i.ForEach(func(item SliceOfEntryForStringToInt) {
+		k := key(item)
+		groups[k] = append(groups[k], item)
+	})
+
+	return groups
+}
+
+// CountByInt returns a map counting the elements of the Iterator by the key computed for each of them.
+func (i IteratorForSliceOfEntryForStringToInt) CountByInt(key func(item SliceOfEntryForStringToInt) int) map[int]uint {
+	counts := map[int]uint{}
+
+	i.ForEach(func(item SliceOfEntryForStringToInt) {
+		counts[key(item)]++
+	})
+
+	return counts
+}
+
+// ToMapByString returns a map indexing the elements of the Iterator by the key computed for each of them.
+// When several elements share a key, the last one is kept.
+func (i IteratorForSliceOfEntryForStringToInt) ToMapByString(key func(item SliceOfEntryForStringToInt) string) map[string]SliceOfEntryForStringToInt {
+	lower, _ := i.SizeHint()
+	collected := make(map[string]SliceOfEntryForStringToInt, lower)
+
+	i.ForEach(func(item SliceOfEntryForStringToInt) {
+		collected[key(item)] = item
+	})
+
+	return collected
+}
+
+// GroupByString returns a map grouping the elements of the Iterator by the key computed for each of them.
+// The elements of a group keep the order in which they were yielded.
+func (i IteratorForSliceOfEntryForStringToInt) GroupByString(key func(item SliceOfEntryForStringToInt) string) map[string][]SliceOfEntryForStringToInt {
+	groups := map[string][]SliceOfEntryForStringToInt{}
+
+	i.ForEach(func(item SliceOfEntryForStringToInt) {
+		k := key(item)
+		groups[k] = append(groups[k], item)
+	})
+
+	return groups
+}
+
+// CountByString returns a map counting the elements of the Iterator by the key computed for each of them.
+func (i IteratorForSliceOfEntryForStringToInt) CountByString(key func(item SliceOfEntryForStringToInt) string) map[string]uint {
+	counts := map[string]uint{}
+
+	i.ForEach(func(item SliceOfEntryForStringToInt) {
+		counts[key(item)]++
+	})
+
+	return counts
+}
+
+// ToMapByInt returns a map indexing the elements of the Iterator by the key computed for each of them.
+// When several elements share a key, the last one is kept.
+func (i IteratorForCSVRecord) ToMapByInt(key func(item CSVRecord) int) map[int]CSVRecord {
+	lower, _ := i.SizeHint()
+	collected := make(map[int]CSVRecord, lower)
+
+	i.ForEach(func(item CSVRecord) {
+		collected[key(item)] = item
+	})
+
+	return collected
+}
+
+// GroupByInt returns a map grouping the elements of the Iterator by the key computed for each of them.
+// The elements of a group keep the order in which they were yielded.
+func (i IteratorForCSVRecord) GroupByInt(key func(item CSVRecord) int) map[int][]CSVRecord {
+	groups := map[int][]CSVRecord{}
+
+	i.ForEach(func(item CSVRecord) {
+		k := key(item)
+		groups[k] = append(groups[k], item)
+	})
+
+	return groups
+}
+
+// CountByInt returns a map counting the elements of the Iterator by the key computed for each of them.
+func (i IteratorForCSVRecord) CountByInt(key func(item CSVRecord) int) map[int]uint {
+	counts := map[int]uint{}
+
+	i.ForEach(func(item CSVRecord) {
+		counts[key(item)]++
+	})
+
+	return counts
+}
+
+// ToMapByString returns a map indexing the elements of the Iterator by the key computed for each of them.
+// When several elements share a key, the last one is kept.
+func (i IteratorForCSVRecord) ToMapByString(key func(item CSVRecord) string) map[string]CSVRecord {
+	lower, _ := i.SizeHint()
+	collected := make(map[string]CSVRecord, lower)
+
+	i.ForEach(func(item CSVRecord) {
+		collected[key(item)] = item
+	})
+
+	return collected
+}
+
+// GroupByString returns a map grouping the elements of the Iterator by the key computed for each of them.
+// The elements of a group keep the order in which they were yielded.
+func (i IteratorForCSVRecord) GroupByString(key func(item CSVRecord) string) map[string][]CSVRecord {
+	groups := map[string][]CSVRecord{}
+
+	i.ForEach(func(item CSVRecord) {
+		k := key(item)
+		groups[k] = append(groups[k], item)
+	})
+
+	return groups
+}
+
+// CountByString returns a map counting the elements of the Iterator by the key computed for each of them.
+func (i IteratorForCSVRecord) CountByString(key func(item CSVRecord) string) map[string]uint {
+	counts := map[string]uint{}
+
+	i.ForEach(func(item CSVRecord) {
+		counts[key(item)]++
+	})
+
+	return counts
+}
@@ -0,0 +1,99 @@
+package iter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCollectSet(t *testing.T) {
+	testCases := map[IteratorForInt]map[int]struct{}{
+		VectorOfInt([]int{}):              {},
+		VectorOfInt([]int{1, 2, 1, 3, 2}): {1: {}, 2: {}, 3: {}},
+		Range(0, 10, 4):                   {0: {}, 4: {}, 8: {}},
+	}
+
+	for iter, want := range testCases {
+		got := iter.CollectSet()
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("case: %v; got: %v; expected: %v", iter, got, want)
+		}
+	}
+}
+
+func TestToMapBy(t *testing.T) {
+	got := VectorOfString([]string{"ada", "alan", "grace", "anna"}).ToMapByInt(func(name string) int { return len(name) })
+
+	want := map[int]string{3: "ada", 4: "anna", 5: "grace"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; expected: %v", got, want)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	got := VectorOfString([]string{"ada", "alan", "grace", "anna"}).GroupByString(func(name string) string { return name[:1] })
+
+	want := map[string][]string{"a": {"ada", "alan", "anna"}, "g": {"grace"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; expected: %v", got, want)
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	testCases := map[IteratorForString]map[string]uint{
+		VectorOfString([]string{}):                             {},
+		WordsOf(strings.NewReader("to be or not to be")):       {"to": 2, "be": 2, "or": 1, "not": 1},
+		VectorOfString([]string{"Go", "go", "GO", "iter", ""}): {"go": 3, "iter": 1, "": 1},
+	}
+
+	for iter, want := range testCases {
+		got := iter.CountByString(strings.ToLower)
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("case: %v; got: %v; expected: %v", iter, got, want)
+		}
+	}
+}
+
+func TestCollectorsOverEntries(t *testing.T) {
+	got := SortedEntriesOfStringToInt(ages, nil).GroupByInt(func(entry EntryForStringToInt) int { return entry.Value / 10 * 10 })
+
+	want := map[int][]EntryForStringToInt{30: {{Key: "ada", Value: 36}}, 40: {{Key: "alan", Value: 41}}, 80: {{Key: "grace", Value: 85}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; expected: %v", got, want)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	testCases := map[IteratorForInt][2][]int{
+		VectorOfInt([]int{}):           {{}, {}},
+		VectorOfInt([]int{1, 2, 3, 4}): {{2, 4}, {1, 3}},
+		Range(0, 6, 2):                 {{0, 2, 4}, {}},
+	}
+
+	for iter, want := range testCases {
+		matching, others := iter.Partition(isEven)
+
+		if got := [2][]int{matching, others}; !reflect.DeepEqual(got, want) {
+			t.Errorf("case: %v; got: %v; expected: %v", iter, got, want)
+		}
+	}
+}
+
+func TestCollectorsClose(t *testing.T) {
+	source := &closeableInts{items: []int{1, 2, 3}}
+
+	got := FromIterableInt(source).CountByString(func(n int) string {
+		if isEven(n) {
+			return "even"
+		}
+
+		return "odd"
+	})
+
+	want := map[string]uint{"even": 1, "odd": 2}
+	if !reflect.DeepEqual(got, want) || source.closes != 1 {
+		t.Errorf("got: (%v, %d closes); expected: (%v, %d)", got, source.closes, want, 1)
+	}
+}
@@ -0,0 +1,45 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package iter
+
+// CollectSet returns a set containing the distinct elements of the Iterator.
+func (i IteratorForInt) CollectSet() map[int]struct{} {
+	lower, _ := i.SizeHint()
+	set := make(map[int]struct{}, lower)
+
+	i.ForEach(func(item int) {
+		set[item] = struct{}{}
+	})
+
+	return set
+}
+
+// Eq checks if the Iterator yields the same elements as other, in the same order.
+// Both are consumed up to their first difference, then closed.
+func (i IteratorForInt) Eq(other IteratorForInt) bool {
+	return i.EqBy(other, func(a, b int) bool {
+		return a == b
+	})
+}
+
+// CollectSet returns a set containing the distinct elements of the Iterator.
+func (i IteratorForString) CollectSet() map[string]struct{} {
+	lower, _ := i.SizeHint()
+	set := make(map[string]struct{}, lower)
+
+	i.ForEach(func(item string) {
+		set[item] = struct{}{}
+	})
+
+	return set
+}
+
+// Eq checks if the Iterator yields the same elements as other, in the same order.
+// Both are consumed up to their first difference, then closed.
+func (i IteratorForString) Eq(other IteratorForString) bool {
+	return i.EqBy(other, func(a, b string) bool {
+		return a == b
+	})
+}
@@ -0,0 +1,91 @@
+package iter
+
+import (
+	"strings"
+	"testing"
+)
+
+func compareInts(a, b int) int {
+	return a - b
+}
+
+func TestEq(t *testing.T) {
+	testCases := map[[2]IteratorForInt]bool{
+		{VectorOfInt([]int{}), VectorOfInt([]int{})}:                        true,
+		{VectorOfInt([]int{0, 2, 4}), Range(0, 6, 2)}:                       true,
+		{Range(0, 10, 1).Filter(isEven), VectorOfInt([]int{0, 2, 4, 6, 8})}: true,
+		{VectorOfInt([]int{1, 2}), VectorOfInt([]int{1, 2, 3})}:             false,
+		{VectorOfInt([]int{1, 2, 3}), VectorOfInt([]int{1, 3, 2})}:          false,
+		{Range(0, 10, 1).Filter(isEven), VectorOfInt([]int{0, 2})}:          false,
+	}
+
+	for iters, want := range testCases {
+		if got := iters[0].Eq(iters[1]); got != want {
+			t.Errorf("case: %v; got: %v; expected: %v", iters, got, want)
+		}
+	}
+}
+
+func TestEqBy(t *testing.T) {
+	got := WordsOf(strings.NewReader("Go ITER")).EqBy(VectorOfString([]string{"go", "iter"}), strings.EqualFold)
+
+	if !got {
+		t.Errorf("got: %v; expected: %v", got, true)
+	}
+}
+
+func TestCmp(t *testing.T) {
+	testCases := map[[2]IteratorForInt]int{
+		{VectorOfInt([]int{}), VectorOfInt([]int{})}:            0,
+		{VectorOfInt([]int{}), VectorOfInt([]int{1})}:           -1,
+		{VectorOfInt([]int{1}), VectorOfInt([]int{})}:           1,
+		{VectorOfInt([]int{1, 2}), VectorOfInt([]int{1, 2, 0})}: -1,
+		{VectorOfInt([]int{1, 3}), VectorOfInt([]int{1, 2, 9})}: 1,
+		{VectorOfInt([]int{1, 2, 3}), Range(1, 4, 1)}:           0,
+		{Range(0, 100, 1), VectorOfInt([]int{0, 1, 5})}:         -3,
+	}
+
+	for iters, want := range testCases {
+		if got := iters[0].Cmp(iters[1], compareInts); got != want {
+			t.Errorf("case: %v; got: %v; expected: %v", iters, got, want)
+		}
+	}
+}
+
+func TestOrdering(t *testing.T) {
+	smaller, greater := []int{1, 2}, []int{1, 3}
+
+	testCases := map[string][2]bool{
+		"lt": {VectorOfInt(smaller).Lt(VectorOfInt(greater), compareInts), VectorOfInt(smaller).Lt(VectorOfInt(smaller), compareInts)},
+		"le": {VectorOfInt(smaller).Le(VectorOfInt(greater), compareInts), VectorOfInt(smaller).Le(VectorOfInt(smaller), compareInts)},
+		"gt": {VectorOfInt(greater).Gt(VectorOfInt(smaller), compareInts), VectorOfInt(smaller).Gt(VectorOfInt(smaller), compareInts)},
+		"ge": {VectorOfInt(greater).Ge(VectorOfInt(smaller), compareInts), VectorOfInt(smaller).Ge(VectorOfInt(smaller), compareInts)},
+	}
+
+	expected := map[string][2]bool{
+		"lt": {true, false},
+		"le": {true, true},
+		"gt": {true, false},
+		"ge": {true, true},
+	}
+
+	for name, got := range testCases {
+		if want := expected[name]; got != want {
+			t.Errorf("case: %s; got: %v; expected: %v", name, got, want)
+		}
+	}
+}
+
+func TestCompareShortCircuits(t *testing.T) {
+	left := &closeableInts{items: []int{1, 5, 3, 4}}
+	right := &closeableInts{items: []int{1, 2, 3, 4}}
+
+	got := FromIterableInt(left).Cmp(FromIterableInt(right), compareInts)
+
+	if got != 3 || left.cursor != 2 || right.cursor != 2 || left.closes != 1 || right.closes != 1 {
+		t.Errorf(
+			"got: (%d, cursors %d and %d, closes %d and %d); expected: (%d, cursors %d and %d, closes %d and %d)",
+			got, left.cursor, right.cursor, left.closes, right.closes, 3, 2, 2, 1, 1,
+		)
+	}
+}
@@ -0,0 +1,483 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package iter
+
+import "context"
+
+// WithContext returns a new Iterator which stops yielding elements once ctx is done.
+// ctx is checked before each element, so a blocking Next is not interrupted.
+// The Iterator Err method then returns the context error.
+func (i IteratorForInt) WithContext(ctx context.Context) IteratorForInt {
+	return IteratorForInt{iter: &contextForInt{iter: i.iter, ctx: ctx, err: nil}}
+}
+
+// ForEachContext runs a callback for every element of the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForInt) ForEachContext(ctx context.Context, callback func(item int)) error {
+	iter := i.WithContext(ctx)
+	iter.ForEach(callback)
+
+	return iter.Err()
+}
+
+// CollectContext returns a slice containing the elements of the Iterator yielded before ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForInt) CollectContext(ctx context.Context) ([]int, error) {
+	iter := i.WithContext(ctx)
+	collected := iter.Collect()
+
+	return collected, iter.Err()
+}
+
+type contextForInt struct {
+	iter IterableForInt
+	ctx  context.Context
+	err  error
+}
+
+func (c *contextForInt) Next() OptionForInt {
+	if c.err != nil {
+		return NoneInt()
+	}
+
+	if err := c.ctx.Err(); err != nil {
+		c.err = err
+		return NoneInt()
+	}
+
+	return c.iter.Next()
+}
+
+func (c *contextForInt) SizeHint() (uint, OptionForUint) {
+	if c.err != nil {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := c.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (c *contextForInt) Err() error {
+	if c.err != nil {
+		return c.err
+	}
+
+	return errOf(c.iter)
+}
+
+func (c *contextForInt) Close() error {
+	return closeOf(c.iter)
+}
+
+var _ IterableForInt = &contextForInt{}
+
+// WithContext returns a new Iterator which stops yielding elements once ctx is done.
+// ctx is checked before each element, so a blocking Next is not interrupted.
+// The Iterator Err method then returns the context error.
+func (i IteratorForString) WithContext(ctx context.Context) IteratorForString {
+	return IteratorForString{iter: &contextForString{iter: i.iter, ctx: ctx, err: nil}}
+}
+
+// ForEachContext runs a callback for every element of the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForString) ForEachContext(ctx context.Context, callback func(item string)) error {
+	iter := i.WithContext(ctx)
+	iter.ForEach(callback)
+
+	return iter.Err()
+}
+
+// CollectContext returns a slice containing the elements of the Iterator yielded before ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForString) CollectContext(ctx context.Context) ([]string, error) {
+	iter := i.WithContext(ctx)
+	collected := iter.Collect()
+
+	return collected, iter.Err()
+}
+
+type contextForString struct {
+	iter IterableForString
+	ctx  context.Context
+	err  error
+}
+
+func (c *contextForString) Next() OptionForString {
+	if c.err != nil {
+		return NoneString()
+	}
+
+	if err := c.ctx.Err(); err != nil {
+		c.err = err
+		return NoneString()
+	}
+
+	return c.iter.Next()
+}
+
+func (c *contextForString) SizeHint() (uint, OptionForUint) {
+	if c.err != nil {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := c.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (c *contextForString) Err() error {
+	if c.err != nil {
+		return c.err
+	}
+
+	return errOf(c.iter)
+}
+
+func (c *contextForString) Close() error {
+	return closeOf(c.iter)
+}
+
+var _ IterableForString = &contextForString{}
+
+// WithContext returns a new Iterator which stops yielding elements once ctx is done.
+// ctx is checked before each element, so a blocking Next is not interrupted.
+// The Iterator Err method then returns the context error.
+func (i IteratorForEntryForStringToInt) WithContext(ctx context.Context) IteratorForEntryForStringToInt {
+	return IteratorForEntryForStringToInt{iter: &contextForEntryForStringToInt{iter: i.iter, ctx: ctx, err: nil}}
+}
+
+// ForEachContext runs a callback for every element of the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForEntryForStringToInt) ForEachContext(ctx context.Context, callback func(item EntryForStringToInt)) error {
+	iter := i.WithContext(ctx)
+	iter.ForEach(callback)
+
+	return iter.Err()
+}
+
+// CollectContext returns a slice containing the elements of the Iterator yielded before ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForEntryForStringToInt) CollectContext(ctx context.Context) ([]EntryForStringToInt, error) {
+	iter := i.WithContext(ctx)
+	collected := iter.Collect()
+
+	return collected, iter.Err()
+}
+
+type contextForEntryForStringToInt struct {
+	iter IterableForEntryForStringToInt
+	ctx  context.Context
+	err  error
+}
+
+func (c *contextForEntryForStringToInt) Next() OptionForEntryForStringToInt {
+	if c.err != nil {
+		return NoneEntryForStringToInt()
+	}
+
+	if err := c.ctx.Err(); err != nil {
+		c.err = err
+		return NoneEntryForStringToInt()
+	}
+
+	return c.iter.Next()
+}
+
+func (c *contextForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if c.err != nil {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := c.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (c *contextForEntryForStringToInt) Err() error {
+	if c.err != nil {
+		return c.err
+	}
+
+	return errOf(c.iter)
+}
+
+func (c *contextForEntryForStringToInt) Close() error {
+	return closeOf(c.iter)
+}
+
+var _ IterableForEntryForStringToInt = &contextForEntryForStringToInt{}
+
+// WithContext returns a new Iterator which stops yielding elements once ctx is done.
+// ctx is checked before each element, so a blocking Next is not interrupted.
+// The Iterator Err method then returns the context error.
+func (i IteratorForSliceOfInt) WithContext(ctx context.Context) IteratorForSliceOfInt {
+	return IteratorForSliceOfInt{iter: &contextForSliceOfInt{iter: i.iter, ctx: ctx, err: nil}}
+}
+
+// ForEachContext runs a callback for every element of the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForSliceOfInt) ForEachContext(ctx context.Context, callback func(item SliceOfInt)) error {
+	iter := i.WithContext(ctx)
+	iter.ForEach(callback)
+
+	return iter.Err()
+}
+
+// CollectContext returns a slice containing the elements of the Iterator yielded before ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForSliceOfInt) CollectContext(ctx context.Context) ([]SliceOfInt, error) {
+	iter := i.WithContext(ctx)
+	collected := iter.Collect()
+
+	return collected, iter.Err()
+}
+
+type contextForSliceOfInt struct {
+	iter IterableForSliceOfInt
+	ctx  context.Context
+	err  error
+}
+
+func (c *contextForSliceOfInt) Next() OptionForSliceOfInt {
+	if c.err != nil {
+		return NoneSliceOfInt()
+	}
+
+	if err := c.ctx.Err(); err != nil {
+		c.err = err
+		return NoneSliceOfInt()
+	}
+
+	return c.iter.Next()
+}
+
+func (c *contextForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	if c.err != nil {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := c.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (c *contextForSliceOfInt) Err() error {
+	if c.err != nil {
+		return c.err
+	}
+
+	return errOf(c.iter)
+}
+
+func (c *contextForSliceOfInt) Close() error {
+	return closeOf(c.iter)
+}
+
+var _ IterableForSliceOfInt = &contextForSliceOfInt{}
+
+// WithContext returns a new Iterator which stops yielding elements once ctx is done.
+// ctx is checked before each element, so a blocking Next is not interrupted.
+// The Iterator Err method then returns the context error.
+func (i IteratorForSliceOfString) WithContext(ctx context.Context) IteratorForSliceOfString {
+	return IteratorForSliceOfString{iter: &contextForSliceOfString{iter: i.iter, ctx: ctx, err: nil}}
+}
+
+// ForEachContext runs a callback for every element of the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForSliceOfString) ForEachContext(ctx context.Context, callback func(item SliceOfString)) error {
+	iter := i.WithContext(ctx)
+	iter.ForEach(callback)
+
+	return iter.Err()
+}
+
+// CollectContext returns a slice containing the elements of the Iterator yielded before ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForSliceOfString) CollectContext(ctx context.Context) ([]SliceOfString, error) {
+	iter := i.WithContext(ctx)
+	collected := iter.Collect()
+
+	return collected, iter.Err()
+}
+
+type contextForSliceOfString struct {
+	iter IterableForSliceOfString
+	ctx  context.Context
+	err  error
+}
+
+func (c *contextForSliceOfString) Next() OptionForSliceOfString {
+	if c.err != nil {
+		return NoneSliceOfString()
+	}
+
+	if err := c.ctx.Err(); err != nil {
+		c.err = err
+		return NoneSliceOfString()
+	}
+
+	return c.iter.Next()
+}
+
+func (c *contextForSliceOfString) SizeHint() (uint, OptionForUint) {
+	if c.err != nil {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := c.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (c *contextForSliceOfString) Err() error {
+	if c.err != nil {
+		return c.err
+	}
+
+	return errOf(c.iter)
+}
+
+func (c *contextForSliceOfString) Close() error {
+	return closeOf(c.iter)
+}
+
+var _ IterableForSliceOfString = &contextForSliceOfString{}
+
+// WithContext returns a new Iterator which stops yielding elements once ctx is done.
+// ctx is checked before each element, so a blocking Next is not interrupted.
+// The Iterator Err method then returns the context error.
+func (i IteratorForSliceOfEntryForStringToInt) WithContext(ctx context.Context) IteratorForSliceOfEntryForStringToInt {
+	return IteratorForSliceOfEntryForStringToInt{iter: &contextForSliceOfEntryForStringToInt{iter: i.iter, ctx: ctx, err: nil}}
+}
+
+// ForEachContext runs a callback for every element of the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForSliceOfEntryForStringToInt) ForEachContext(ctx context.Context, callback func(item SliceOfEntryForStringToInt)) error {
+	iter := i.WithContext(ctx)
+	iter.ForEach(callback)
+
+	return iter.Err()
+}
+
+// CollectContext returns a slice containing the elements of the Iterator yielded before ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForSliceOfEntryForStringToInt) CollectContext(ctx context.Context) ([]SliceOfEntryForStringToInt, error) {
+	iter := i.WithContext(ctx)
+	collected := iter.Collect()
+
+	return collected, iter.Err()
+}
+
+type contextForSliceOfEntryForStringToInt struct {
+	iter IterableForSliceOfEntryForStringToInt
+	ctx  context.Context
+	err  error
+}
+
+func (c *contextForSliceOfEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	if c.err != nil {
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	if err := c.ctx.Err(); err != nil {
+		c.err = err
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	return c.iter.Next()
+}
+
+func (c *contextForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if c.err != nil {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := c.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (c *contextForSliceOfEntryForStringToInt) Err() error {
+	if c.err != nil {
+		return c.err
+	}
+
+	return errOf(c.iter)
+}
+
+func (c *contextForSliceOfEntryForStringToInt) Close() error {
+	return closeOf(c.iter)
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &contextForSliceOfEntryForStringToInt{}
+
+// WithContext returns a new Iterator which stops yielding elements once ctx is done.
+// ctx is checked before each element, so a blocking Next is not interrupted.
+// The Iterator Err method then returns the context error.
+func (i IteratorForCSVRecord) WithContext(ctx context.Context) IteratorForCSVRecord {
+	return IteratorForCSVRecord{iter: &contextForCSVRecord{iter: i.iter, ctx: ctx, err: nil}}
+}
+
+// ForEachContext runs a callback for every element of the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForCSVRecord) ForEachContext(ctx context.Context, callback func(item CSVRecord)) error {
+	iter := i.WithContext(ctx)
+	iter.ForEach(callback)
+
+	return iter.Err()
+}
+
+// CollectContext returns a slice containing the elements of the Iterator yielded before ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForCSVRecord) CollectContext(ctx context.Context) ([]CSVRecord, error) {
+	iter := i.WithContext(ctx)
+	collected := iter.Collect()
+
+	return collected, iter.Err()
+}
+
+type contextForCSVRecord struct {
+	iter IterableForCSVRecord
+	ctx  context.Context
+	err  error
+}
+
+func (c *contextForCSVRecord) Next() OptionForCSVRecord {
+	if c.err != nil {
+		return NoneCSVRecord()
+	}
+
+	if err := c.ctx.Err(); err != nil {
+		c.err = err
+		return NoneCSVRecord()
+	}
+
+	return c.iter.Next()
+}
+
+func (c *contextForCSVRecord) SizeHint() (uint, OptionForUint) {
+	if c.err != nil {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := c.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (c *contextForCSVRecord) Err() error {
+	if c.err != nil {
+		return c.err
+	}
+
+	return errOf(c.iter)
+}
+
+func (c *contextForCSVRecord) Close() error {
+	return closeOf(c.iter)
+}
+
+var _ IterableForCSVRecord = &contextForCSVRecord{}
@@ -0,0 +1,91 @@
+package iter
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	iter := RepeatInt(1).Map(func(item int) int {
+		return item
+	}).WithContext(ctx)
+
+	got := iter.Take(3).Collect()
+	want := []int{1, 1, 1}
+	if !reflect.DeepEqual(got, want) || iter.Err() != nil {
+		t.Errorf("got: (%v, %v); expected: (%v, %v)", got, iter.Err(), want, nil)
+	}
+
+	cancel()
+
+	if got := iter.Next(); !reflect.DeepEqual(got, NoneInt()) || iter.Err() != context.Canceled {
+		t.Errorf("got: (%v, %v); expected: (%v, %v)", got, iter.Err(), NoneInt(), context.Canceled)
+	}
+}
+
+func TestCollectContext(t *testing.T) {
+	got, err := Range(0, 4, 1).CollectContext(context.Background())
+
+	want := []int{0, 1, 2, 3}
+	if !reflect.DeepEqual(got, want) || err != nil {
+		t.Errorf("got: (%v, %v); expected: (%v, %v)", got, err, want, nil)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	count := 0
+
+	got, err = Range(0, 10, 1).Map(func(item int) int {
+		count++
+		if count == 3 {
+			cancel()
+		}
+
+		return item
+	}).CollectContext(ctx)
+
+	want = []int{0, 1, 2}
+	if !reflect.DeepEqual(got, want) || err != context.Canceled {
+		t.Errorf("got: (%v, %v); expected: (%v, %v)", got, err, want, context.Canceled)
+	}
+}
+
+func TestForEachContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	got := 0
+	err := RepeatInt(1).ForEachContext(ctx, func(item int) {
+		got += item
+		if got == 5 {
+			cancel()
+		}
+	})
+
+	if got != 5 || err != context.Canceled {
+		t.Errorf("got: (%d, %v); expected: (%d, %v)", got, err, 5, context.Canceled)
+	}
+}
+
+func TestFoldContext(t *testing.T) {
+	got, err := Range(0, 4, 1).FoldForIntContext(context.Background(), 0, func(acc, item int) int {
+		return acc + item
+	})
+
+	if got != 6 || err != nil {
+		t.Errorf("got: (%d, %v); expected: (%d, %v)", got, err, 6, nil)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	got, err = Range(0, 4, 1).FoldForIntContext(ctx, 0, func(acc, item int) int {
+		return acc + item
+	})
+
+	if got != 0 || err != context.Canceled {
+		t.Errorf("got: (%d, %v); expected: (%d, %v)", got, err, 0, context.Canceled)
+	}
+}
@@ -0,0 +1,108 @@
+package iter
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// CSVRecord is a CSV row keyed by the names of its columns.
+type CSVRecord map[string]string
+
+// CSVRecords builds a fallible Iterator yielding the rows read from r.
+// r is not closed. The Iterator stops at the first error.
+func CSVRecords(r io.Reader) TryIteratorForSliceOfString {
+	return CSVReaderOf(csv.NewReader(r))
+}
+
+// CSVReaderOf builds a fallible Iterator yielding the rows of a Reader, for instance one with another separator.
+// The Iterator stops at the first error.
+func CSVReaderOf(reader *csv.Reader) TryIteratorForSliceOfString {
+	return TryIteratorForSliceOfString{
+		iter: &csvIterable{reader: reader, flag: false},
+	}
+}
+
+// CSVRecordsWithHeader builds a fallible Iterator yielding the rows read from r after the first one,
+// keyed by the names of the columns it holds.
+// r is not closed. The Iterator stops at the first error.
+func CSVRecordsWithHeader(r io.Reader) TryIteratorForCSVRecord {
+	return TryIteratorForCSVRecord{
+		iter: &csvWithHeaderIterable{rows: csvIterable{reader: csv.NewReader(r), flag: false}, header: nil},
+	}
+}
+
+type csvIterable struct {
+	reader *csv.Reader
+	flag   bool
+}
+
+func (c *csvIterable) Next() OptionForResultForSliceOfString {
+	if c.flag {
+		return NoneResultForSliceOfString()
+	}
+
+	row, err := c.reader.Read()
+	if err != nil {
+		c.flag = true
+
+		if err == io.EOF {
+			return NoneResultForSliceOfString()
+		}
+
+		return SomeResultForSliceOfString(ErrSliceOfString(err))
+	}
+
+	return SomeResultForSliceOfString(OkSliceOfString(row))
+}
+
+func (c *csvIterable) SizeHint() (uint, OptionForUint) {
+	if c.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+var _ TryIterableForSliceOfString = &csvIterable{}
+
+type csvWithHeaderIterable struct {
+	rows   csvIterable
+	header []string
+}
+
+func (c *csvWithHeaderIterable) Next() OptionForResultForCSVRecord {
+	if c.header == nil {
+		header := c.rows.Next()
+		if header.IsNone() {
+			return NoneResultForCSVRecord()
+		}
+
+		if header.Unwrap().IsErr() {
+			return SomeResultForCSVRecord(ErrCSVRecord(header.Unwrap().Err()))
+		}
+
+		c.header = header.Unwrap().Unwrap()
+	}
+
+	row := c.rows.Next()
+	if row.IsNone() {
+		return NoneResultForCSVRecord()
+	}
+
+	if row.Unwrap().IsErr() {
+		return SomeResultForCSVRecord(ErrCSVRecord(row.Unwrap().Err()))
+	}
+
+	record := make(CSVRecord, len(c.header))
+	for k, field := range row.Unwrap().Unwrap() {
+		record[c.header[k]] = field
+	}
+
+	return SomeResultForCSVRecord(OkCSVRecord(record))
+}
+
+func (c *csvWithHeaderIterable) SizeHint() (uint, OptionForUint) {
+	return c.rows.SizeHint()
+}
+
+var _ TryIterableForCSVRecord = &csvWithHeaderIterable{}
@@ -0,0 +1,75 @@
+package iter
+
+import (
+	"encoding/csv"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCSVRecords(t *testing.T) {
+	rows, err := CSVRecords(strings.NewReader("name,age\nada,36\n\"grace, hopper\",85\n")).TryCollect()
+
+	want := []string{"name,age", "ada,36", "grace, hopper,85"}
+	got := []string{}
+	for _, row := range rows {
+		got = append(got, strings.Join(row, ","))
+	}
+
+	if !reflect.DeepEqual(got, want) || err != nil {
+		t.Errorf("got: (%v, %v); expected: (%v, %v)", got, err, want, nil)
+	}
+}
+
+func TestCSVReaderOf(t *testing.T) {
+	reader := csv.NewReader(strings.NewReader("a;b\nc;d\n"))
+	reader.Comma = ';'
+
+	got, err := CSVReaderOf(reader).TryCollect()
+
+	want := []SliceOfString{{"a", "b"}, {"c", "d"}}
+	if !reflect.DeepEqual(got, want) || err != nil {
+		t.Errorf("got: (%v, %v); expected: (%v, %v)", got, err, want, nil)
+	}
+}
+
+func TestCSVRecordsError(t *testing.T) {
+	iter := CSVRecords(strings.NewReader("a,b\nc,d,e\nf,g\n")).Unwrapped()
+
+	got := iter.Collect()
+
+	want := []SliceOfString{{"a", "b"}}
+	if !reflect.DeepEqual(got, want) || !errors.Is(iter.Err(), csv.ErrFieldCount) {
+		t.Errorf("got: (%v, %v); expected: (%v, %v)", got, iter.Err(), want, csv.ErrFieldCount)
+	}
+}
+
+func TestCSVRecordsWithHeader(t *testing.T) {
+	testCases := map[string][]CSVRecord{
+		"":           {},
+		"name,age\n": {},
+		"name,age\nada,36\ngrace,85\n": {
+			{"name": "ada", "age": "36"},
+			{"name": "grace", "age": "85"},
+		},
+	}
+
+	for input, want := range testCases {
+		got, err := CSVRecordsWithHeader(strings.NewReader(input)).TryCollect()
+
+		if !reflect.DeepEqual(got, want) || err != nil {
+			t.Errorf("case: %q; got: (%v, %v); expected: (%v, %v)", input, got, err, want, nil)
+		}
+	}
+}
+
+func TestCSVRecordsWithHeaderAndFold(t *testing.T) {
+	total, err := CSVRecordsWithHeader(strings.NewReader("name,age\nada,36\ngrace,85\n")).TryFoldForInt(0, func(acc int, record CSVRecord) (int, error) {
+		return acc + len(record["name"]), nil
+	})
+
+	if total != 8 || err != nil {
+		t.Errorf("got: (%d, %v); expected: (%d, %v)", total, err, 8, nil)
+	}
+}
@@ -0,0 +1,133 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package iter
+
+import "context"
+
+// FoldForIntContext applies a reducer to the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForInt) FoldForIntContext(ctx context.Context, init int, reducer func(acc int, item int) int) (int, error) {
+	iter := i.WithContext(ctx)
+	acc := iter.FoldForInt(init, reducer)
+
+	return acc, iter.Err()
+}
+
+// FoldForSliceOfIntContext applies a reducer to the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForInt) FoldForSliceOfIntContext(ctx context.Context, init SliceOfInt, reducer func(acc SliceOfInt, item int) SliceOfInt) (SliceOfInt, error) {
+	iter := i.WithContext(ctx)
+	acc := iter.FoldForSliceOfInt(init, reducer)
+
+	return acc, iter.Err()
+}
+
+// FoldForIntContext applies a reducer to the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForString) FoldForIntContext(ctx context.Context, init int, reducer func(acc int, item string) int) (int, error) {
+	iter := i.WithContext(ctx)
+	acc := iter.FoldForInt(init, reducer)
+
+	return acc, iter.Err()
+}
+
+// FoldForSliceOfIntContext applies a reducer to the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForString) FoldForSliceOfIntContext(ctx context.Context, init SliceOfInt, reducer func(acc SliceOfInt, item string) SliceOfInt) (SliceOfInt, error) {
+	iter := i.WithContext(ctx)
+	acc := iter.FoldForSliceOfInt(init, reducer)
+
+	return acc, iter.Err()
+}
+
+// FoldForIntContext applies a reducer to the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForEntryForStringToInt) FoldForIntContext(ctx context.Context, init int, reducer func(acc int, item EntryForStringToInt) int) (int, error) {
+	iter := i.WithContext(ctx)
+	acc := iter.FoldForInt(init, reducer)
+
+	return acc, iter.Err()
+}
+
+// FoldForSliceOfIntContext applies a reducer to the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForEntryForStringToInt) FoldForSliceOfIntContext(ctx context.Context, init SliceOfInt, reducer func(acc SliceOfInt, item EntryForStringToInt) SliceOfInt) (SliceOfInt, error) {
+	iter := i.WithContext(ctx)
+	acc := iter.FoldForSliceOfInt(init, reducer)
+
+	return acc, iter.Err()
+}
+
+// FoldForIntContext applies a reducer to the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForSliceOfInt) FoldForIntContext(ctx context.Context, init int, reducer func(acc int, item SliceOfInt) int) (int, error) {
+	iter := i.WithContext(ctx)
+	acc := iter.FoldForInt(init, reducer)
+
+	return acc, iter.Err()
+}
+
+// FoldForSliceOfIntContext applies a reducer to the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForSliceOfInt) FoldForSliceOfIntContext(ctx context.Context, init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfInt) SliceOfInt) (SliceOfInt, error) {
+	iter := i.WithContext(ctx)
+	acc := iter.FoldForSliceOfInt(init, reducer)
+
+	return acc, iter.Err()
+}
+
+// FoldForIntContext applies a reducer to the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForSliceOfString) FoldForIntContext(ctx context.Context, init int, reducer func(acc int, item SliceOfString) int) (int, error) {
+	iter := i.WithContext(ctx)
+	acc := iter.FoldForInt(init, reducer)
+
+	return acc, iter.Err()
+}
+
+// FoldForSliceOfIntContext applies a reducer to the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForSliceOfString) FoldForSliceOfIntContext(ctx context.Context, init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfString) SliceOfInt) (SliceOfInt, error) {
+	iter := i.WithContext(ctx)
+	acc := iter.FoldForSliceOfInt(init, reducer)
+
+	return acc, iter.Err()
+}
+
+// FoldForIntContext applies a reducer to the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForSliceOfEntryForStringToInt) FoldForIntContext(ctx context.Context, init int, reducer func(acc int, item SliceOfEntryForStringToInt) int) (int, error) {
+	iter := i.WithContext(ctx)
+	acc := iter.FoldForInt(init, reducer)
+
+	return acc, iter.Err()
+}
+
+// FoldForSliceOfIntContext applies a reducer to the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForSliceOfEntryForStringToInt) FoldForSliceOfIntContext(ctx context.Context, init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfEntryForStringToInt) SliceOfInt) (SliceOfInt, error) {
+	iter := i.WithContext(ctx)
+	acc := iter.FoldForSliceOfInt(init, reducer)
+
+	return acc, iter.Err()
+}
+
+// FoldForIntContext applies a reducer to the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForCSVRecord) FoldForIntContext(ctx context.Context, init int, reducer func(acc int, item CSVRecord) int) (int, error) {
+	iter := i.WithContext(ctx)
+	acc := iter.FoldForInt(init, reducer)
+
+	return acc, iter.Err()
+}
+
+// FoldForSliceOfIntContext applies a reducer to the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForCSVRecord) FoldForSliceOfIntContext(ctx context.Context, init SliceOfInt, reducer func(acc SliceOfInt, item CSVRecord) SliceOfInt) (SliceOfInt, error) {
+	iter := i.WithContext(ctx)
+	acc := iter.FoldForSliceOfInt(init, reducer)
+
+	return acc, iter.Err()
+}
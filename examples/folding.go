@@ -6,6 +6,8 @@ package iter
 
 // FoldForInt applies a reducer to the Iterator.
 func (i IteratorForInt) FoldForInt(init int, reducer func(acc int, item int) int) int {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -20,6 +22,70 @@ func (i IteratorForInt) FoldForInt(init int, reducer func(acc int, item int) int
 
 // TryFoldForInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
 func (i IteratorForInt) TryFoldForInt(init int, reducer func(acc int, item int) (int, bool)) (int, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForInt) TryFoldForInt(init int, reducer func(acc int, item int) (int, error)) (int, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfInt applies a reducer to the Iterator.
+func (i IteratorForInt) FoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item int) SliceOfInt) SliceOfInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForSliceOfInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForInt) TryFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item int) (SliceOfInt, bool)) (SliceOfInt, bool) {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -36,8 +102,36 @@ func (i IteratorForInt) TryFoldForInt(init int, reducer func(acc int, item int)
 	return acc, true
 }
 
+// TryFoldForSliceOfInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForInt) TryFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item int) (SliceOfInt, error)) (SliceOfInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
 // FoldForUint applies a reducer to the Iterator.
 func (i IteratorForInt) FoldForUint(init uint, reducer func(acc uint, item int) uint) uint {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -52,6 +146,8 @@ func (i IteratorForInt) FoldForUint(init uint, reducer func(acc uint, item int)
 
 // TryFoldForUint folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
 func (i IteratorForInt) TryFoldForUint(init uint, reducer func(acc uint, item int) (uint, bool)) (uint, bool) {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -68,8 +164,36 @@ func (i IteratorForInt) TryFoldForUint(init uint, reducer func(acc uint, item in
 	return acc, true
 }
 
+// TryFoldForUint applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForInt) TryFoldForUint(init uint, reducer func(acc uint, item int) (uint, error)) (uint, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
 // FoldForEmpty applies a reducer to the Iterator.
 func (i IteratorForInt) FoldForEmpty(init Empty, reducer func(acc Empty, item int) Empty) Empty {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -84,6 +208,8 @@ func (i IteratorForInt) FoldForEmpty(init Empty, reducer func(acc Empty, item in
 
 // TryFoldForEmpty folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
 func (i IteratorForInt) TryFoldForEmpty(init Empty, reducer func(acc Empty, item int) (Empty, bool)) (Empty, bool) {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -100,8 +226,36 @@ func (i IteratorForInt) TryFoldForEmpty(init Empty, reducer func(acc Empty, item
 	return acc, true
 }
 
+// TryFoldForEmpty applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForInt) TryFoldForEmpty(init Empty, reducer func(acc Empty, item int) (Empty, error)) (Empty, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
 // FoldForString applies a reducer to the Iterator.
 func (i IteratorForInt) FoldForString(init string, reducer func(acc string, item int) string) string {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -116,6 +270,8 @@ func (i IteratorForInt) FoldForString(init string, reducer func(acc string, item
 
 // TryFoldForString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
 func (i IteratorForInt) TryFoldForString(init string, reducer func(acc string, item int) (string, bool)) (string, bool) {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -132,8 +288,36 @@ func (i IteratorForInt) TryFoldForString(init string, reducer func(acc string, i
 	return acc, true
 }
 
-// FoldForOptionForInt applies a reducer to the Iterator.
-func (i IteratorForInt) FoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item int) OptionForInt) OptionForInt {
+// TryFoldForString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForInt) TryFoldForString(init string, reducer func(acc string, item int) (string, error)) (string, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForInt) FoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item int) EntryForStringToInt) EntryForStringToInt {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -146,8 +330,10 @@ func (i IteratorForInt) FoldForOptionForInt(init OptionForInt, reducer func(acc
 	return acc
 }
 
-// TryFoldForOptionForInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
-func (i IteratorForInt) TryFoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item int) (OptionForInt, bool)) (OptionForInt, bool) {
+// TryFoldForEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForInt) TryFoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item int) (EntryForStringToInt, bool)) (EntryForStringToInt, bool) {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -164,8 +350,36 @@ func (i IteratorForInt) TryFoldForOptionForInt(init OptionForInt, reducer func(a
 	return acc, true
 }
 
-// FoldForOptionForString applies a reducer to the Iterator.
-func (i IteratorForInt) FoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item int) OptionForString) OptionForString {
+// TryFoldForEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForInt) TryFoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item int) (EntryForStringToInt, error)) (EntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfString applies a reducer to the Iterator.
+func (i IteratorForInt) FoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item int) SliceOfString) SliceOfString {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -178,8 +392,10 @@ func (i IteratorForInt) FoldForOptionForString(init OptionForString, reducer fun
 	return acc
 }
 
-// TryFoldForOptionForString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
-func (i IteratorForInt) TryFoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item int) (OptionForString, bool)) (OptionForString, bool) {
+// TryFoldForSliceOfString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForInt) TryFoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item int) (SliceOfString, bool)) (SliceOfString, bool) {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -196,8 +412,36 @@ func (i IteratorForInt) TryFoldForOptionForString(init OptionForString, reducer
 	return acc, true
 }
 
-// FoldForInt applies a reducer to the Iterator.
-func (i IteratorForString) FoldForInt(init int, reducer func(acc int, item string) int) int {
+// TryFoldForSliceOfString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForInt) TryFoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item int) (SliceOfString, error)) (SliceOfString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForInt) FoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item int) SliceOfEntryForStringToInt) SliceOfEntryForStringToInt {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -210,8 +454,10 @@ func (i IteratorForString) FoldForInt(init int, reducer func(acc int, item strin
 	return acc
 }
 
-// TryFoldForInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
-func (i IteratorForString) TryFoldForInt(init int, reducer func(acc int, item string) (int, bool)) (int, bool) {
+// TryFoldForSliceOfEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForInt) TryFoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item int) (SliceOfEntryForStringToInt, bool)) (SliceOfEntryForStringToInt, bool) {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -228,8 +474,36 @@ func (i IteratorForString) TryFoldForInt(init int, reducer func(acc int, item st
 	return acc, true
 }
 
-// FoldForUint applies a reducer to the Iterator.
-func (i IteratorForString) FoldForUint(init uint, reducer func(acc uint, item string) uint) uint {
+// TryFoldForSliceOfEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForInt) TryFoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item int) (SliceOfEntryForStringToInt, error)) (SliceOfEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForCSVRecord applies a reducer to the Iterator.
+func (i IteratorForInt) FoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item int) CSVRecord) CSVRecord {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -242,8 +516,10 @@ func (i IteratorForString) FoldForUint(init uint, reducer func(acc uint, item st
 	return acc
 }
 
-// TryFoldForUint folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
-func (i IteratorForString) TryFoldForUint(init uint, reducer func(acc uint, item string) (uint, bool)) (uint, bool) {
+// TryFoldForCSVRecord folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForInt) TryFoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item int) (CSVRecord, bool)) (CSVRecord, bool) {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -260,8 +536,36 @@ func (i IteratorForString) TryFoldForUint(init uint, reducer func(acc uint, item
 	return acc, true
 }
 
-// FoldForEmpty applies a reducer to the Iterator.
-func (i IteratorForString) FoldForEmpty(init Empty, reducer func(acc Empty, item string) Empty) Empty {
+// TryFoldForCSVRecord applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForInt) TryFoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item int) (CSVRecord, error)) (CSVRecord, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForInt applies a reducer to the Iterator.
+func (i IteratorForInt) FoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item int) OptionForInt) OptionForInt {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -274,8 +578,10 @@ func (i IteratorForString) FoldForEmpty(init Empty, reducer func(acc Empty, item
 	return acc
 }
 
-// TryFoldForEmpty folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
-func (i IteratorForString) TryFoldForEmpty(init Empty, reducer func(acc Empty, item string) (Empty, bool)) (Empty, bool) {
+// TryFoldForOptionForInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForInt) TryFoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item int) (OptionForInt, bool)) (OptionForInt, bool) {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -292,8 +598,36 @@ func (i IteratorForString) TryFoldForEmpty(init Empty, reducer func(acc Empty, i
 	return acc, true
 }
 
-// FoldForString applies a reducer to the Iterator.
-func (i IteratorForString) FoldForString(init string, reducer func(acc string, item string) string) string {
+// TryFoldForOptionForInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForInt) TryFoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item int) (OptionForInt, error)) (OptionForInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForString applies a reducer to the Iterator.
+func (i IteratorForInt) FoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item int) OptionForString) OptionForString {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -306,8 +640,10 @@ func (i IteratorForString) FoldForString(init string, reducer func(acc string, i
 	return acc
 }
 
-// TryFoldForString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
-func (i IteratorForString) TryFoldForString(init string, reducer func(acc string, item string) (string, bool)) (string, bool) {
+// TryFoldForOptionForString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForInt) TryFoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item int) (OptionForString, bool)) (OptionForString, bool) {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -324,8 +660,36 @@ func (i IteratorForString) TryFoldForString(init string, reducer func(acc string
 	return acc, true
 }
 
-// FoldForOptionForInt applies a reducer to the Iterator.
-func (i IteratorForString) FoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item string) OptionForInt) OptionForInt {
+// TryFoldForOptionForString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForInt) TryFoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item int) (OptionForString, error)) (OptionForString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForInt) FoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item int) OptionForEntryForStringToInt) OptionForEntryForStringToInt {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -338,8 +702,10 @@ func (i IteratorForString) FoldForOptionForInt(init OptionForInt, reducer func(a
 	return acc
 }
 
-// TryFoldForOptionForInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
-func (i IteratorForString) TryFoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item string) (OptionForInt, bool)) (OptionForInt, bool) {
+// TryFoldForOptionForEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForInt) TryFoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item int) (OptionForEntryForStringToInt, bool)) (OptionForEntryForStringToInt, bool) {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -356,8 +722,36 @@ func (i IteratorForString) TryFoldForOptionForInt(init OptionForInt, reducer fun
 	return acc, true
 }
 
-// FoldForOptionForString applies a reducer to the Iterator.
-func (i IteratorForString) FoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item string) OptionForString) OptionForString {
+// TryFoldForOptionForEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForInt) TryFoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item int) (OptionForEntryForStringToInt, error)) (OptionForEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfInt applies a reducer to the Iterator.
+func (i IteratorForInt) FoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item int) OptionForSliceOfInt) OptionForSliceOfInt {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -370,8 +764,10 @@ func (i IteratorForString) FoldForOptionForString(init OptionForString, reducer
 	return acc
 }
 
-// TryFoldForOptionForString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
-func (i IteratorForString) TryFoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item string) (OptionForString, bool)) (OptionForString, bool) {
+// TryFoldForOptionForSliceOfInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForInt) TryFoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item int) (OptionForSliceOfInt, bool)) (OptionForSliceOfInt, bool) {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -387,3 +783,6167 @@ func (i IteratorForString) TryFoldForOptionForString(init OptionForString, reduc
 
 	return acc, true
 }
+
+// TryFoldForOptionForSliceOfInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForInt) TryFoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item int) (OptionForSliceOfInt, error)) (OptionForSliceOfInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfString applies a reducer to the Iterator.
+func (i IteratorForInt) FoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item int) OptionForSliceOfString) OptionForSliceOfString {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForInt) TryFoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item int) (OptionForSliceOfString, bool)) (OptionForSliceOfString, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForInt) TryFoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item int) (OptionForSliceOfString, error)) (OptionForSliceOfString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForInt) FoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item int) OptionForSliceOfEntryForStringToInt) OptionForSliceOfEntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForInt) TryFoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item int) (OptionForSliceOfEntryForStringToInt, bool)) (OptionForSliceOfEntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForInt) TryFoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item int) (OptionForSliceOfEntryForStringToInt, error)) (OptionForSliceOfEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForCSVRecord applies a reducer to the Iterator.
+func (i IteratorForInt) FoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item int) OptionForCSVRecord) OptionForCSVRecord {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForCSVRecord folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForInt) TryFoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item int) (OptionForCSVRecord, bool)) (OptionForCSVRecord, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForCSVRecord applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForInt) TryFoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item int) (OptionForCSVRecord, error)) (OptionForCSVRecord, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForInt applies a reducer to the Iterator.
+func (i IteratorForString) FoldForInt(init int, reducer func(acc int, item string) int) int {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForString) TryFoldForInt(init int, reducer func(acc int, item string) (int, bool)) (int, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForString) TryFoldForInt(init int, reducer func(acc int, item string) (int, error)) (int, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfInt applies a reducer to the Iterator.
+func (i IteratorForString) FoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item string) SliceOfInt) SliceOfInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForSliceOfInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForString) TryFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item string) (SliceOfInt, bool)) (SliceOfInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForSliceOfInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForString) TryFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item string) (SliceOfInt, error)) (SliceOfInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForUint applies a reducer to the Iterator.
+func (i IteratorForString) FoldForUint(init uint, reducer func(acc uint, item string) uint) uint {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForUint folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForString) TryFoldForUint(init uint, reducer func(acc uint, item string) (uint, bool)) (uint, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForUint applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForString) TryFoldForUint(init uint, reducer func(acc uint, item string) (uint, error)) (uint, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForEmpty applies a reducer to the Iterator.
+func (i IteratorForString) FoldForEmpty(init Empty, reducer func(acc Empty, item string) Empty) Empty {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForEmpty folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForString) TryFoldForEmpty(init Empty, reducer func(acc Empty, item string) (Empty, bool)) (Empty, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForEmpty applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForString) TryFoldForEmpty(init Empty, reducer func(acc Empty, item string) (Empty, error)) (Empty, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForString applies a reducer to the Iterator.
+func (i IteratorForString) FoldForString(init string, reducer func(acc string, item string) string) string {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForString) TryFoldForString(init string, reducer func(acc string, item string) (string, bool)) (string, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForString) TryFoldForString(init string, reducer func(acc string, item string) (string, error)) (string, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForString) FoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item string) EntryForStringToInt) EntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForString) TryFoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item string) (EntryForStringToInt, bool)) (EntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForString) TryFoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item string) (EntryForStringToInt, error)) (EntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfString applies a reducer to the Iterator.
+func (i IteratorForString) FoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item string) SliceOfString) SliceOfString {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForSliceOfString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForString) TryFoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item string) (SliceOfString, bool)) (SliceOfString, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForSliceOfString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForString) TryFoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item string) (SliceOfString, error)) (SliceOfString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForString) FoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item string) SliceOfEntryForStringToInt) SliceOfEntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForSliceOfEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForString) TryFoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item string) (SliceOfEntryForStringToInt, bool)) (SliceOfEntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForSliceOfEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForString) TryFoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item string) (SliceOfEntryForStringToInt, error)) (SliceOfEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForCSVRecord applies a reducer to the Iterator.
+func (i IteratorForString) FoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item string) CSVRecord) CSVRecord {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForCSVRecord folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForString) TryFoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item string) (CSVRecord, bool)) (CSVRecord, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForCSVRecord applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForString) TryFoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item string) (CSVRecord, error)) (CSVRecord, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForInt applies a reducer to the Iterator.
+func (i IteratorForString) FoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item string) OptionForInt) OptionForInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForString) TryFoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item string) (OptionForInt, bool)) (OptionForInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForString) TryFoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item string) (OptionForInt, error)) (OptionForInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForString applies a reducer to the Iterator.
+func (i IteratorForString) FoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item string) OptionForString) OptionForString {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForString) TryFoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item string) (OptionForString, bool)) (OptionForString, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForString) TryFoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item string) (OptionForString, error)) (OptionForString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForString) FoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item string) OptionForEntryForStringToInt) OptionForEntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForString) TryFoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item string) (OptionForEntryForStringToInt, bool)) (OptionForEntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForString) TryFoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item string) (OptionForEntryForStringToInt, error)) (OptionForEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfInt applies a reducer to the Iterator.
+func (i IteratorForString) FoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item string) OptionForSliceOfInt) OptionForSliceOfInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForString) TryFoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item string) (OptionForSliceOfInt, bool)) (OptionForSliceOfInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForString) TryFoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item string) (OptionForSliceOfInt, error)) (OptionForSliceOfInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfString applies a reducer to the Iterator.
+func (i IteratorForString) FoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item string) OptionForSliceOfString) OptionForSliceOfString {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForString) TryFoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item string) (OptionForSliceOfString, bool)) (OptionForSliceOfString, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForString) TryFoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item string) (OptionForSliceOfString, error)) (OptionForSliceOfString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForString) FoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item string) OptionForSliceOfEntryForStringToInt) OptionForSliceOfEntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForString) TryFoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item string) (OptionForSliceOfEntryForStringToInt, bool)) (OptionForSliceOfEntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForString) TryFoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item string) (OptionForSliceOfEntryForStringToInt, error)) (OptionForSliceOfEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForCSVRecord applies a reducer to the Iterator.
+func (i IteratorForString) FoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item string) OptionForCSVRecord) OptionForCSVRecord {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForCSVRecord folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForString) TryFoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item string) (OptionForCSVRecord, bool)) (OptionForCSVRecord, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForCSVRecord applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForString) TryFoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item string) (OptionForCSVRecord, error)) (OptionForCSVRecord, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForInt applies a reducer to the Iterator.
+func (i IteratorForEntryForStringToInt) FoldForInt(init int, reducer func(acc int, item EntryForStringToInt) int) int {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForEntryForStringToInt) TryFoldForInt(init int, reducer func(acc int, item EntryForStringToInt) (int, bool)) (int, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForEntryForStringToInt) TryFoldForInt(init int, reducer func(acc int, item EntryForStringToInt) (int, error)) (int, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfInt applies a reducer to the Iterator.
+func (i IteratorForEntryForStringToInt) FoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item EntryForStringToInt) SliceOfInt) SliceOfInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForSliceOfInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForEntryForStringToInt) TryFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item EntryForStringToInt) (SliceOfInt, bool)) (SliceOfInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForSliceOfInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForEntryForStringToInt) TryFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item EntryForStringToInt) (SliceOfInt, error)) (SliceOfInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForUint applies a reducer to the Iterator.
+func (i IteratorForEntryForStringToInt) FoldForUint(init uint, reducer func(acc uint, item EntryForStringToInt) uint) uint {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForUint folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForEntryForStringToInt) TryFoldForUint(init uint, reducer func(acc uint, item EntryForStringToInt) (uint, bool)) (uint, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForUint applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForEntryForStringToInt) TryFoldForUint(init uint, reducer func(acc uint, item EntryForStringToInt) (uint, error)) (uint, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForEmpty applies a reducer to the Iterator.
+func (i IteratorForEntryForStringToInt) FoldForEmpty(init Empty, reducer func(acc Empty, item EntryForStringToInt) Empty) Empty {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForEmpty folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForEntryForStringToInt) TryFoldForEmpty(init Empty, reducer func(acc Empty, item EntryForStringToInt) (Empty, bool)) (Empty, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForEmpty applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForEntryForStringToInt) TryFoldForEmpty(init Empty, reducer func(acc Empty, item EntryForStringToInt) (Empty, error)) (Empty, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForString applies a reducer to the Iterator.
+func (i IteratorForEntryForStringToInt) FoldForString(init string, reducer func(acc string, item EntryForStringToInt) string) string {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForEntryForStringToInt) TryFoldForString(init string, reducer func(acc string, item EntryForStringToInt) (string, bool)) (string, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForEntryForStringToInt) TryFoldForString(init string, reducer func(acc string, item EntryForStringToInt) (string, error)) (string, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForEntryForStringToInt) FoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item EntryForStringToInt) EntryForStringToInt) EntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForEntryForStringToInt) TryFoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item EntryForStringToInt) (EntryForStringToInt, bool)) (EntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForEntryForStringToInt) TryFoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item EntryForStringToInt) (EntryForStringToInt, error)) (EntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfString applies a reducer to the Iterator.
+func (i IteratorForEntryForStringToInt) FoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item EntryForStringToInt) SliceOfString) SliceOfString {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForSliceOfString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForEntryForStringToInt) TryFoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item EntryForStringToInt) (SliceOfString, bool)) (SliceOfString, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForSliceOfString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForEntryForStringToInt) TryFoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item EntryForStringToInt) (SliceOfString, error)) (SliceOfString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForEntryForStringToInt) FoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item EntryForStringToInt) SliceOfEntryForStringToInt) SliceOfEntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForSliceOfEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForEntryForStringToInt) TryFoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item EntryForStringToInt) (SliceOfEntryForStringToInt, bool)) (SliceOfEntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForSliceOfEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForEntryForStringToInt) TryFoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item EntryForStringToInt) (SliceOfEntryForStringToInt, error)) (SliceOfEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForCSVRecord applies a reducer to the Iterator.
+func (i IteratorForEntryForStringToInt) FoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item EntryForStringToInt) CSVRecord) CSVRecord {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForCSVRecord folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForEntryForStringToInt) TryFoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item EntryForStringToInt) (CSVRecord, bool)) (CSVRecord, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForCSVRecord applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForEntryForStringToInt) TryFoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item EntryForStringToInt) (CSVRecord, error)) (CSVRecord, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForInt applies a reducer to the Iterator.
+func (i IteratorForEntryForStringToInt) FoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item EntryForStringToInt) OptionForInt) OptionForInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForEntryForStringToInt) TryFoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item EntryForStringToInt) (OptionForInt, bool)) (OptionForInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForEntryForStringToInt) TryFoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item EntryForStringToInt) (OptionForInt, error)) (OptionForInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForString applies a reducer to the Iterator.
+func (i IteratorForEntryForStringToInt) FoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item EntryForStringToInt) OptionForString) OptionForString {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForEntryForStringToInt) TryFoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item EntryForStringToInt) (OptionForString, bool)) (OptionForString, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForEntryForStringToInt) TryFoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item EntryForStringToInt) (OptionForString, error)) (OptionForString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForEntryForStringToInt) FoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item EntryForStringToInt) OptionForEntryForStringToInt) OptionForEntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForEntryForStringToInt) TryFoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item EntryForStringToInt) (OptionForEntryForStringToInt, bool)) (OptionForEntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForEntryForStringToInt) TryFoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item EntryForStringToInt) (OptionForEntryForStringToInt, error)) (OptionForEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfInt applies a reducer to the Iterator.
+func (i IteratorForEntryForStringToInt) FoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item EntryForStringToInt) OptionForSliceOfInt) OptionForSliceOfInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForEntryForStringToInt) TryFoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item EntryForStringToInt) (OptionForSliceOfInt, bool)) (OptionForSliceOfInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForEntryForStringToInt) TryFoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item EntryForStringToInt) (OptionForSliceOfInt, error)) (OptionForSliceOfInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfString applies a reducer to the Iterator.
+func (i IteratorForEntryForStringToInt) FoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item EntryForStringToInt) OptionForSliceOfString) OptionForSliceOfString {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForEntryForStringToInt) TryFoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item EntryForStringToInt) (OptionForSliceOfString, bool)) (OptionForSliceOfString, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForEntryForStringToInt) TryFoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item EntryForStringToInt) (OptionForSliceOfString, error)) (OptionForSliceOfString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForEntryForStringToInt) FoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item EntryForStringToInt) OptionForSliceOfEntryForStringToInt) OptionForSliceOfEntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForEntryForStringToInt) TryFoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item EntryForStringToInt) (OptionForSliceOfEntryForStringToInt, bool)) (OptionForSliceOfEntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForEntryForStringToInt) TryFoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item EntryForStringToInt) (OptionForSliceOfEntryForStringToInt, error)) (OptionForSliceOfEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForCSVRecord applies a reducer to the Iterator.
+func (i IteratorForEntryForStringToInt) FoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item EntryForStringToInt) OptionForCSVRecord) OptionForCSVRecord {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForCSVRecord folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForEntryForStringToInt) TryFoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item EntryForStringToInt) (OptionForCSVRecord, bool)) (OptionForCSVRecord, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForCSVRecord applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForEntryForStringToInt) TryFoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item EntryForStringToInt) (OptionForCSVRecord, error)) (OptionForCSVRecord, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfInt) FoldForInt(init int, reducer func(acc int, item SliceOfInt) int) int {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfInt) TryFoldForInt(init int, reducer func(acc int, item SliceOfInt) (int, bool)) (int, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfInt) TryFoldForInt(init int, reducer func(acc int, item SliceOfInt) (int, error)) (int, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfInt) FoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfInt) SliceOfInt) SliceOfInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForSliceOfInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfInt) TryFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfInt) (SliceOfInt, bool)) (SliceOfInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForSliceOfInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfInt) TryFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfInt) (SliceOfInt, error)) (SliceOfInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForUint applies a reducer to the Iterator.
+func (i IteratorForSliceOfInt) FoldForUint(init uint, reducer func(acc uint, item SliceOfInt) uint) uint {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForUint folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfInt) TryFoldForUint(init uint, reducer func(acc uint, item SliceOfInt) (uint, bool)) (uint, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForUint applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfInt) TryFoldForUint(init uint, reducer func(acc uint, item SliceOfInt) (uint, error)) (uint, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForEmpty applies a reducer to the Iterator.
+func (i IteratorForSliceOfInt) FoldForEmpty(init Empty, reducer func(acc Empty, item SliceOfInt) Empty) Empty {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForEmpty folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfInt) TryFoldForEmpty(init Empty, reducer func(acc Empty, item SliceOfInt) (Empty, bool)) (Empty, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForEmpty applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfInt) TryFoldForEmpty(init Empty, reducer func(acc Empty, item SliceOfInt) (Empty, error)) (Empty, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForString applies a reducer to the Iterator.
+func (i IteratorForSliceOfInt) FoldForString(init string, reducer func(acc string, item SliceOfInt) string) string {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfInt) TryFoldForString(init string, reducer func(acc string, item SliceOfInt) (string, bool)) (string, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfInt) TryFoldForString(init string, reducer func(acc string, item SliceOfInt) (string, error)) (string, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfInt) FoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item SliceOfInt) EntryForStringToInt) EntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfInt) TryFoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item SliceOfInt) (EntryForStringToInt, bool)) (EntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfInt) TryFoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item SliceOfInt) (EntryForStringToInt, error)) (EntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfString applies a reducer to the Iterator.
+func (i IteratorForSliceOfInt) FoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item SliceOfInt) SliceOfString) SliceOfString {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForSliceOfString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfInt) TryFoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item SliceOfInt) (SliceOfString, bool)) (SliceOfString, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForSliceOfString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfInt) TryFoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item SliceOfInt) (SliceOfString, error)) (SliceOfString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfInt) FoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item SliceOfInt) SliceOfEntryForStringToInt) SliceOfEntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForSliceOfEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfInt) TryFoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item SliceOfInt) (SliceOfEntryForStringToInt, bool)) (SliceOfEntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForSliceOfEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfInt) TryFoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item SliceOfInt) (SliceOfEntryForStringToInt, error)) (SliceOfEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForCSVRecord applies a reducer to the Iterator.
+func (i IteratorForSliceOfInt) FoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item SliceOfInt) CSVRecord) CSVRecord {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForCSVRecord folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfInt) TryFoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item SliceOfInt) (CSVRecord, bool)) (CSVRecord, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForCSVRecord applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfInt) TryFoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item SliceOfInt) (CSVRecord, error)) (CSVRecord, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfInt) FoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item SliceOfInt) OptionForInt) OptionForInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfInt) TryFoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item SliceOfInt) (OptionForInt, bool)) (OptionForInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfInt) TryFoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item SliceOfInt) (OptionForInt, error)) (OptionForInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForString applies a reducer to the Iterator.
+func (i IteratorForSliceOfInt) FoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item SliceOfInt) OptionForString) OptionForString {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfInt) TryFoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item SliceOfInt) (OptionForString, bool)) (OptionForString, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfInt) TryFoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item SliceOfInt) (OptionForString, error)) (OptionForString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfInt) FoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item SliceOfInt) OptionForEntryForStringToInt) OptionForEntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfInt) TryFoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item SliceOfInt) (OptionForEntryForStringToInt, bool)) (OptionForEntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfInt) TryFoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item SliceOfInt) (OptionForEntryForStringToInt, error)) (OptionForEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfInt) FoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item SliceOfInt) OptionForSliceOfInt) OptionForSliceOfInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfInt) TryFoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item SliceOfInt) (OptionForSliceOfInt, bool)) (OptionForSliceOfInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfInt) TryFoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item SliceOfInt) (OptionForSliceOfInt, error)) (OptionForSliceOfInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfString applies a reducer to the Iterator.
+func (i IteratorForSliceOfInt) FoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item SliceOfInt) OptionForSliceOfString) OptionForSliceOfString {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfInt) TryFoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item SliceOfInt) (OptionForSliceOfString, bool)) (OptionForSliceOfString, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfInt) TryFoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item SliceOfInt) (OptionForSliceOfString, error)) (OptionForSliceOfString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfInt) FoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item SliceOfInt) OptionForSliceOfEntryForStringToInt) OptionForSliceOfEntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfInt) TryFoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item SliceOfInt) (OptionForSliceOfEntryForStringToInt, bool)) (OptionForSliceOfEntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfInt) TryFoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item SliceOfInt) (OptionForSliceOfEntryForStringToInt, error)) (OptionForSliceOfEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForCSVRecord applies a reducer to the Iterator.
+func (i IteratorForSliceOfInt) FoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item SliceOfInt) OptionForCSVRecord) OptionForCSVRecord {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForCSVRecord folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfInt) TryFoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item SliceOfInt) (OptionForCSVRecord, bool)) (OptionForCSVRecord, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForCSVRecord applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfInt) TryFoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item SliceOfInt) (OptionForCSVRecord, error)) (OptionForCSVRecord, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfString) FoldForInt(init int, reducer func(acc int, item SliceOfString) int) int {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfString) TryFoldForInt(init int, reducer func(acc int, item SliceOfString) (int, bool)) (int, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfString) TryFoldForInt(init int, reducer func(acc int, item SliceOfString) (int, error)) (int, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfString) FoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfString) SliceOfInt) SliceOfInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForSliceOfInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfString) TryFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfString) (SliceOfInt, bool)) (SliceOfInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForSliceOfInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfString) TryFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfString) (SliceOfInt, error)) (SliceOfInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForUint applies a reducer to the Iterator.
+func (i IteratorForSliceOfString) FoldForUint(init uint, reducer func(acc uint, item SliceOfString) uint) uint {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForUint folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfString) TryFoldForUint(init uint, reducer func(acc uint, item SliceOfString) (uint, bool)) (uint, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForUint applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfString) TryFoldForUint(init uint, reducer func(acc uint, item SliceOfString) (uint, error)) (uint, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForEmpty applies a reducer to the Iterator.
+func (i IteratorForSliceOfString) FoldForEmpty(init Empty, reducer func(acc Empty, item SliceOfString) Empty) Empty {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForEmpty folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfString) TryFoldForEmpty(init Empty, reducer func(acc Empty, item SliceOfString) (Empty, bool)) (Empty, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForEmpty applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfString) TryFoldForEmpty(init Empty, reducer func(acc Empty, item SliceOfString) (Empty, error)) (Empty, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForString applies a reducer to the Iterator.
+func (i IteratorForSliceOfString) FoldForString(init string, reducer func(acc string, item SliceOfString) string) string {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfString) TryFoldForString(init string, reducer func(acc string, item SliceOfString) (string, bool)) (string, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfString) TryFoldForString(init string, reducer func(acc string, item SliceOfString) (string, error)) (string, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfString) FoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item SliceOfString) EntryForStringToInt) EntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfString) TryFoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item SliceOfString) (EntryForStringToInt, bool)) (EntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfString) TryFoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item SliceOfString) (EntryForStringToInt, error)) (EntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfString applies a reducer to the Iterator.
+func (i IteratorForSliceOfString) FoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item SliceOfString) SliceOfString) SliceOfString {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForSliceOfString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfString) TryFoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item SliceOfString) (SliceOfString, bool)) (SliceOfString, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForSliceOfString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfString) TryFoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item SliceOfString) (SliceOfString, error)) (SliceOfString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfString) FoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item SliceOfString) SliceOfEntryForStringToInt) SliceOfEntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForSliceOfEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfString) TryFoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item SliceOfString) (SliceOfEntryForStringToInt, bool)) (SliceOfEntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForSliceOfEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfString) TryFoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item SliceOfString) (SliceOfEntryForStringToInt, error)) (SliceOfEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForCSVRecord applies a reducer to the Iterator.
+func (i IteratorForSliceOfString) FoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item SliceOfString) CSVRecord) CSVRecord {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForCSVRecord folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfString) TryFoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item SliceOfString) (CSVRecord, bool)) (CSVRecord, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForCSVRecord applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfString) TryFoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item SliceOfString) (CSVRecord, error)) (CSVRecord, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfString) FoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item SliceOfString) OptionForInt) OptionForInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfString) TryFoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item SliceOfString) (OptionForInt, bool)) (OptionForInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfString) TryFoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item SliceOfString) (OptionForInt, error)) (OptionForInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForString applies a reducer to the Iterator.
+func (i IteratorForSliceOfString) FoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item SliceOfString) OptionForString) OptionForString {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfString) TryFoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item SliceOfString) (OptionForString, bool)) (OptionForString, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfString) TryFoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item SliceOfString) (OptionForString, error)) (OptionForString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfString) FoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item SliceOfString) OptionForEntryForStringToInt) OptionForEntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfString) TryFoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item SliceOfString) (OptionForEntryForStringToInt, bool)) (OptionForEntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfString) TryFoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item SliceOfString) (OptionForEntryForStringToInt, error)) (OptionForEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfString) FoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item SliceOfString) OptionForSliceOfInt) OptionForSliceOfInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfString) TryFoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item SliceOfString) (OptionForSliceOfInt, bool)) (OptionForSliceOfInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfString) TryFoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item SliceOfString) (OptionForSliceOfInt, error)) (OptionForSliceOfInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfString applies a reducer to the Iterator.
+func (i IteratorForSliceOfString) FoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item SliceOfString) OptionForSliceOfString) OptionForSliceOfString {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfString) TryFoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item SliceOfString) (OptionForSliceOfString, bool)) (OptionForSliceOfString, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfString) TryFoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item SliceOfString) (OptionForSliceOfString, error)) (OptionForSliceOfString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfString) FoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item SliceOfString) OptionForSliceOfEntryForStringToInt) OptionForSliceOfEntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfString) TryFoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item SliceOfString) (OptionForSliceOfEntryForStringToInt, bool)) (OptionForSliceOfEntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfString) TryFoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item SliceOfString) (OptionForSliceOfEntryForStringToInt, error)) (OptionForSliceOfEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForCSVRecord applies a reducer to the Iterator.
+func (i IteratorForSliceOfString) FoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item SliceOfString) OptionForCSVRecord) OptionForCSVRecord {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForCSVRecord folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfString) TryFoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item SliceOfString) (OptionForCSVRecord, bool)) (OptionForCSVRecord, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForCSVRecord applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfString) TryFoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item SliceOfString) (OptionForCSVRecord, error)) (OptionForCSVRecord, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) FoldForInt(init int, reducer func(acc int, item SliceOfEntryForStringToInt) int) int {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfEntryForStringToInt) TryFoldForInt(init int, reducer func(acc int, item SliceOfEntryForStringToInt) (int, bool)) (int, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfEntryForStringToInt) TryFoldForInt(init int, reducer func(acc int, item SliceOfEntryForStringToInt) (int, error)) (int, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) FoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfEntryForStringToInt) SliceOfInt) SliceOfInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForSliceOfInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfEntryForStringToInt) TryFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfEntryForStringToInt) (SliceOfInt, bool)) (SliceOfInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForSliceOfInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfEntryForStringToInt) TryFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfEntryForStringToInt) (SliceOfInt, error)) (SliceOfInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForUint applies a reducer to the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) FoldForUint(init uint, reducer func(acc uint, item SliceOfEntryForStringToInt) uint) uint {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForUint folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfEntryForStringToInt) TryFoldForUint(init uint, reducer func(acc uint, item SliceOfEntryForStringToInt) (uint, bool)) (uint, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForUint applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfEntryForStringToInt) TryFoldForUint(init uint, reducer func(acc uint, item SliceOfEntryForStringToInt) (uint, error)) (uint, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForEmpty applies a reducer to the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) FoldForEmpty(init Empty, reducer func(acc Empty, item SliceOfEntryForStringToInt) Empty) Empty {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForEmpty folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfEntryForStringToInt) TryFoldForEmpty(init Empty, reducer func(acc Empty, item SliceOfEntryForStringToInt) (Empty, bool)) (Empty, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForEmpty applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfEntryForStringToInt) TryFoldForEmpty(init Empty, reducer func(acc Empty, item SliceOfEntryForStringToInt) (Empty, error)) (Empty, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForString applies a reducer to the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) FoldForString(init string, reducer func(acc string, item SliceOfEntryForStringToInt) string) string {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfEntryForStringToInt) TryFoldForString(init string, reducer func(acc string, item SliceOfEntryForStringToInt) (string, bool)) (string, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfEntryForStringToInt) TryFoldForString(init string, reducer func(acc string, item SliceOfEntryForStringToInt) (string, error)) (string, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) FoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item SliceOfEntryForStringToInt) EntryForStringToInt) EntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfEntryForStringToInt) TryFoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item SliceOfEntryForStringToInt) (EntryForStringToInt, bool)) (EntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfEntryForStringToInt) TryFoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item SliceOfEntryForStringToInt) (EntryForStringToInt, error)) (EntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfString applies a reducer to the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) FoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item SliceOfEntryForStringToInt) SliceOfString) SliceOfString {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForSliceOfString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfEntryForStringToInt) TryFoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item SliceOfEntryForStringToInt) (SliceOfString, bool)) (SliceOfString, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForSliceOfString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfEntryForStringToInt) TryFoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item SliceOfEntryForStringToInt) (SliceOfString, error)) (SliceOfString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) FoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item SliceOfEntryForStringToInt) SliceOfEntryForStringToInt) SliceOfEntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForSliceOfEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfEntryForStringToInt) TryFoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item SliceOfEntryForStringToInt) (SliceOfEntryForStringToInt, bool)) (SliceOfEntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForSliceOfEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfEntryForStringToInt) TryFoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item SliceOfEntryForStringToInt) (SliceOfEntryForStringToInt, error)) (SliceOfEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForCSVRecord applies a reducer to the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) FoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item SliceOfEntryForStringToInt) CSVRecord) CSVRecord {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForCSVRecord folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfEntryForStringToInt) TryFoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item SliceOfEntryForStringToInt) (CSVRecord, bool)) (CSVRecord, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForCSVRecord applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfEntryForStringToInt) TryFoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item SliceOfEntryForStringToInt) (CSVRecord, error)) (CSVRecord, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) FoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item SliceOfEntryForStringToInt) OptionForInt) OptionForInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfEntryForStringToInt) TryFoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item SliceOfEntryForStringToInt) (OptionForInt, bool)) (OptionForInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfEntryForStringToInt) TryFoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item SliceOfEntryForStringToInt) (OptionForInt, error)) (OptionForInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForString applies a reducer to the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) FoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item SliceOfEntryForStringToInt) OptionForString) OptionForString {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfEntryForStringToInt) TryFoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item SliceOfEntryForStringToInt) (OptionForString, bool)) (OptionForString, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfEntryForStringToInt) TryFoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item SliceOfEntryForStringToInt) (OptionForString, error)) (OptionForString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) FoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item SliceOfEntryForStringToInt) OptionForEntryForStringToInt) OptionForEntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfEntryForStringToInt) TryFoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item SliceOfEntryForStringToInt) (OptionForEntryForStringToInt, bool)) (OptionForEntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfEntryForStringToInt) TryFoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item SliceOfEntryForStringToInt) (OptionForEntryForStringToInt, error)) (OptionForEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) FoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item SliceOfEntryForStringToInt) OptionForSliceOfInt) OptionForSliceOfInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfEntryForStringToInt) TryFoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item SliceOfEntryForStringToInt) (OptionForSliceOfInt, bool)) (OptionForSliceOfInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfEntryForStringToInt) TryFoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item SliceOfEntryForStringToInt) (OptionForSliceOfInt, error)) (OptionForSliceOfInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfString applies a reducer to the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) FoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item SliceOfEntryForStringToInt) OptionForSliceOfString) OptionForSliceOfString {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfEntryForStringToInt) TryFoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item SliceOfEntryForStringToInt) (OptionForSliceOfString, bool)) (OptionForSliceOfString, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfEntryForStringToInt) TryFoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item SliceOfEntryForStringToInt) (OptionForSliceOfString, error)) (OptionForSliceOfString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) FoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item SliceOfEntryForStringToInt) OptionForSliceOfEntryForStringToInt) OptionForSliceOfEntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfEntryForStringToInt) TryFoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item SliceOfEntryForStringToInt) (OptionForSliceOfEntryForStringToInt, bool)) (OptionForSliceOfEntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfEntryForStringToInt) TryFoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item SliceOfEntryForStringToInt) (OptionForSliceOfEntryForStringToInt, error)) (OptionForSliceOfEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForCSVRecord applies a reducer to the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) FoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item SliceOfEntryForStringToInt) OptionForCSVRecord) OptionForCSVRecord {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForCSVRecord folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForSliceOfEntryForStringToInt) TryFoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item SliceOfEntryForStringToInt) (OptionForCSVRecord, bool)) (OptionForCSVRecord, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForCSVRecord applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForSliceOfEntryForStringToInt) TryFoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item SliceOfEntryForStringToInt) (OptionForCSVRecord, error)) (OptionForCSVRecord, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForInt applies a reducer to the Iterator.
+func (i IteratorForCSVRecord) FoldForInt(init int, reducer func(acc int, item CSVRecord) int) int {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForCSVRecord) TryFoldForInt(init int, reducer func(acc int, item CSVRecord) (int, bool)) (int, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForCSVRecord) TryFoldForInt(init int, reducer func(acc int, item CSVRecord) (int, error)) (int, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfInt applies a reducer to the Iterator.
+func (i IteratorForCSVRecord) FoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item CSVRecord) SliceOfInt) SliceOfInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForSliceOfInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForCSVRecord) TryFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item CSVRecord) (SliceOfInt, bool)) (SliceOfInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForSliceOfInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForCSVRecord) TryFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item CSVRecord) (SliceOfInt, error)) (SliceOfInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForUint applies a reducer to the Iterator.
+func (i IteratorForCSVRecord) FoldForUint(init uint, reducer func(acc uint, item CSVRecord) uint) uint {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForUint folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForCSVRecord) TryFoldForUint(init uint, reducer func(acc uint, item CSVRecord) (uint, bool)) (uint, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForUint applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForCSVRecord) TryFoldForUint(init uint, reducer func(acc uint, item CSVRecord) (uint, error)) (uint, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForEmpty applies a reducer to the Iterator.
+func (i IteratorForCSVRecord) FoldForEmpty(init Empty, reducer func(acc Empty, item CSVRecord) Empty) Empty {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForEmpty folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForCSVRecord) TryFoldForEmpty(init Empty, reducer func(acc Empty, item CSVRecord) (Empty, bool)) (Empty, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForEmpty applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForCSVRecord) TryFoldForEmpty(init Empty, reducer func(acc Empty, item CSVRecord) (Empty, error)) (Empty, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForString applies a reducer to the Iterator.
+func (i IteratorForCSVRecord) FoldForString(init string, reducer func(acc string, item CSVRecord) string) string {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForCSVRecord) TryFoldForString(init string, reducer func(acc string, item CSVRecord) (string, bool)) (string, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForCSVRecord) TryFoldForString(init string, reducer func(acc string, item CSVRecord) (string, error)) (string, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForCSVRecord) FoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item CSVRecord) EntryForStringToInt) EntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForCSVRecord) TryFoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item CSVRecord) (EntryForStringToInt, bool)) (EntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForCSVRecord) TryFoldForEntryForStringToInt(init EntryForStringToInt, reducer func(acc EntryForStringToInt, item CSVRecord) (EntryForStringToInt, error)) (EntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfString applies a reducer to the Iterator.
+func (i IteratorForCSVRecord) FoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item CSVRecord) SliceOfString) SliceOfString {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForSliceOfString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForCSVRecord) TryFoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item CSVRecord) (SliceOfString, bool)) (SliceOfString, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForSliceOfString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForCSVRecord) TryFoldForSliceOfString(init SliceOfString, reducer func(acc SliceOfString, item CSVRecord) (SliceOfString, error)) (SliceOfString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForSliceOfEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForCSVRecord) FoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item CSVRecord) SliceOfEntryForStringToInt) SliceOfEntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForSliceOfEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForCSVRecord) TryFoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item CSVRecord) (SliceOfEntryForStringToInt, bool)) (SliceOfEntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForSliceOfEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForCSVRecord) TryFoldForSliceOfEntryForStringToInt(init SliceOfEntryForStringToInt, reducer func(acc SliceOfEntryForStringToInt, item CSVRecord) (SliceOfEntryForStringToInt, error)) (SliceOfEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForCSVRecord applies a reducer to the Iterator.
+func (i IteratorForCSVRecord) FoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item CSVRecord) CSVRecord) CSVRecord {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForCSVRecord folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForCSVRecord) TryFoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item CSVRecord) (CSVRecord, bool)) (CSVRecord, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForCSVRecord applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForCSVRecord) TryFoldForCSVRecord(init CSVRecord, reducer func(acc CSVRecord, item CSVRecord) (CSVRecord, error)) (CSVRecord, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForInt applies a reducer to the Iterator.
+func (i IteratorForCSVRecord) FoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item CSVRecord) OptionForInt) OptionForInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForCSVRecord) TryFoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item CSVRecord) (OptionForInt, bool)) (OptionForInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForCSVRecord) TryFoldForOptionForInt(init OptionForInt, reducer func(acc OptionForInt, item CSVRecord) (OptionForInt, error)) (OptionForInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForString applies a reducer to the Iterator.
+func (i IteratorForCSVRecord) FoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item CSVRecord) OptionForString) OptionForString {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForCSVRecord) TryFoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item CSVRecord) (OptionForString, bool)) (OptionForString, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForCSVRecord) TryFoldForOptionForString(init OptionForString, reducer func(acc OptionForString, item CSVRecord) (OptionForString, error)) (OptionForString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForCSVRecord) FoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item CSVRecord) OptionForEntryForStringToInt) OptionForEntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForCSVRecord) TryFoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item CSVRecord) (OptionForEntryForStringToInt, bool)) (OptionForEntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForCSVRecord) TryFoldForOptionForEntryForStringToInt(init OptionForEntryForStringToInt, reducer func(acc OptionForEntryForStringToInt, item CSVRecord) (OptionForEntryForStringToInt, error)) (OptionForEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfInt applies a reducer to the Iterator.
+func (i IteratorForCSVRecord) FoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item CSVRecord) OptionForSliceOfInt) OptionForSliceOfInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForCSVRecord) TryFoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item CSVRecord) (OptionForSliceOfInt, bool)) (OptionForSliceOfInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForCSVRecord) TryFoldForOptionForSliceOfInt(init OptionForSliceOfInt, reducer func(acc OptionForSliceOfInt, item CSVRecord) (OptionForSliceOfInt, error)) (OptionForSliceOfInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfString applies a reducer to the Iterator.
+func (i IteratorForCSVRecord) FoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item CSVRecord) OptionForSliceOfString) OptionForSliceOfString {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfString folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForCSVRecord) TryFoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item CSVRecord) (OptionForSliceOfString, bool)) (OptionForSliceOfString, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfString applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForCSVRecord) TryFoldForOptionForSliceOfString(init OptionForSliceOfString, reducer func(acc OptionForSliceOfString, item CSVRecord) (OptionForSliceOfString, error)) (OptionForSliceOfString, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForSliceOfEntryForStringToInt applies a reducer to the Iterator.
+func (i IteratorForCSVRecord) FoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item CSVRecord) OptionForSliceOfEntryForStringToInt) OptionForSliceOfEntryForStringToInt {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForSliceOfEntryForStringToInt folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForCSVRecord) TryFoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item CSVRecord) (OptionForSliceOfEntryForStringToInt, bool)) (OptionForSliceOfEntryForStringToInt, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForSliceOfEntryForStringToInt applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForCSVRecord) TryFoldForOptionForSliceOfEntryForStringToInt(init OptionForSliceOfEntryForStringToInt, reducer func(acc OptionForSliceOfEntryForStringToInt, item CSVRecord) (OptionForSliceOfEntryForStringToInt, error)) (OptionForSliceOfEntryForStringToInt, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
+
+// FoldForOptionForCSVRecord applies a reducer to the Iterator.
+func (i IteratorForCSVRecord) FoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item CSVRecord) OptionForCSVRecord) OptionForCSVRecord {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		acc = reducer(acc, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return acc
+}
+
+// TryFoldForOptionForCSVRecord folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
+func (i IteratorForCSVRecord) TryFoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item CSVRecord) (OptionForCSVRecord, bool)) (OptionForCSVRecord, bool) {
+	defer i.Close()
+
+	acc := init
+
+	item := i.Next()
+	for item.IsSome() {
+		r, ok := reducer(acc, item.Unwrap())
+		if !ok {
+			return r, ok
+		}
+
+		acc = r
+		item = i.Next()
+	}
+
+	return acc, true
+}
+
+// TryFoldForOptionForCSVRecord applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForCSVRecord) TryFoldForOptionForCSVRecord(init OptionForCSVRecord, reducer func(acc OptionForCSVRecord, item CSVRecord) (OptionForCSVRecord, error)) (OptionForCSVRecord, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
@@ -0,0 +1,95 @@
+package iter
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// LinesOf builds an Iterator yielding the lines read from r, without their end-of-line marker.
+// r is not closed. Read errors are available through the Iterator Err method.
+func LinesOf(r io.Reader) IteratorForString {
+	return ScanOf(r, bufio.ScanLines)
+}
+
+// WordsOf builds an Iterator yielding the space-separated words read from r.
+// r is not closed. Read errors are available through the Iterator Err method.
+func WordsOf(r io.Reader) IteratorForString {
+	return ScanOf(r, bufio.ScanWords)
+}
+
+// RunesOf builds an Iterator yielding the UTF-8 encoded runes read from r.
+// r is not closed. Read errors are available through the Iterator Err method.
+func RunesOf(r io.Reader) IteratorForString {
+	return ScanOf(r, bufio.ScanRunes)
+}
+
+// BytesOf builds an Iterator yielding the bytes read from r.
+// r is not closed. Read errors are available through the Iterator Err method.
+func BytesOf(r io.Reader) IteratorForString {
+	return ScanOf(r, bufio.ScanBytes)
+}
+
+// ScanOf builds an Iterator yielding the tokens read from r with a split function.
+// r is not closed. Read errors are available through the Iterator Err method.
+func ScanOf(r io.Reader, split bufio.SplitFunc) IteratorForString {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(split)
+
+	return ScannerOf(scanner)
+}
+
+// ScannerOf builds an Iterator yielding the tokens of a Scanner, for instance one with a larger buffer.
+// Scanner errors are available through the Iterator Err method.
+func ScannerOf(scanner *bufio.Scanner) IteratorForString {
+	return IteratorForString{
+		iter: &scannerIterable{scanner: scanner},
+	}
+}
+
+// SplitOn returns a split function for ScanOf yielding the tokens separated by sep.
+func SplitOn(sep string) bufio.SplitFunc {
+	if sep == "" {
+		panic("Called `SplitOn` with an empty separator.")
+	}
+
+	separator := []byte(sep)
+
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+
+		if index := bytes.Index(data, separator); index >= 0 {
+			return index + len(separator), data[:index], nil
+		}
+
+		if atEOF {
+			return len(data), data, nil
+		}
+
+		return 0, nil, nil
+	}
+}
+
+type scannerIterable struct {
+	scanner *bufio.Scanner
+}
+
+func (s *scannerIterable) Next() OptionForString {
+	if !s.scanner.Scan() {
+		return NoneString()
+	}
+
+	return SomeString(s.scanner.Text())
+}
+
+func (s *scannerIterable) SizeHint() (uint, OptionForUint) {
+	return 0, NoneUint()
+}
+
+func (s *scannerIterable) Err() error {
+	return s.scanner.Err()
+}
+
+var _ IterableForString = &scannerIterable{}
@@ -0,0 +1,57 @@
+package iter
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestReaderSources(t *testing.T) {
+	text := "Lorem ipsum\ndolor  sit\r\n\namét"
+
+	testCases := map[IteratorForString][]string{
+		LinesOf(strings.NewReader("")):                     {},
+		LinesOf(strings.NewReader(text)):                   {"Lorem ipsum", "dolor  sit", "", "amét"},
+		WordsOf(strings.NewReader(text)):                   {"Lorem", "ipsum", "dolor", "sit", "amét"},
+		RunesOf(strings.NewReader("amét")):                 {"a", "m", "é", "t"},
+		BytesOf(strings.NewReader("ab")):                   {"a", "b"},
+		ScanOf(strings.NewReader("a,b,,c"), SplitOn(",")):  {"a", "b", "", "c"},
+		ScanOf(strings.NewReader("a::b::"), SplitOn("::")): {"a", "b"},
+	}
+
+	for iter, want := range testCases {
+		got := iter.Collect()
+
+		if !reflect.DeepEqual(got, want) || iter.Err() != nil {
+			t.Errorf("case: %v; got: (%q, %v); expected: (%q, %v)", iter, got, iter.Err(), want, nil)
+		}
+	}
+}
+
+func TestReaderSourcesError(t *testing.T) {
+	errRead := errors.New("read failed")
+	iter := LinesOf(io.MultiReader(strings.NewReader("a\nb\n"), iotest.ErrReader(errRead)))
+
+	got := iter.Filter(isNotEmpty).Collect()
+
+	want := []string{"a", "b"}
+	if !reflect.DeepEqual(got, want) || iter.Err() != errRead {
+		t.Errorf("got: (%q, %v); expected: (%q, %v)", got, iter.Err(), want, errRead)
+	}
+}
+
+func TestScannerOf(t *testing.T) {
+	scanner := bufio.NewScanner(strings.NewReader(strings.Repeat("a", 100)))
+	scanner.Buffer(make([]byte, 10), 10)
+
+	iter := ScannerOf(scanner)
+	got := iter.Count()
+
+	if got != 0 || iter.Err() != bufio.ErrTooLong {
+		t.Errorf("got: (%d, %v); expected: (%d, %v)", got, iter.Err(), 0, bufio.ErrTooLong)
+	}
+}
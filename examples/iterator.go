@@ -4,9 +4,37 @@
 
 package iter
 
+import (
+	"fmt"
+	"strings"
+)
+
 // IterableForInt describes a struct that can be iterated over.
+// Iterables holding resources can also implement io.Closer: adapters pass Close through to their sources,
+// and terminal operations close them once exhausted or short-circuited.
 type IterableForInt interface {
 	Next() OptionForInt
+	SizeHint() (lower uint, upper OptionForUint)
+}
+
+// CloneableForInt is optionally implemented by Iterables which can be copied along with their progress.
+type CloneableForInt interface {
+	Clone() (IterableForInt, bool)
+}
+
+// ResettableForInt is optionally implemented by Iterables which can rewind to their first element.
+type ResettableForInt interface {
+	Reset()
+}
+
+// SinkForInt describes a container which elements can be pushed to, such as a buffer or a ring.
+type SinkForInt interface {
+	Push(item int)
+}
+
+// splittableForInt describes an Iterable which can be split into independent parts.
+type splittableForInt interface {
+	split(parts uint) []IterableForInt
 }
 
 // IteratorForInt embeds an Iterable and provides util functions for it.
@@ -22,6 +50,44 @@ func (i IteratorForInt) Next() OptionForInt {
 	return i.iter.Next()
 }
 
+// SizeHint returns the bounds on the number of elements left in the Iterator.
+// The upper bound is None if it is unknown or does not fit in a uint.
+func (i IteratorForInt) SizeHint() (lower uint, upper OptionForUint) {
+	return i.iter.SizeHint()
+}
+
+// Err returns the error which ended the Iterator early, if any.
+func (i IteratorForInt) Err() error {
+	return errOf(i.iter)
+}
+
+// Close releases the resources held by the Iterator sources, if any.
+func (i IteratorForInt) Close() error {
+	return closeOf(i.iter)
+}
+
+// Clone returns a copy of the Iterator which can be advanced independently from it.
+// It returns false if the Iterator is not only built on Cloneable Iterables.
+func (i IteratorForInt) Clone() (IteratorForInt, bool) {
+	clone, ok := cloneOfInt(i.iter)
+	if !ok {
+		return i, false
+	}
+
+	return IteratorForInt{iter: clone}, true
+}
+
+// Reset rewinds the Iterator to its first element.
+// It returns false if the Iterator is not directly built on a Resettable source such as a Vector or a Range.
+func (i IteratorForInt) Reset() bool {
+	r, ok := i.iter.(ResettableForInt)
+	if ok {
+		r.Reset()
+	}
+
+	return ok
+}
+
 // AdvanceBy calls Next n times.
 // It returns an error if it reached the end of the Iterator
 // before it finished to iterate.
@@ -48,31 +114,104 @@ func (i IteratorForInt) Skip(n uint) IteratorForInt {
 }
 
 // Collect returns a slice containing the elements of the Iterator.
+// The slice is preallocated with the lower bound of the Iterator SizeHint.
 func (i IteratorForInt) Collect() []int {
-	collected := []int{}
+	lower, _ := i.SizeHint()
+
+	return i.AppendTo(make([]int, 0, lower))
+}
+
+// AppendTo appends the elements of the Iterator to dst and returns the extended slice.
+// dst is only reallocated if its capacity is lower than required by the lower bound of the Iterator SizeHint,
+// so that a buffer can be reused with AppendTo(buffer[:0]).
+func (i IteratorForInt) AppendTo(dst []int) []int {
+	defer i.Close()
+
+	if lower, _ := i.SizeHint(); uint(cap(dst)-len(dst)) < lower {
+		grown := make([]int, len(dst), uint(len(dst))+lower)
+		copy(grown, dst)
+		dst = grown
+	}
 
 	item := i.Next()
 	for item.IsSome() {
-		collected = append(collected, item.Unwrap())
+		dst = append(dst, item.Unwrap())
 
 		item = i.Next()
 	}
 
-	return collected
+	return dst
+}
+
+// Extend pushes every element of the Iterator to sink.
+func (i IteratorForInt) Extend(sink SinkForInt) {
+	i.ForEach(sink.Push)
+}
+
+// Partition returns two slices containing the elements of the Iterator that validate a predicate and the others.
+func (i IteratorForInt) Partition(predicate func(item int) bool) ([]int, []int) {
+	matching, others := []int{}, []int{}
+
+	i.ForEach(func(item int) {
+		if predicate(item) {
+			matching = append(matching, item)
+		} else {
+			others = append(others, item)
+		}
+	})
+
+	return matching, others
+}
+
+// JoinWith concatenates the elements of the Iterator formatted by format, or by fmt if format is nil, separated by sep.
+// The result is preallocated for the lower bound of the Iterator SizeHint.
+func (i IteratorForInt) JoinWith(sep string, format func(item int) string) string {
+	if format == nil {
+		format = func(item int) string { return fmt.Sprint(item) }
+	}
+
+	var builder strings.Builder
+	if lower, _ := i.SizeHint(); lower > 0 {
+		builder.Grow(int(lower) * (len(sep) + 1))
+	}
+
+	return i.joinInto(&builder, sep, format)
+}
+
+// joinInto writes the elements of the Iterator formatted by format and separated by sep to builder, then closes the Iterator.
+func (i IteratorForInt) joinInto(builder *strings.Builder, sep string, format func(item int) string) string {
+	defer i.Close()
+
+	for item, first := i.Next(), true; item.IsSome(); item, first = i.Next(), false {
+		if !first {
+			builder.WriteString(sep)
+		}
+
+		builder.WriteString(format(item.Unwrap()))
+	}
+
+	return builder.String()
 }
 
 // FoldFirst folds over the Iterator, using its first element as the accumulator initial value.
 func (i IteratorForInt) FoldFirst(reducer func(acc, item int) int) OptionForInt {
 	first := i.Next()
 	if first.IsNone() {
+		i.Close()
 		return NoneInt()
 	}
 
 	return SomeInt(i.FoldForInt(first.Unwrap(), reducer))
 }
 
-// Count returns the number of elements in the Iterator.
+// Count consumes the Iterator and returns the number of elements it yielded.
+// Vectors and Ranges are counted without iterating.
 func (i IteratorForInt) Count() uint {
+	if d, ok := i.iter.(drainable); ok {
+		defer i.Close()
+		return d.drain()
+	}
+
 	return i.FoldForUint(uint(0), func(acc uint, item int) uint {
 		return acc + 1
 	})
@@ -139,13 +278,97 @@ func (i IteratorForInt) Position(predicate func(item int) bool) OptionForUint {
 	return SomeUint(r)
 }
 
+// find returns the next element validating a predicate, without closing the Iterator.
+func (i IteratorForInt) find(predicate func(item int) bool) OptionForInt {
+	item := i.Next()
+	for item.IsSome() {
+		if predicate(item.Unwrap()) {
+			return item
+		}
+
+		item = i.Next()
+	}
+
+	return item
+}
+
 // SkipWhile skips the next elements until it reaches one which validates predicate.
 func (i IteratorForInt) SkipWhile(predicate func(item int) bool) IteratorForInt {
-	i.Find(predicate)
+	i.find(predicate)
 
 	return i
 }
 
+// EqBy checks if the Iterator yields elements equal to those of other according to eq, in the same order.
+// Both are consumed up to their first difference, then closed.
+func (i IteratorForInt) EqBy(other IteratorForInt, eq func(a, b int) bool) bool {
+	defer i.Close()
+	defer other.Close()
+
+	lower, upper := i.SizeHint()
+	otherLower, otherUpper := other.SizeHint()
+
+	if (upper.IsSome() && upper.Unwrap() < otherLower) || (otherUpper.IsSome() && otherUpper.Unwrap() < lower) {
+		return false
+	}
+
+	for {
+		a, b := i.Next(), other.Next()
+		if a.IsNone() || b.IsNone() {
+			return a.IsNone() && b.IsNone()
+		}
+
+		if !eq(a.Unwrap(), b.Unwrap()) {
+			return false
+		}
+	}
+}
+
+// Cmp compares lexicographically the elements of the Iterator with those of other according to cmp,
+// which returns a negative number, zero or a positive number when its first argument is smaller, equal or greater.
+// An Iterator ending before the other is smaller. Both are consumed up to their first difference, then closed.
+func (i IteratorForInt) Cmp(other IteratorForInt, cmp func(a, b int) int) int {
+	defer i.Close()
+	defer other.Close()
+
+	for {
+		a, b := i.Next(), other.Next()
+
+		switch {
+		case a.IsNone() && b.IsNone():
+			return 0
+		case a.IsNone():
+			return -1
+		case b.IsNone():
+			return 1
+		}
+
+		if c := cmp(a.Unwrap(), b.Unwrap()); c != 0 {
+			return c
+		}
+	}
+}
+
+// Lt checks if the Iterator is lexicographically smaller than other according to cmp.
+func (i IteratorForInt) Lt(other IteratorForInt, cmp func(a, b int) int) bool {
+	return i.Cmp(other, cmp) < 0
+}
+
+// Le checks if the Iterator is lexicographically smaller than or equal to other according to cmp.
+func (i IteratorForInt) Le(other IteratorForInt, cmp func(a, b int) int) bool {
+	return i.Cmp(other, cmp) <= 0
+}
+
+// Gt checks if the Iterator is lexicographically greater than other according to cmp.
+func (i IteratorForInt) Gt(other IteratorForInt, cmp func(a, b int) int) bool {
+	return i.Cmp(other, cmp) > 0
+}
+
+// Ge checks if the Iterator is lexicographically greater than or equal to other according to cmp.
+func (i IteratorForInt) Ge(other IteratorForInt, cmp func(a, b int) int) bool {
+	return i.Cmp(other, cmp) >= 0
+}
+
 // Map returns a new Iterator applying a mapper function to every element.
 func (i IteratorForInt) Map(mapper func(item int) int) IteratorForInt {
 	return IteratorForInt{iter: &mapIterableForInt{mapper: mapper, iter: i.iter}}
@@ -156,6 +379,38 @@ func (i IteratorForInt) Chain(iter IteratorForInt) IteratorForInt {
 	return IteratorForInt{iter: &chainForInt{first: i.iter, second: iter.iter, flag: false}}
 }
 
+// Cycle returns a new Iterator endlessly repeating the elements of the Iterator.
+// The elements are buffered during the first pass, unless the Iterator is a Vector.
+func (i IteratorForInt) Cycle() IteratorForInt {
+	if v, ok := i.iter.(*vectorForInt); ok {
+		buffer := v.slice[v.cursor:]
+		v.cursor = uint(len(v.slice))
+
+		return IteratorForInt{iter: &cycleForInt{iter: v, buffer: buffer, cursor: 0, flag: true}}
+	}
+
+	return IteratorForInt{iter: &cycleForInt{iter: i.iter, buffer: []int{}, cursor: 0, flag: false}}
+}
+
+// Tee returns n Iterators each yielding all the elements of the Iterator.
+// Ints are buffered until every one of the n Iterators has yielded them.
+// The Iterator it was built on must not be used directly anymore. It is closed right away if n is zero.
+func (i IteratorForInt) Tee(n uint) []IteratorForInt {
+	if n == 0 {
+		i.Close()
+		return []IteratorForInt{}
+	}
+
+	buffer := &teeBufferForInt{iter: i.iter, items: []int{}, offset: 0, positions: make([]uint, n), open: n, flag: false}
+
+	iterators := make([]IteratorForInt, n)
+	for k := range iterators {
+		iterators[k] = IteratorForInt{iter: &teeForInt{buffer: buffer, branch: uint(k), closed: false}}
+	}
+
+	return iterators
+}
+
 // TakeWhile returns a new Iterator yielding elements until predicate becomes false.
 func (i IteratorForInt) TakeWhile(predicate func(item int) bool) IteratorForInt {
 	return IteratorForInt{iter: &takeWhileForInt{iter: i.iter, predicate: predicate, flag: false}}
@@ -172,8 +427,31 @@ func (i IteratorForInt) Filter(predicate func(item int) bool) IteratorForInt {
 }
 
 // IterableForString describes a struct that can be iterated over.
+// Iterables holding resources can also implement io.Closer: adapters pass Close through to their sources,
+// and terminal operations close them once exhausted or short-circuited.
 type IterableForString interface {
 	Next() OptionForString
+	SizeHint() (lower uint, upper OptionForUint)
+}
+
+// CloneableForString is optionally implemented by Iterables which can be copied along with their progress.
+type CloneableForString interface {
+	Clone() (IterableForString, bool)
+}
+
+// ResettableForString is optionally implemented by Iterables which can rewind to their first element.
+type ResettableForString interface {
+	Reset()
+}
+
+// SinkForString describes a container which elements can be pushed to, such as a buffer or a ring.
+type SinkForString interface {
+	Push(item string)
+}
+
+// splittableForString describes an Iterable which can be split into independent parts.
+type splittableForString interface {
+	split(parts uint) []IterableForString
 }
 
 // IteratorForString embeds an Iterable and provides util functions for it.
@@ -189,6 +467,44 @@ func (i IteratorForString) Next() OptionForString {
 	return i.iter.Next()
 }
 
+// SizeHint returns the bounds on the number of elements left in the Iterator.
+// The upper bound is None if it is unknown or does not fit in a uint.
+func (i IteratorForString) SizeHint() (lower uint, upper OptionForUint) {
+	return i.iter.SizeHint()
+}
+
+// Err returns the error which ended the Iterator early, if any.
+func (i IteratorForString) Err() error {
+	return errOf(i.iter)
+}
+
+// Close releases the resources held by the Iterator sources, if any.
+func (i IteratorForString) Close() error {
+	return closeOf(i.iter)
+}
+
+// Clone returns a copy of the Iterator which can be advanced independently from it.
+// It returns false if the Iterator is not only built on Cloneable Iterables.
+func (i IteratorForString) Clone() (IteratorForString, bool) {
+	clone, ok := cloneOfString(i.iter)
+	if !ok {
+		return i, false
+	}
+
+	return IteratorForString{iter: clone}, true
+}
+
+// Reset rewinds the Iterator to its first element.
+// It returns false if the Iterator is not directly built on a Resettable source such as a Vector or a Range.
+func (i IteratorForString) Reset() bool {
+	r, ok := i.iter.(ResettableForString)
+	if ok {
+		r.Reset()
+	}
+
+	return ok
+}
+
 // AdvanceBy calls Next n times.
 // It returns an error if it reached the end of the Iterator
 // before it finished to iterate.
@@ -215,31 +531,104 @@ func (i IteratorForString) Skip(n uint) IteratorForString {
 }
 
 // Collect returns a slice containing the elements of the Iterator.
+// The slice is preallocated with the lower bound of the Iterator SizeHint.
 func (i IteratorForString) Collect() []string {
-	collected := []string{}
+	lower, _ := i.SizeHint()
+
+	return i.AppendTo(make([]string, 0, lower))
+}
+
+// AppendTo appends the elements of the Iterator to dst and returns the extended slice.
+// dst is only reallocated if its capacity is lower than required by the lower bound of the Iterator SizeHint,
+// so that a buffer can be reused with AppendTo(buffer[:0]).
+func (i IteratorForString) AppendTo(dst []string) []string {
+	defer i.Close()
+
+	if lower, _ := i.SizeHint(); uint(cap(dst)-len(dst)) < lower {
+		grown := make([]string, len(dst), uint(len(dst))+lower)
+		copy(grown, dst)
+		dst = grown
+	}
 
 	item := i.Next()
 	for item.IsSome() {
-		collected = append(collected, item.Unwrap())
+		dst = append(dst, item.Unwrap())
 
 		item = i.Next()
 	}
 
-	return collected
+	return dst
+}
+
+// Extend pushes every element of the Iterator to sink.
+func (i IteratorForString) Extend(sink SinkForString) {
+	i.ForEach(sink.Push)
+}
+
+// Partition returns two slices containing the elements of the Iterator that validate a predicate and the others.
+func (i IteratorForString) Partition(predicate func(item string) bool) ([]string, []string) {
+	matching, others := []string{}, []string{}
+
+	i.ForEach(func(item string) {
+		if predicate(item) {
+			matching = append(matching, item)
+		} else {
+			others = append(others, item)
+		}
+	})
+
+	return matching, others
+}
+
+// JoinWith concatenates the elements of the Iterator formatted by format, or by fmt if format is nil, separated by sep.
+// The result is preallocated for the lower bound of the Iterator SizeHint.
+func (i IteratorForString) JoinWith(sep string, format func(item string) string) string {
+	if format == nil {
+		format = func(item string) string { return fmt.Sprint(item) }
+	}
+
+	var builder strings.Builder
+	if lower, _ := i.SizeHint(); lower > 0 {
+		builder.Grow(int(lower) * (len(sep) + 1))
+	}
+
+	return i.joinInto(&builder, sep, format)
+}
+
+// joinInto writes the elements of the Iterator formatted by format and separated by sep to builder, then closes the Iterator.
+func (i IteratorForString) joinInto(builder *strings.Builder, sep string, format func(item string) string) string {
+	defer i.Close()
+
+	for item, first := i.Next(), true; item.IsSome(); item, first = i.Next(), false {
+		if !first {
+			builder.WriteString(sep)
+		}
+
+		builder.WriteString(format(item.Unwrap()))
+	}
+
+	return builder.String()
 }
 
 // FoldFirst folds over the Iterator, using its first element as the accumulator initial value.
 func (i IteratorForString) FoldFirst(reducer func(acc, item string) string) OptionForString {
 	first := i.Next()
 	if first.IsNone() {
+		i.Close()
 		return NoneString()
 	}
 
 	return SomeString(i.FoldForString(first.Unwrap(), reducer))
 }
 
-// Count returns the number of elements in the Iterator.
+// Count consumes the Iterator and returns the number of elements it yielded.
+// Vectors and Ranges are counted without iterating.
 func (i IteratorForString) Count() uint {
+	if d, ok := i.iter.(drainable); ok {
+		defer i.Close()
+		return d.drain()
+	}
+
 	return i.FoldForUint(uint(0), func(acc uint, item string) uint {
 		return acc + 1
 	})
@@ -306,13 +695,97 @@ func (i IteratorForString) Position(predicate func(item string) bool) OptionForU
 	return SomeUint(r)
 }
 
+// find returns the next element validating a predicate, without closing the Iterator.
+func (i IteratorForString) find(predicate func(item string) bool) OptionForString {
+	item := i.Next()
+	for item.IsSome() {
+		if predicate(item.Unwrap()) {
+			return item
+		}
+
+		item = i.Next()
+	}
+
+	return item
+}
+
 // SkipWhile skips the next elements until it reaches one which validates predicate.
 func (i IteratorForString) SkipWhile(predicate func(item string) bool) IteratorForString {
-	i.Find(predicate)
+	i.find(predicate)
 
 	return i
 }
 
+// EqBy checks if the Iterator yields elements equal to those of other according to eq, in the same order.
+// Both are consumed up to their first difference, then closed.
+func (i IteratorForString) EqBy(other IteratorForString, eq func(a, b string) bool) bool {
+	defer i.Close()
+	defer other.Close()
+
+	lower, upper := i.SizeHint()
+	otherLower, otherUpper := other.SizeHint()
+
+	if (upper.IsSome() && upper.Unwrap() < otherLower) || (otherUpper.IsSome() && otherUpper.Unwrap() < lower) {
+		return false
+	}
+
+	for {
+		a, b := i.Next(), other.Next()
+		if a.IsNone() || b.IsNone() {
+			return a.IsNone() && b.IsNone()
+		}
+
+		if !eq(a.Unwrap(), b.Unwrap()) {
+			return false
+		}
+	}
+}
+
+// Cmp compares lexicographically the elements of the Iterator with those of other according to cmp,
+// which returns a negative number, zero or a positive number when its first argument is smaller, equal or greater.
+// An Iterator ending before the other is smaller. Both are consumed up to their first difference, then closed.
+func (i IteratorForString) Cmp(other IteratorForString, cmp func(a, b string) int) int {
+	defer i.Close()
+	defer other.Close()
+
+	for {
+		a, b := i.Next(), other.Next()
+
+		switch {
+		case a.IsNone() && b.IsNone():
+			return 0
+		case a.IsNone():
+			return -1
+		case b.IsNone():
+			return 1
+		}
+
+		if c := cmp(a.Unwrap(), b.Unwrap()); c != 0 {
+			return c
+		}
+	}
+}
+
+// Lt checks if the Iterator is lexicographically smaller than other according to cmp.
+func (i IteratorForString) Lt(other IteratorForString, cmp func(a, b string) int) bool {
+	return i.Cmp(other, cmp) < 0
+}
+
+// Le checks if the Iterator is lexicographically smaller than or equal to other according to cmp.
+func (i IteratorForString) Le(other IteratorForString, cmp func(a, b string) int) bool {
+	return i.Cmp(other, cmp) <= 0
+}
+
+// Gt checks if the Iterator is lexicographically greater than other according to cmp.
+func (i IteratorForString) Gt(other IteratorForString, cmp func(a, b string) int) bool {
+	return i.Cmp(other, cmp) > 0
+}
+
+// Ge checks if the Iterator is lexicographically greater than or equal to other according to cmp.
+func (i IteratorForString) Ge(other IteratorForString, cmp func(a, b string) int) bool {
+	return i.Cmp(other, cmp) >= 0
+}
+
 // Map returns a new Iterator applying a mapper function to every element.
 func (i IteratorForString) Map(mapper func(item string) string) IteratorForString {
 	return IteratorForString{iter: &mapIterableForString{mapper: mapper, iter: i.iter}}
@@ -323,6 +796,38 @@ func (i IteratorForString) Chain(iter IteratorForString) IteratorForString {
 	return IteratorForString{iter: &chainForString{first: i.iter, second: iter.iter, flag: false}}
 }
 
+// Cycle returns a new Iterator endlessly repeating the elements of the Iterator.
+// The elements are buffered during the first pass, unless the Iterator is a Vector.
+func (i IteratorForString) Cycle() IteratorForString {
+	if v, ok := i.iter.(*vectorForString); ok {
+		buffer := v.slice[v.cursor:]
+		v.cursor = uint(len(v.slice))
+
+		return IteratorForString{iter: &cycleForString{iter: v, buffer: buffer, cursor: 0, flag: true}}
+	}
+
+	return IteratorForString{iter: &cycleForString{iter: i.iter, buffer: []string{}, cursor: 0, flag: false}}
+}
+
+// Tee returns n Iterators each yielding all the elements of the Iterator.
+// Strings are buffered until every one of the n Iterators has yielded them.
+// The Iterator it was built on must not be used directly anymore. It is closed right away if n is zero.
+func (i IteratorForString) Tee(n uint) []IteratorForString {
+	if n == 0 {
+		i.Close()
+		return []IteratorForString{}
+	}
+
+	buffer := &teeBufferForString{iter: i.iter, items: []string{}, offset: 0, positions: make([]uint, n), open: n, flag: false}
+
+	iterators := make([]IteratorForString, n)
+	for k := range iterators {
+		iterators[k] = IteratorForString{iter: &teeForString{buffer: buffer, branch: uint(k), closed: false}}
+	}
+
+	return iterators
+}
+
 // TakeWhile returns a new Iterator yielding elements until predicate becomes false.
 func (i IteratorForString) TakeWhile(predicate func(item string) bool) IteratorForString {
 	return IteratorForString{iter: &takeWhileForString{iter: i.iter, predicate: predicate, flag: false}}
@@ -337,3 +842,2088 @@ func (i IteratorForString) Take(n uint) IteratorForString {
 func (i IteratorForString) Filter(predicate func(item string) bool) IteratorForString {
 	return IteratorForString{iter: &filterForString{iter: i, predicate: predicate}}
 }
+
+// IterableForEntryForStringToInt describes a struct that can be iterated over.
+// Iterables holding resources can also implement io.Closer: adapters pass Close through to their sources,
+// and terminal operations close them once exhausted or short-circuited.
+type IterableForEntryForStringToInt interface {
+	Next() OptionForEntryForStringToInt
+	SizeHint() (lower uint, upper OptionForUint)
+}
+
+// CloneableForEntryForStringToInt is optionally implemented by Iterables which can be copied along with their progress.
+type CloneableForEntryForStringToInt interface {
+	Clone() (IterableForEntryForStringToInt, bool)
+}
+
+// ResettableForEntryForStringToInt is optionally implemented by Iterables which can rewind to their first element.
+type ResettableForEntryForStringToInt interface {
+	Reset()
+}
+
+// SinkForEntryForStringToInt describes a container which elements can be pushed to, such as a buffer or a ring.
+type SinkForEntryForStringToInt interface {
+	Push(item EntryForStringToInt)
+}
+
+// splittableForEntryForStringToInt describes an Iterable which can be split into independent parts.
+type splittableForEntryForStringToInt interface {
+	split(parts uint) []IterableForEntryForStringToInt
+}
+
+// IteratorForEntryForStringToInt embeds an Iterable and provides util functions for it.
+type IteratorForEntryForStringToInt struct {
+	iter IterableForEntryForStringToInt
+}
+
+// Iterator implements Iterable.
+var _ IterableForEntryForStringToInt = IteratorForEntryForStringToInt{}
+
+// Next returns the next element of the Iterator.
+func (i IteratorForEntryForStringToInt) Next() OptionForEntryForStringToInt {
+	return i.iter.Next()
+}
+
+// SizeHint returns the bounds on the number of elements left in the Iterator.
+// The upper bound is None if it is unknown or does not fit in a uint.
+func (i IteratorForEntryForStringToInt) SizeHint() (lower uint, upper OptionForUint) {
+	return i.iter.SizeHint()
+}
+
+// Err returns the error which ended the Iterator early, if any.
+func (i IteratorForEntryForStringToInt) Err() error {
+	return errOf(i.iter)
+}
+
+// Close releases the resources held by the Iterator sources, if any.
+func (i IteratorForEntryForStringToInt) Close() error {
+	return closeOf(i.iter)
+}
+
+// Clone returns a copy of the Iterator which can be advanced independently from it.
+// It returns false if the Iterator is not only built on Cloneable Iterables.
+func (i IteratorForEntryForStringToInt) Clone() (IteratorForEntryForStringToInt, bool) {
+	clone, ok := cloneOfEntryForStringToInt(i.iter)
+	if !ok {
+		return i, false
+	}
+
+	return IteratorForEntryForStringToInt{iter: clone}, true
+}
+
+// Reset rewinds the Iterator to its first element.
+// It returns false if the Iterator is not directly built on a Resettable source such as a Vector or a Range.
+func (i IteratorForEntryForStringToInt) Reset() bool {
+	r, ok := i.iter.(ResettableForEntryForStringToInt)
+	if ok {
+		r.Reset()
+	}
+
+	return ok
+}
+
+// AdvanceBy calls Next n times.
+// It returns an error if it reached the end of the Iterator
+// before it finished to iterate.
+func (i IteratorForEntryForStringToInt) AdvanceBy(n uint) error {
+	for k := uint(0); k < n; k++ {
+		if i.Next().IsNone() {
+			return &errAdvanceBy{}
+		}
+	}
+
+	return nil
+}
+
+// Nth returns the nth element of the Iterator.
+func (i IteratorForEntryForStringToInt) Nth(n uint) OptionForEntryForStringToInt {
+	i.AdvanceBy(n)
+	return i.Next()
+}
+
+// Skip the next n iterations.
+func (i IteratorForEntryForStringToInt) Skip(n uint) IteratorForEntryForStringToInt {
+	i.AdvanceBy(n)
+	return i
+}
+
+// Collect returns a slice containing the elements of the Iterator.
+// The slice is preallocated with the lower bound of the Iterator SizeHint.
+func (i IteratorForEntryForStringToInt) Collect() []EntryForStringToInt {
+	lower, _ := i.SizeHint()
+
+	return i.AppendTo(make([]EntryForStringToInt, 0, lower))
+}
+
+// AppendTo appends the elements of the Iterator to dst and returns the extended slice.
+// dst is only reallocated if its capacity is lower than required by the lower bound of the Iterator SizeHint,
+// so that a buffer can be reused with AppendTo(buffer[:0]).
+func (i IteratorForEntryForStringToInt) AppendTo(dst []EntryForStringToInt) []EntryForStringToInt {
+	defer i.Close()
+
+	if lower, _ := i.SizeHint(); uint(cap(dst)-len(dst)) < lower {
+		grown := make([]EntryForStringToInt, len(dst), uint(len(dst))+lower)
+		copy(grown, dst)
+		dst = grown
+	}
+
+	item := i.Next()
+	for item.IsSome() {
+		dst = append(dst, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return dst
+}
+
+// Extend pushes every element of the Iterator to sink.
+func (i IteratorForEntryForStringToInt) Extend(sink SinkForEntryForStringToInt) {
+	i.ForEach(sink.Push)
+}
+
+// Partition returns two slices containing the elements of the Iterator that validate a predicate and the others.
+func (i IteratorForEntryForStringToInt) Partition(predicate func(item EntryForStringToInt) bool) ([]EntryForStringToInt, []EntryForStringToInt) {
+	matching, others := []EntryForStringToInt{}, []EntryForStringToInt{}
+
+	i.ForEach(func(item EntryForStringToInt) {
+		if predicate(item) {
+			matching = append(matching, item)
+		} else {
+			others = append(others, item)
+		}
+	})
+
+	return matching, others
+}
+
+// JoinWith concatenates the elements of the Iterator formatted by format, or by fmt if format is nil, separated by sep.
+// The result is preallocated for the lower bound of the Iterator SizeHint.
+func (i IteratorForEntryForStringToInt) JoinWith(sep string, format func(item EntryForStringToInt) string) string {
+	if format == nil {
+		format = func(item EntryForStringToInt) string { return fmt.Sprint(item) }
+	}
+
+	var builder strings.Builder
+	if lower, _ := i.SizeHint(); lower > 0 {
+		builder.Grow(int(lower) * (len(sep) + 1))
+	}
+
+	return i.joinInto(&builder, sep, format)
+}
+
+// joinInto writes the elements of the Iterator formatted by format and separated by sep to builder, then closes the Iterator.
+func (i IteratorForEntryForStringToInt) joinInto(builder *strings.Builder, sep string, format func(item EntryForStringToInt) string) string {
+	defer i.Close()
+
+	for item, first := i.Next(), true; item.IsSome(); item, first = i.Next(), false {
+		if !first {
+			builder.WriteString(sep)
+		}
+
+		builder.WriteString(format(item.Unwrap()))
+	}
+
+	return builder.String()
+}
+
+// FoldFirst folds over the Iterator, using its first element as the accumulator initial value.
+func (i IteratorForEntryForStringToInt) FoldFirst(reducer func(acc, item EntryForStringToInt) EntryForStringToInt) OptionForEntryForStringToInt {
+	first := i.Next()
+	if first.IsNone() {
+		i.Close()
+		return NoneEntryForStringToInt()
+	}
+
+	return SomeEntryForStringToInt(i.FoldForEntryForStringToInt(first.Unwrap(), reducer))
+}
+
+// Count consumes the Iterator and returns the number of elements it yielded.
+// Vectors and Ranges are counted without iterating.
+func (i IteratorForEntryForStringToInt) Count() uint {
+	if d, ok := i.iter.(drainable); ok {
+		defer i.Close()
+		return d.drain()
+	}
+
+	return i.FoldForUint(uint(0), func(acc uint, item EntryForStringToInt) uint {
+		return acc + 1
+	})
+}
+
+// Last returns the last element of the Iterator.
+func (i IteratorForEntryForStringToInt) Last() OptionForEntryForStringToInt {
+	return i.FoldForOptionForEntryForStringToInt(NoneEntryForStringToInt(), func(acc OptionForEntryForStringToInt, item EntryForStringToInt) OptionForEntryForStringToInt {
+		return SomeEntryForStringToInt(item)
+	})
+}
+
+// ForEach runs a callback for every element of the iterator.
+func (i IteratorForEntryForStringToInt) ForEach(callback func(item EntryForStringToInt)) {
+	i.FoldForEmpty(Empty{}, func(acc Empty, item EntryForStringToInt) Empty {
+		callback(item)
+		return acc
+	})
+}
+
+// All checks if all the elements of the Iterator validates a predicate.
+func (i IteratorForEntryForStringToInt) All(predicate func(item EntryForStringToInt) bool) bool {
+	_, ok := i.TryFoldForEmpty(Empty{}, func(acc Empty, item EntryForStringToInt) (Empty, bool) {
+		return acc, predicate(item)
+	})
+	return ok
+}
+
+// Any checks if at least one element of the Iterator validates a predicate.
+func (i IteratorForEntryForStringToInt) Any(predicate func(item EntryForStringToInt) bool) bool {
+	_, ok := i.TryFoldForEmpty(Empty{}, func(acc Empty, item EntryForStringToInt) (Empty, bool) {
+		return acc, !predicate(item)
+	})
+	return !ok
+}
+
+// Find returns the first element of the Iterator that validates a predicate.
+func (i IteratorForEntryForStringToInt) Find(predicate func(item EntryForStringToInt) bool) OptionForEntryForStringToInt {
+	r, ok := i.TryFoldForOptionForEntryForStringToInt(NoneEntryForStringToInt(), func(acc OptionForEntryForStringToInt, item EntryForStringToInt) (OptionForEntryForStringToInt, bool) {
+		return SomeEntryForStringToInt(item), !predicate(item)
+	})
+
+	if ok {
+		return NoneEntryForStringToInt()
+	}
+
+	return r
+}
+
+// Position returns the position of the first element of the Iterator that validates a predicate.
+func (i IteratorForEntryForStringToInt) Position(predicate func(item EntryForStringToInt) bool) OptionForUint {
+	r, ok := i.TryFoldForUint(uint(0), func(acc uint, item EntryForStringToInt) (uint, bool) {
+		if predicate(item) {
+			return acc, false
+		}
+
+		return acc + 1, true
+	})
+
+	if ok {
+		return NoneUint()
+	}
+
+	return SomeUint(r)
+}
+
+// find returns the next element validating a predicate, without closing the Iterator.
+func (i IteratorForEntryForStringToInt) find(predicate func(item EntryForStringToInt) bool) OptionForEntryForStringToInt {
+	item := i.Next()
+	for item.IsSome() {
+		if predicate(item.Unwrap()) {
+			return item
+		}
+
+		item = i.Next()
+	}
+
+	return item
+}
+
+// SkipWhile skips the next elements until it reaches one which validates predicate.
+func (i IteratorForEntryForStringToInt) SkipWhile(predicate func(item EntryForStringToInt) bool) IteratorForEntryForStringToInt {
+	i.find(predicate)
+
+	return i
+}
+
+// EqBy checks if the Iterator yields elements equal to those of other according to eq, in the same order.
+// Both are consumed up to their first difference, then closed.
+func (i IteratorForEntryForStringToInt) EqBy(other IteratorForEntryForStringToInt, eq func(a, b EntryForStringToInt) bool) bool {
+	defer i.Close()
+	defer other.Close()
+
+	lower, upper := i.SizeHint()
+	otherLower, otherUpper := other.SizeHint()
+
+	if (upper.IsSome() && upper.Unwrap() < otherLower) || (otherUpper.IsSome() && otherUpper.Unwrap() < lower) {
+		return false
+	}
+
+	for {
+		a, b := i.Next(), other.Next()
+		if a.IsNone() || b.IsNone() {
+			return a.IsNone() && b.IsNone()
+		}
+
+		if !eq(a.Unwrap(), b.Unwrap()) {
+			return false
+		}
+	}
+}
+
+// Cmp compares lexicographically the elements of the Iterator with those of other according to cmp,
+// which returns a negative number, zero or a positive number when its first argument is smaller, equal or greater.
+// An Iterator ending before the other is smaller. Both are consumed up to their first difference, then closed.
+func (i IteratorForEntryForStringToInt) Cmp(other IteratorForEntryForStringToInt, cmp func(a, b EntryForStringToInt) int) int {
+	defer i.Close()
+	defer other.Close()
+
+	for {
+		a, b := i.Next(), other.Next()
+
+		switch {
+		case a.IsNone() && b.IsNone():
+			return 0
+		case a.IsNone():
+			return -1
+		case b.IsNone():
+			return 1
+		}
+
+		if c := cmp(a.Unwrap(), b.Unwrap()); c != 0 {
+			return c
+		}
+	}
+}
+
+// Lt checks if the Iterator is lexicographically smaller than other according to cmp.
+func (i IteratorForEntryForStringToInt) Lt(other IteratorForEntryForStringToInt, cmp func(a, b EntryForStringToInt) int) bool {
+	return i.Cmp(other, cmp) < 0
+}
+
+// Le checks if the Iterator is lexicographically smaller than or equal to other according to cmp.
+func (i IteratorForEntryForStringToInt) Le(other IteratorForEntryForStringToInt, cmp func(a, b EntryForStringToInt) int) bool {
+	return i.Cmp(other, cmp) <= 0
+}
+
+// Gt checks if the Iterator is lexicographically greater than other according to cmp.
+func (i IteratorForEntryForStringToInt) Gt(other IteratorForEntryForStringToInt, cmp func(a, b EntryForStringToInt) int) bool {
+	return i.Cmp(other, cmp) > 0
+}
+
+// Ge checks if the Iterator is lexicographically greater than or equal to other according to cmp.
+func (i IteratorForEntryForStringToInt) Ge(other IteratorForEntryForStringToInt, cmp func(a, b EntryForStringToInt) int) bool {
+	return i.Cmp(other, cmp) >= 0
+}
+
+// Map returns a new Iterator applying a mapper function to every element.
+func (i IteratorForEntryForStringToInt) Map(mapper func(item EntryForStringToInt) EntryForStringToInt) IteratorForEntryForStringToInt {
+	return IteratorForEntryForStringToInt{iter: &mapIterableForEntryForStringToInt{mapper: mapper, iter: i.iter}}
+}
+
+// Chain returns a new Iterator sequentially joining the two it was built on.
+func (i IteratorForEntryForStringToInt) Chain(iter IteratorForEntryForStringToInt) IteratorForEntryForStringToInt {
+	return IteratorForEntryForStringToInt{iter: &chainForEntryForStringToInt{first: i.iter, second: iter.iter, flag: false}}
+}
+
+// Cycle returns a new Iterator endlessly repeating the elements of the Iterator.
+// The elements are buffered during the first pass, unless the Iterator is a Vector.
+func (i IteratorForEntryForStringToInt) Cycle() IteratorForEntryForStringToInt {
+	if v, ok := i.iter.(*vectorForEntryForStringToInt); ok {
+		buffer := v.slice[v.cursor:]
+		v.cursor = uint(len(v.slice))
+
+		return IteratorForEntryForStringToInt{iter: &cycleForEntryForStringToInt{iter: v, buffer: buffer, cursor: 0, flag: true}}
+	}
+
+	return IteratorForEntryForStringToInt{iter: &cycleForEntryForStringToInt{iter: i.iter, buffer: []EntryForStringToInt{}, cursor: 0, flag: false}}
+}
+
+// Tee returns n Iterators each yielding all the elements of the Iterator.
+// EntryForStringToInts are buffered until every one of the n Iterators has yielded them.
+// The Iterator it was built on must not be used directly anymore. It is closed right away if n is zero.
+func (i IteratorForEntryForStringToInt) Tee(n uint) []IteratorForEntryForStringToInt {
+	if n == 0 {
+		i.Close()
+		return []IteratorForEntryForStringToInt{}
+	}
+
+	buffer := &teeBufferForEntryForStringToInt{iter: i.iter, items: []EntryForStringToInt{}, offset: 0, positions: make([]uint, n), open: n, flag: false}
+
+	iterators := make([]IteratorForEntryForStringToInt, n)
+	for k := range iterators {
+		iterators[k] = IteratorForEntryForStringToInt{iter: &teeForEntryForStringToInt{buffer: buffer, branch: uint(k), closed: false}}
+	}
+
+	return iterators
+}
+
+// TakeWhile returns a new Iterator yielding elements until predicate becomes false.
+func (i IteratorForEntryForStringToInt) TakeWhile(predicate func(item EntryForStringToInt) bool) IteratorForEntryForStringToInt {
+	return IteratorForEntryForStringToInt{iter: &takeWhileForEntryForStringToInt{iter: i.iter, predicate: predicate, flag: false}}
+}
+
+// Take returns a new Iterator yielding only the n next elements.
+func (i IteratorForEntryForStringToInt) Take(n uint) IteratorForEntryForStringToInt {
+	return IteratorForEntryForStringToInt{iter: &takeForEntryForStringToInt{iter: i.iter, count: 0, max: n, flag: false}}
+}
+
+// Filter returns a new Iterator yielding only elements validating a predicate.
+func (i IteratorForEntryForStringToInt) Filter(predicate func(item EntryForStringToInt) bool) IteratorForEntryForStringToInt {
+	return IteratorForEntryForStringToInt{iter: &filterForEntryForStringToInt{iter: i, predicate: predicate}}
+}
+
+// IterableForSliceOfInt describes a struct that can be iterated over.
+// Iterables holding resources can also implement io.Closer: adapters pass Close through to their sources,
+// and terminal operations close them once exhausted or short-circuited.
+type IterableForSliceOfInt interface {
+	Next() OptionForSliceOfInt
+	SizeHint() (lower uint, upper OptionForUint)
+}
+
+// CloneableForSliceOfInt is optionally implemented by Iterables which can be copied along with their progress.
+type CloneableForSliceOfInt interface {
+	Clone() (IterableForSliceOfInt, bool)
+}
+
+// ResettableForSliceOfInt is optionally implemented by Iterables which can rewind to their first element.
+type ResettableForSliceOfInt interface {
+	Reset()
+}
+
+// SinkForSliceOfInt describes a container which elements can be pushed to, such as a buffer or a ring.
+type SinkForSliceOfInt interface {
+	Push(item SliceOfInt)
+}
+
+// splittableForSliceOfInt describes an Iterable which can be split into independent parts.
+type splittableForSliceOfInt interface {
+	split(parts uint) []IterableForSliceOfInt
+}
+
+// IteratorForSliceOfInt embeds an Iterable and provides util functions for it.
+type IteratorForSliceOfInt struct {
+	iter IterableForSliceOfInt
+}
+
+// Iterator implements Iterable.
+var _ IterableForSliceOfInt = IteratorForSliceOfInt{}
+
+// Next returns the next element of the Iterator.
+func (i IteratorForSliceOfInt) Next() OptionForSliceOfInt {
+	return i.iter.Next()
+}
+
+// SizeHint returns the bounds on the number of elements left in the Iterator.
+// The upper bound is None if it is unknown or does not fit in a uint.
+func (i IteratorForSliceOfInt) SizeHint() (lower uint, upper OptionForUint) {
+	return i.iter.SizeHint()
+}
+
+// Err returns the error which ended the Iterator early, if any.
+func (i IteratorForSliceOfInt) Err() error {
+	return errOf(i.iter)
+}
+
+// Close releases the resources held by the Iterator sources, if any.
+func (i IteratorForSliceOfInt) Close() error {
+	return closeOf(i.iter)
+}
+
+// Clone returns a copy of the Iterator which can be advanced independently from it.
+// It returns false if the Iterator is not only built on Cloneable Iterables.
+func (i IteratorForSliceOfInt) Clone() (IteratorForSliceOfInt, bool) {
+	clone, ok := cloneOfSliceOfInt(i.iter)
+	if !ok {
+		return i, false
+	}
+
+	return IteratorForSliceOfInt{iter: clone}, true
+}
+
+// Reset rewinds the Iterator to its first element.
+// It returns false if the Iterator is not directly built on a Resettable source such as a Vector or a Range.
+func (i IteratorForSliceOfInt) Reset() bool {
+	r, ok := i.iter.(ResettableForSliceOfInt)
+	if ok {
+		r.Reset()
+	}
+
+	return ok
+}
+
+// AdvanceBy calls Next n times.
+// It returns an error if it reached the end of the Iterator
+// before it finished to iterate.
+func (i IteratorForSliceOfInt) AdvanceBy(n uint) error {
+	for k := uint(0); k < n; k++ {
+		if i.Next().IsNone() {
+			return &errAdvanceBy{}
+		}
+	}
+
+	return nil
+}
+
+// Nth returns the nth element of the Iterator.
+func (i IteratorForSliceOfInt) Nth(n uint) OptionForSliceOfInt {
+	i.AdvanceBy(n)
+	return i.Next()
+}
+
+// Skip the next n iterations.
+func (i IteratorForSliceOfInt) Skip(n uint) IteratorForSliceOfInt {
+	i.AdvanceBy(n)
+	return i
+}
+
+// Collect returns a slice containing the elements of the Iterator.
+// The slice is preallocated with the lower bound of the Iterator SizeHint.
+func (i IteratorForSliceOfInt) Collect() []SliceOfInt {
+	lower, _ := i.SizeHint()
+
+	return i.AppendTo(make([]SliceOfInt, 0, lower))
+}
+
+// AppendTo appends the elements of the Iterator to dst and returns the extended slice.
+// dst is only reallocated if its capacity is lower than required by the lower bound of the Iterator SizeHint,
+// so that a buffer can be reused with AppendTo(buffer[:0]).
+func (i IteratorForSliceOfInt) AppendTo(dst []SliceOfInt) []SliceOfInt {
+	defer i.Close()
+
+	if lower, _ := i.SizeHint(); uint(cap(dst)-len(dst)) < lower {
+		grown := make([]SliceOfInt, len(dst), uint(len(dst))+lower)
+		copy(grown, dst)
+		dst = grown
+	}
+
+	item := i.Next()
+	for item.IsSome() {
+		dst = append(dst, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return dst
+}
+
+// Extend pushes every element of the Iterator to sink.
+func (i IteratorForSliceOfInt) Extend(sink SinkForSliceOfInt) {
+	i.ForEach(sink.Push)
+}
+
+// Partition returns two slices containing the elements of the Iterator that validate a predicate and the others.
+func (i IteratorForSliceOfInt) Partition(predicate func(item SliceOfInt) bool) ([]SliceOfInt, []SliceOfInt) {
+	matching, others := []SliceOfInt{}, []SliceOfInt{}
+
+	i.ForEach(func(item SliceOfInt) {
+		if predicate(item) {
+			matching = append(matching, item)
+		} else {
+			others = append(others, item)
+		}
+	})
+
+	return matching, others
+}
+
+// JoinWith concatenates the elements of the Iterator formatted by format, or by fmt if format is nil, separated by sep.
+// The result is preallocated for the lower bound of the Iterator SizeHint.
+func (i IteratorForSliceOfInt) JoinWith(sep string, format func(item SliceOfInt) string) string {
+	if format == nil {
+		format = func(item SliceOfInt) string { return fmt.Sprint(item) }
+	}
+
+	var builder strings.Builder
+	if lower, _ := i.SizeHint(); lower > 0 {
+		builder.Grow(int(lower) * (len(sep) + 1))
+	}
+
+	return i.joinInto(&builder, sep, format)
+}
+
+// joinInto writes the elements of the Iterator formatted by format and separated by sep to builder, then closes the Iterator.
+func (i IteratorForSliceOfInt) joinInto(builder *strings.Builder, sep string, format func(item SliceOfInt) string) string {
+	defer i.Close()
+
+	for item, first := i.Next(), true; item.IsSome(); item, first = i.Next(), false {
+		if !first {
+			builder.WriteString(sep)
+		}
+
+		builder.WriteString(format(item.Unwrap()))
+	}
+
+	return builder.String()
+}
+
+// FoldFirst folds over the Iterator, using its first element as the accumulator initial value.
+func (i IteratorForSliceOfInt) FoldFirst(reducer func(acc, item SliceOfInt) SliceOfInt) OptionForSliceOfInt {
+	first := i.Next()
+	if first.IsNone() {
+		i.Close()
+		return NoneSliceOfInt()
+	}
+
+	return SomeSliceOfInt(i.FoldForSliceOfInt(first.Unwrap(), reducer))
+}
+
+// Count consumes the Iterator and returns the number of elements it yielded.
+// Vectors and Ranges are counted without iterating.
+func (i IteratorForSliceOfInt) Count() uint {
+	if d, ok := i.iter.(drainable); ok {
+		defer i.Close()
+		return d.drain()
+	}
+
+	return i.FoldForUint(uint(0), func(acc uint, item SliceOfInt) uint {
+		return acc + 1
+	})
+}
+
+// Last returns the last element of the Iterator.
+func (i IteratorForSliceOfInt) Last() OptionForSliceOfInt {
+	return i.FoldForOptionForSliceOfInt(NoneSliceOfInt(), func(acc OptionForSliceOfInt, item SliceOfInt) OptionForSliceOfInt {
+		return SomeSliceOfInt(item)
+	})
+}
+
+// ForEach runs a callback for every element of the iterator.
+func (i IteratorForSliceOfInt) ForEach(callback func(item SliceOfInt)) {
+	i.FoldForEmpty(Empty{}, func(acc Empty, item SliceOfInt) Empty {
+		callback(item)
+		return acc
+	})
+}
+
+// All checks if all the elements of the Iterator validates a predicate.
+func (i IteratorForSliceOfInt) All(predicate func(item SliceOfInt) bool) bool {
+	_, ok := i.TryFoldForEmpty(Empty{}, func(acc Empty, item SliceOfInt) (Empty, bool) {
+		return acc, predicate(item)
+	})
+	return ok
+}
+
+// Any checks if at least one element of the Iterator validates a predicate.
+func (i IteratorForSliceOfInt) Any(predicate func(item SliceOfInt) bool) bool {
+	_, ok := i.TryFoldForEmpty(Empty{}, func(acc Empty, item SliceOfInt) (Empty, bool) {
+		return acc, !predicate(item)
+	})
+	return !ok
+}
+
+// Find returns the first element of the Iterator that validates a predicate.
+func (i IteratorForSliceOfInt) Find(predicate func(item SliceOfInt) bool) OptionForSliceOfInt {
+	r, ok := i.TryFoldForOptionForSliceOfInt(NoneSliceOfInt(), func(acc OptionForSliceOfInt, item SliceOfInt) (OptionForSliceOfInt, bool) {
+		return SomeSliceOfInt(item), !predicate(item)
+	})
+
+	if ok {
+		return NoneSliceOfInt()
+	}
+
+	return r
+}
+
+// Position returns the position of the first element of the Iterator that validates a predicate.
+func (i IteratorForSliceOfInt) Position(predicate func(item SliceOfInt) bool) OptionForUint {
+	r, ok := i.TryFoldForUint(uint(0), func(acc uint, item SliceOfInt) (uint, bool) {
+		if predicate(item) {
+			return acc, false
+		}
+
+		return acc + 1, true
+	})
+
+	if ok {
+		return NoneUint()
+	}
+
+	return SomeUint(r)
+}
+
+// find returns the next element validating a predicate, without closing the Iterator.
+func (i IteratorForSliceOfInt) find(predicate func(item SliceOfInt) bool) OptionForSliceOfInt {
+	item := i.Next()
+	for item.IsSome() {
+		if predicate(item.Unwrap()) {
+			return item
+		}
+
+		item = i.Next()
+	}
+
+	return item
+}
+
+// SkipWhile skips the next elements until it reaches one which validates predicate.
+func (i IteratorForSliceOfInt) SkipWhile(predicate func(item SliceOfInt) bool) IteratorForSliceOfInt {
+	i.find(predicate)
+
+	return i
+}
+
+// EqBy checks if the Iterator yields elements equal to those of other according to eq, in the same order.
+// Both are consumed up to their first difference, then closed.
+func (i IteratorForSliceOfInt) EqBy(other IteratorForSliceOfInt, eq func(a, b SliceOfInt) bool) bool {
+	defer i.Close()
+	defer other.Close()
+
+	lower, upper := i.SizeHint()
+	otherLower, otherUpper := other.SizeHint()
+
+	if (upper.IsSome() && upper.Unwrap() < otherLower) || (otherUpper.IsSome() && otherUpper.Unwrap() < lower) {
+		return false
+	}
+
+	for {
+		a, b := i.Next(), other.Next()
+		if a.IsNone() || b.IsNone() {
+			return a.IsNone() && b.IsNone()
+		}
+
+		if !eq(a.Unwrap(), b.Unwrap()) {
+			return false
+		}
+	}
+}
+
+// Cmp compares lexicographically the elements of the Iterator with those of other according to cmp,
+// which returns a negative number, zero or a positive number when its first argument is smaller, equal or greater.
+// An Iterator ending before the other is smaller. Both are consumed up to their first difference, then closed.
+func (i IteratorForSliceOfInt) Cmp(other IteratorForSliceOfInt, cmp func(a, b SliceOfInt) int) int {
+	defer i.Close()
+	defer other.Close()
+
+	for {
+		a, b := i.Next(), other.Next()
+
+		switch {
+		case a.IsNone() && b.IsNone():
+			return 0
+		case a.IsNone():
+			return -1
+		case b.IsNone():
+			return 1
+		}
+
+		if c := cmp(a.Unwrap(), b.Unwrap()); c != 0 {
+			return c
+		}
+	}
+}
+
+// Lt checks if the Iterator is lexicographically smaller than other according to cmp.
+func (i IteratorForSliceOfInt) Lt(other IteratorForSliceOfInt, cmp func(a, b SliceOfInt) int) bool {
+	return i.Cmp(other, cmp) < 0
+}
+
+// Le checks if the Iterator is lexicographically smaller than or equal to other according to cmp.
+func (i IteratorForSliceOfInt) Le(other IteratorForSliceOfInt, cmp func(a, b SliceOfInt) int) bool {
+	return i.Cmp(other, cmp) <= 0
+}
+
+// Gt checks if the Iterator is lexicographically greater than other according to cmp.
+func (i IteratorForSliceOfInt) Gt(other IteratorForSliceOfInt, cmp func(a, b SliceOfInt) int) bool {
+	return i.Cmp(other, cmp) > 0
+}
+
+// Ge checks if the Iterator is lexicographically greater than or equal to other according to cmp.
+func (i IteratorForSliceOfInt) Ge(other IteratorForSliceOfInt, cmp func(a, b SliceOfInt) int) bool {
+	return i.Cmp(other, cmp) >= 0
+}
+
+// Map returns a new Iterator applying a mapper function to every element.
+func (i IteratorForSliceOfInt) Map(mapper func(item SliceOfInt) SliceOfInt) IteratorForSliceOfInt {
+	return IteratorForSliceOfInt{iter: &mapIterableForSliceOfInt{mapper: mapper, iter: i.iter}}
+}
+
+// Chain returns a new Iterator sequentially joining the two it was built on.
+func (i IteratorForSliceOfInt) Chain(iter IteratorForSliceOfInt) IteratorForSliceOfInt {
+	return IteratorForSliceOfInt{iter: &chainForSliceOfInt{first: i.iter, second: iter.iter, flag: false}}
+}
+
+// Cycle returns a new Iterator endlessly repeating the elements of the Iterator.
+// The elements are buffered during the first pass, unless the Iterator is a Vector.
+func (i IteratorForSliceOfInt) Cycle() IteratorForSliceOfInt {
+	if v, ok := i.iter.(*vectorForSliceOfInt); ok {
+		buffer := v.slice[v.cursor:]
+		v.cursor = uint(len(v.slice))
+
+		return IteratorForSliceOfInt{iter: &cycleForSliceOfInt{iter: v, buffer: buffer, cursor: 0, flag: true}}
+	}
+
+	return IteratorForSliceOfInt{iter: &cycleForSliceOfInt{iter: i.iter, buffer: []SliceOfInt{}, cursor: 0, flag: false}}
+}
+
+// Tee returns n Iterators each yielding all the elements of the Iterator.
+// SliceOfInts are buffered until every one of the n Iterators has yielded them.
+// The Iterator it was built on must not be used directly anymore. It is closed right away if n is zero.
+func (i IteratorForSliceOfInt) Tee(n uint) []IteratorForSliceOfInt {
+	if n == 0 {
+		i.Close()
+		return []IteratorForSliceOfInt{}
+	}
+
+	buffer := &teeBufferForSliceOfInt{iter: i.iter, items: []SliceOfInt{}, offset: 0, positions: make([]uint, n), open: n, flag: false}
+
+	iterators := make([]IteratorForSliceOfInt, n)
+	for k := range iterators {
+		iterators[k] = IteratorForSliceOfInt{iter: &teeForSliceOfInt{buffer: buffer, branch: uint(k), closed: false}}
+	}
+
+	return iterators
+}
+
+// TakeWhile returns a new Iterator yielding elements until predicate becomes false.
+func (i IteratorForSliceOfInt) TakeWhile(predicate func(item SliceOfInt) bool) IteratorForSliceOfInt {
+	return IteratorForSliceOfInt{iter: &takeWhileForSliceOfInt{iter: i.iter, predicate: predicate, flag: false}}
+}
+
+// Take returns a new Iterator yielding only the n next elements.
+func (i IteratorForSliceOfInt) Take(n uint) IteratorForSliceOfInt {
+	return IteratorForSliceOfInt{iter: &takeForSliceOfInt{iter: i.iter, count: 0, max: n, flag: false}}
+}
+
+// Filter returns a new Iterator yielding only elements validating a predicate.
+func (i IteratorForSliceOfInt) Filter(predicate func(item SliceOfInt) bool) IteratorForSliceOfInt {
+	return IteratorForSliceOfInt{iter: &filterForSliceOfInt{iter: i, predicate: predicate}}
+}
+
+// IterableForSliceOfString describes a struct that can be iterated over.
+// Iterables holding resources can also implement io.Closer: adapters pass Close through to their sources,
+// and terminal operations close them once exhausted or short-circuited.
+type IterableForSliceOfString interface {
+	Next() OptionForSliceOfString
+	SizeHint() (lower uint, upper OptionForUint)
+}
+
+// CloneableForSliceOfString is optionally implemented by Iterables which can be copied along with their progress.
+type CloneableForSliceOfString interface {
+	Clone() (IterableForSliceOfString, bool)
+}
+
+// ResettableForSliceOfString is optionally implemented by Iterables which can rewind to their first element.
+type ResettableForSliceOfString interface {
+	Reset()
+}
+
+// SinkForSliceOfString describes a container which elements can be pushed to, such as a buffer or a ring.
+type SinkForSliceOfString interface {
+	Push(item SliceOfString)
+}
+
+// splittableForSliceOfString describes an Iterable which can be split into independent parts.
+type splittableForSliceOfString interface {
+	split(parts uint) []IterableForSliceOfString
+}
+
+// IteratorForSliceOfString embeds an Iterable and provides util functions for it.
+type IteratorForSliceOfString struct {
+	iter IterableForSliceOfString
+}
+
+// Iterator implements Iterable.
+var _ IterableForSliceOfString = IteratorForSliceOfString{}
+
+// Next returns the next element of the Iterator.
+func (i IteratorForSliceOfString) Next() OptionForSliceOfString {
+	return i.iter.Next()
+}
+
+// SizeHint returns the bounds on the number of elements left in the Iterator.
+// The upper bound is None if it is unknown or does not fit in a uint.
+func (i IteratorForSliceOfString) SizeHint() (lower uint, upper OptionForUint) {
+	return i.iter.SizeHint()
+}
+
+// Err returns the error which ended the Iterator early, if any.
+func (i IteratorForSliceOfString) Err() error {
+	return errOf(i.iter)
+}
+
+// Close releases the resources held by the Iterator sources, if any.
+func (i IteratorForSliceOfString) Close() error {
+	return closeOf(i.iter)
+}
+
+// Clone returns a copy of the Iterator which can be advanced independently from it.
+// It returns false if the Iterator is not only built on Cloneable Iterables.
+func (i IteratorForSliceOfString) Clone() (IteratorForSliceOfString, bool) {
+	clone, ok := cloneOfSliceOfString(i.iter)
+	if !ok {
+		return i, false
+	}
+
+	return IteratorForSliceOfString{iter: clone}, true
+}
+
+// Reset rewinds the Iterator to its first element.
+// It returns false if the Iterator is not directly built on a Resettable source such as a Vector or a Range.
+func (i IteratorForSliceOfString) Reset() bool {
+	r, ok := i.iter.(ResettableForSliceOfString)
+	if ok {
+		r.Reset()
+	}
+
+	return ok
+}
+
+// AdvanceBy calls Next n times.
+// It returns an error if it reached the end of the Iterator
+// before it finished to iterate.
+func (i IteratorForSliceOfString) AdvanceBy(n uint) error {
+	for k := uint(0); k < n; k++ {
+		if i.Next().IsNone() {
+			return &errAdvanceBy{}
+		}
+	}
+
+	return nil
+}
+
+// Nth returns the nth element of the Iterator.
+func (i IteratorForSliceOfString) Nth(n uint) OptionForSliceOfString {
+	i.AdvanceBy(n)
+	return i.Next()
+}
+
+// Skip the next n iterations.
+func (i IteratorForSliceOfString) Skip(n uint) IteratorForSliceOfString {
+	i.AdvanceBy(n)
+	return i
+}
+
+// Collect returns a slice containing the elements of the Iterator.
+// The slice is preallocated with the lower bound of the Iterator SizeHint.
+func (i IteratorForSliceOfString) Collect() []SliceOfString {
+	lower, _ := i.SizeHint()
+
+	return i.AppendTo(make([]SliceOfString, 0, lower))
+}
+
+// AppendTo appends the elements of the Iterator to dst and returns the extended slice.
+// dst is only reallocated if its capacity is lower than required by the lower bound of the Iterator SizeHint,
+// so that a buffer can be reused with AppendTo(buffer[:0]).
+func (i IteratorForSliceOfString) AppendTo(dst []SliceOfString) []SliceOfString {
+	defer i.Close()
+
+	if lower, _ := i.SizeHint(); uint(cap(dst)-len(dst)) < lower {
+		grown := make([]SliceOfString, len(dst), uint(len(dst))+lower)
+		copy(grown, dst)
+		dst = grown
+	}
+
+	item := i.Next()
+	for item.IsSome() {
+		dst = append(dst, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return dst
+}
+
+// Extend pushes every element of the Iterator to sink.
+func (i IteratorForSliceOfString) Extend(sink SinkForSliceOfString) {
+	i.ForEach(sink.Push)
+}
+
+// Partition returns two slices containing the elements of the Iterator that validate a predicate and the others.
+func (i IteratorForSliceOfString) Partition(predicate func(item SliceOfString) bool) ([]SliceOfString, []SliceOfString) {
+	matching, others := []SliceOfString{}, []SliceOfString{}
+
+	i.ForEach(func(item SliceOfString) {
+		if predicate(item) {
+			matching = append(matching, item)
+		} else {
+			others = append(others, item)
+		}
+	})
+
+	return matching, others
+}
+
+// JoinWith concatenates the elements of the Iterator formatted by format, or by fmt if format is nil, separated by sep.
+// The result is preallocated for the lower bound of the Iterator SizeHint.
+func (i IteratorForSliceOfString) JoinWith(sep string, format func(item SliceOfString) string) string {
+	if format == nil {
+		format = func(item SliceOfString) string { return fmt.Sprint(item) }
+	}
+
+	var builder strings.Builder
+	if lower, _ := i.SizeHint(); lower > 0 {
+		builder.Grow(int(lower) * (len(sep) + 1))
+	}
+
+	return i.joinInto(&builder, sep, format)
+}
+
+// joinInto writes the elements of the Iterator formatted by format and separated by sep to builder, then closes the Iterator.
+func (i IteratorForSliceOfString) joinInto(builder *strings.Builder, sep string, format func(item SliceOfString) string) string {
+	defer i.Close()
+
+	for item, first := i.Next(), true; item.IsSome(); item, first = i.Next(), false {
+		if !first {
+			builder.WriteString(sep)
+		}
+
+		builder.WriteString(format(item.Unwrap()))
+	}
+
+	return builder.String()
+}
+
+// FoldFirst folds over the Iterator, using its first element as the accumulator initial value.
+func (i IteratorForSliceOfString) FoldFirst(reducer func(acc, item SliceOfString) SliceOfString) OptionForSliceOfString {
+	first := i.Next()
+	if first.IsNone() {
+		i.Close()
+		return NoneSliceOfString()
+	}
+
+	return SomeSliceOfString(i.FoldForSliceOfString(first.Unwrap(), reducer))
+}
+
+// Count consumes the Iterator and returns the number of elements it yielded.
+// Vectors and Ranges are counted without iterating.
+func (i IteratorForSliceOfString) Count() uint {
+	if d, ok := i.iter.(drainable); ok {
+		defer i.Close()
+		return d.drain()
+	}
+
+	return i.FoldForUint(uint(0), func(acc uint, item SliceOfString) uint {
+		return acc + 1
+	})
+}
+
+// Last returns the last element of the Iterator.
+func (i IteratorForSliceOfString) Last() OptionForSliceOfString {
+	return i.FoldForOptionForSliceOfString(NoneSliceOfString(), func(acc OptionForSliceOfString, item SliceOfString) OptionForSliceOfString {
+		return SomeSliceOfString(item)
+	})
+}
+
+// ForEach runs a callback for every element of the iterator.
+func (i IteratorForSliceOfString) ForEach(callback func(item SliceOfString)) {
+	i.FoldForEmpty(Empty{}, func(acc Empty, item SliceOfString) Empty {
+		callback(item)
+		return acc
+	})
+}
+
+// All checks if all the elements of the Iterator validates a predicate.
+func (i IteratorForSliceOfString) All(predicate func(item SliceOfString) bool) bool {
+	_, ok := i.TryFoldForEmpty(Empty{}, func(acc Empty, item SliceOfString) (Empty, bool) {
+		return acc, predicate(item)
+	})
+	return ok
+}
+
+// Any checks if at least one element of the Iterator validates a predicate.
+func (i IteratorForSliceOfString) Any(predicate func(item SliceOfString) bool) bool {
+	_, ok := i.TryFoldForEmpty(Empty{}, func(acc Empty, item SliceOfString) (Empty, bool) {
+		return acc, !predicate(item)
+	})
+	return !ok
+}
+
+// Find returns the first element of the Iterator that validates a predicate.
+func (i IteratorForSliceOfString) Find(predicate func(item SliceOfString) bool) OptionForSliceOfString {
+	r, ok := i.TryFoldForOptionForSliceOfString(NoneSliceOfString(), func(acc OptionForSliceOfString, item SliceOfString) (OptionForSliceOfString, bool) {
+		return SomeSliceOfString(item), !predicate(item)
+	})
+
+	if ok {
+		return NoneSliceOfString()
+	}
+
+	return r
+}
+
+// Position returns the position of the first element of the Iterator that validates a predicate.
+func (i IteratorForSliceOfString) Position(predicate func(item SliceOfString) bool) OptionForUint {
+	r, ok := i.TryFoldForUint(uint(0), func(acc uint, item SliceOfString) (uint, bool) {
+		if predicate(item) {
+			return acc, false
+		}
+
+		return acc + 1, true
+	})
+
+	if ok {
+		return NoneUint()
+	}
+
+	return SomeUint(r)
+}
+
+// find returns the next element validating a predicate, without closing the Iterator.
+func (i IteratorForSliceOfString) find(predicate func(item SliceOfString) bool) OptionForSliceOfString {
+	item := i.Next()
+	for item.IsSome() {
+		if predicate(item.Unwrap()) {
+			return item
+		}
+
+		item = i.Next()
+	}
+
+	return item
+}
+
+// SkipWhile skips the next elements until it reaches one which validates predicate.
+func (i IteratorForSliceOfString) SkipWhile(predicate func(item SliceOfString) bool) IteratorForSliceOfString {
+	i.find(predicate)
+
+	return i
+}
+
+// EqBy checks if the Iterator yields elements equal to those of other according to eq, in the same order.
+// Both are consumed up to their first difference, then closed.
+func (i IteratorForSliceOfString) EqBy(other IteratorForSliceOfString, eq func(a, b SliceOfString) bool) bool {
+	defer i.Close()
+	defer other.Close()
+
+	lower, upper := i.SizeHint()
+	otherLower, otherUpper := other.SizeHint()
+
+	if (upper.IsSome() && upper.Unwrap() < otherLower) || (otherUpper.IsSome() && otherUpper.Unwrap() < lower) {
+		return false
+	}
+
+	for {
+		a, b := i.Next(), other.Next()
+		if a.IsNone() || b.IsNone() {
+			return a.IsNone() && b.IsNone()
+		}
+
+		if !eq(a.Unwrap(), b.Unwrap()) {
+			return false
+		}
+	}
+}
+
+// Cmp compares lexicographically the elements of the Iterator with those of other according to cmp,
+// which returns a negative number, zero or a positive number when its first argument is smaller, equal or greater.
+// An Iterator ending before the other is smaller. Both are consumed up to their first difference, then closed.
+func (i IteratorForSliceOfString) Cmp(other IteratorForSliceOfString, cmp func(a, b SliceOfString) int) int {
+	defer i.Close()
+	defer other.Close()
+
+	for {
+		a, b := i.Next(), other.Next()
+
+		switch {
+		case a.IsNone() && b.IsNone():
+			return 0
+		case a.IsNone():
+			return -1
+		case b.IsNone():
+			return 1
+		}
+
+		if c := cmp(a.Unwrap(), b.Unwrap()); c != 0 {
+			return c
+		}
+	}
+}
+
+// Lt checks if the Iterator is lexicographically smaller than other according to cmp.
+func (i IteratorForSliceOfString) Lt(other IteratorForSliceOfString, cmp func(a, b SliceOfString) int) bool {
+	return i.Cmp(other, cmp) < 0
+}
+
+// Le checks if the Iterator is lexicographically smaller than or equal to other according to cmp.
+func (i IteratorForSliceOfString) Le(other IteratorForSliceOfString, cmp func(a, b SliceOfString) int) bool {
+	return i.Cmp(other, cmp) <= 0
+}
+
+// Gt checks if the Iterator is lexicographically greater than other according to cmp.
+func (i IteratorForSliceOfString) Gt(other IteratorForSliceOfString, cmp func(a, b SliceOfString) int) bool {
+	return i.Cmp(other, cmp) > 0
+}
+
+// Ge checks if the Iterator is lexicographically greater than or equal to other according to cmp.
+func (i IteratorForSliceOfString) Ge(other IteratorForSliceOfString, cmp func(a, b SliceOfString) int) bool {
+	return i.Cmp(other, cmp) >= 0
+}
+
+// Map returns a new Iterator applying a mapper function to every element.
+func (i IteratorForSliceOfString) Map(mapper func(item SliceOfString) SliceOfString) IteratorForSliceOfString {
+	return IteratorForSliceOfString{iter: &mapIterableForSliceOfString{mapper: mapper, iter: i.iter}}
+}
+
+// Chain returns a new Iterator sequentially joining the two it was built on.
+func (i IteratorForSliceOfString) Chain(iter IteratorForSliceOfString) IteratorForSliceOfString {
+	return IteratorForSliceOfString{iter: &chainForSliceOfString{first: i.iter, second: iter.iter, flag: false}}
+}
+
+// Cycle returns a new Iterator endlessly repeating the elements of the Iterator.
+// The elements are buffered during the first pass, unless the Iterator is a Vector.
+func (i IteratorForSliceOfString) Cycle() IteratorForSliceOfString {
+	if v, ok := i.iter.(*vectorForSliceOfString); ok {
+		buffer := v.slice[v.cursor:]
+		v.cursor = uint(len(v.slice))
+
+		return IteratorForSliceOfString{iter: &cycleForSliceOfString{iter: v, buffer: buffer, cursor: 0, flag: true}}
+	}
+
+	return IteratorForSliceOfString{iter: &cycleForSliceOfString{iter: i.iter, buffer: []SliceOfString{}, cursor: 0, flag: false}}
+}
+
+// Tee returns n Iterators each yielding all the elements of the Iterator.
+// SliceOfStrings are buffered until every one of the n Iterators has yielded them.
+// The Iterator it was built on must not be used directly anymore. It is closed right away if n is zero.
+func (i IteratorForSliceOfString) Tee(n uint) []IteratorForSliceOfString {
+	if n == 0 {
+		i.Close()
+		return []IteratorForSliceOfString{}
+	}
+
+	buffer := &teeBufferForSliceOfString{iter: i.iter, items: []SliceOfString{}, offset: 0, positions: make([]uint, n), open: n, flag: false}
+
+	iterators := make([]IteratorForSliceOfString, n)
+	for k := range iterators {
+		iterators[k] = IteratorForSliceOfString{iter: &teeForSliceOfString{buffer: buffer, branch: uint(k), closed: false}}
+	}
+
+	return iterators
+}
+
+// TakeWhile returns a new Iterator yielding elements until predicate becomes false.
+func (i IteratorForSliceOfString) TakeWhile(predicate func(item SliceOfString) bool) IteratorForSliceOfString {
+	return IteratorForSliceOfString{iter: &takeWhileForSliceOfString{iter: i.iter, predicate: predicate, flag: false}}
+}
+
+// Take returns a new Iterator yielding only the n next elements.
+func (i IteratorForSliceOfString) Take(n uint) IteratorForSliceOfString {
+	return IteratorForSliceOfString{iter: &takeForSliceOfString{iter: i.iter, count: 0, max: n, flag: false}}
+}
+
+// Filter returns a new Iterator yielding only elements validating a predicate.
+func (i IteratorForSliceOfString) Filter(predicate func(item SliceOfString) bool) IteratorForSliceOfString {
+	return IteratorForSliceOfString{iter: &filterForSliceOfString{iter: i, predicate: predicate}}
+}
+
+// IterableForSliceOfEntryForStringToInt describes a struct that can be iterated over.
+// Iterables holding resources can also implement io.Closer: adapters pass Close through to their sources,
+// and terminal operations close them once exhausted or short-circuited.
+type IterableForSliceOfEntryForStringToInt interface {
+	Next() OptionForSliceOfEntryForStringToInt
+	SizeHint() (lower uint, upper OptionForUint)
+}
+
+// CloneableForSliceOfEntryForStringToInt is optionally implemented by Iterables which can be copied along with their progress.
+type CloneableForSliceOfEntryForStringToInt interface {
+	Clone() (IterableForSliceOfEntryForStringToInt, bool)
+}
+
+// ResettableForSliceOfEntryForStringToInt is optionally implemented by Iterables which can rewind to their first element.
+type ResettableForSliceOfEntryForStringToInt interface {
+	Reset()
+}
+
+// SinkForSliceOfEntryForStringToInt describes a container which elements can be pushed to, such as a buffer or a ring.
+type SinkForSliceOfEntryForStringToInt interface {
+	Push(item SliceOfEntryForStringToInt)
+}
+
+// splittableForSliceOfEntryForStringToInt describes an Iterable which can be split into independent parts.
+type splittableForSliceOfEntryForStringToInt interface {
+	split(parts uint) []IterableForSliceOfEntryForStringToInt
+}
+
+// IteratorForSliceOfEntryForStringToInt embeds an Iterable and provides util functions for it.
+type IteratorForSliceOfEntryForStringToInt struct {
+	iter IterableForSliceOfEntryForStringToInt
+}
+
+// Iterator implements Iterable.
+var _ IterableForSliceOfEntryForStringToInt = IteratorForSliceOfEntryForStringToInt{}
+
+// Next returns the next element of the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	return i.iter.Next()
+}
+
+// SizeHint returns the bounds on the number of elements left in the Iterator.
+// The upper bound is None if it is unknown or does not fit in a uint.
+func (i IteratorForSliceOfEntryForStringToInt) SizeHint() (lower uint, upper OptionForUint) {
+	return i.iter.SizeHint()
+}
+
+// Err returns the error which ended the Iterator early, if any.
+func (i IteratorForSliceOfEntryForStringToInt) Err() error {
+	return errOf(i.iter)
+}
+
+// Close releases the resources held by the Iterator sources, if any.
+func (i IteratorForSliceOfEntryForStringToInt) Close() error {
+	return closeOf(i.iter)
+}
+
+// Clone returns a copy of the Iterator which can be advanced independently from it.
+// It returns false if the Iterator is not only built on Cloneable Iterables.
+func (i IteratorForSliceOfEntryForStringToInt) Clone() (IteratorForSliceOfEntryForStringToInt, bool) {
+	clone, ok := cloneOfSliceOfEntryForStringToInt(i.iter)
+	if !ok {
+		return i, false
+	}
+
+	return IteratorForSliceOfEntryForStringToInt{iter: clone}, true
+}
+
+// Reset rewinds the Iterator to its first element.
+// It returns false if the Iterator is not directly built on a Resettable source such as a Vector or a Range.
+func (i IteratorForSliceOfEntryForStringToInt) Reset() bool {
+	r, ok := i.iter.(ResettableForSliceOfEntryForStringToInt)
+	if ok {
+		r.Reset()
+	}
+
+	return ok
+}
+
+// AdvanceBy calls Next n times.
+// It returns an error if it reached the end of the Iterator
+// before it finished to iterate.
+func (i IteratorForSliceOfEntryForStringToInt) AdvanceBy(n uint) error {
+	for k := uint(0); k < n; k++ {
+		if i.Next().IsNone() {
+			return &errAdvanceBy{}
+		}
+	}
+
+	return nil
+}
+
+// Nth returns the nth element of the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) Nth(n uint) OptionForSliceOfEntryForStringToInt {
+	i.AdvanceBy(n)
+	return i.Next()
+}
+
+// Skip the next n iterations.
+func (i IteratorForSliceOfEntryForStringToInt) Skip(n uint) IteratorForSliceOfEntryForStringToInt {
+	i.AdvanceBy(n)
+	return i
+}
+
+// Collect returns a slice containing the elements of the Iterator.
+// The slice is preallocated with the lower bound of the Iterator SizeHint.
+func (i IteratorForSliceOfEntryForStringToInt) Collect() []SliceOfEntryForStringToInt {
+	lower, _ := i.SizeHint()
+
+	return i.AppendTo(make([]SliceOfEntryForStringToInt, 0, lower))
+}
+
+// AppendTo appends the elements of the Iterator to dst and returns the extended slice.
+// dst is only reallocated if its capacity is lower than required by the lower bound of the Iterator SizeHint,
+// so that a buffer can be reused with AppendTo(buffer[:0]).
+func (i IteratorForSliceOfEntryForStringToInt) AppendTo(dst []SliceOfEntryForStringToInt) []SliceOfEntryForStringToInt {
+	defer i.Close()
+
+	if lower, _ := i.SizeHint(); uint(cap(dst)-len(dst)) < lower {
+		grown := make([]SliceOfEntryForStringToInt, len(dst), uint(len(dst))+lower)
+		copy(grown, dst)
+		dst = grown
+	}
+
+	item := i.Next()
+	for item.IsSome() {
+		dst = append(dst, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return dst
+}
+
+// Extend pushes every element of the Iterator to sink.
+func (i IteratorForSliceOfEntryForStringToInt) Extend(sink SinkForSliceOfEntryForStringToInt) {
+	i.ForEach(sink.Push)
+}
+
+// Partition returns two slices containing the elements of the Iterator that validate a predicate and the others.
+func (i IteratorForSliceOfEntryForStringToInt) Partition(predicate func(item SliceOfEntryForStringToInt) bool) ([]SliceOfEntryForStringToInt, []SliceOfEntryForStringToInt) {
+	matching, others := []SliceOfEntryForStringToInt{}, []SliceOfEntryForStringToInt{}
+
+	i.ForEach(func(item SliceOfEntryForStringToInt) {
+		if predicate(item) {
+			matching = append(matching, item)
+		} else {
+			others = append(others, item)
+		}
+	})
+
+	return matching, others
+}
+
+// JoinWith concatenates the elements of the Iterator formatted by format, or by fmt if format is nil, separated by sep.
+// The result is preallocated for the lower bound of the Iterator SizeHint.
+func (i IteratorForSliceOfEntryForStringToInt) JoinWith(sep string, format func(item SliceOfEntryForStringToInt) string) string {
+	if format == nil {
+		format = func(item SliceOfEntryForStringToInt) string { return fmt.Sprint(item) }
+	}
+
+	var builder strings.Builder
+	if lower, _ := i.SizeHint(); lower > 0 {
+		builder.Grow(int(lower) * (len(sep) + 1))
+	}
+
+	return i.joinInto(&builder, sep, format)
+}
+
+// joinInto writes the elements of the Iterator formatted by format and separated by sep to builder, then closes the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) joinInto(builder *strings.Builder, sep string, format func(item SliceOfEntryForStringToInt) string) string {
+	defer i.Close()
+
+	for item, first := i.Next(), true; item.IsSome(); item, first = i.Next(), false {
+		if !first {
+			builder.WriteString(sep)
+		}
+
+		builder.WriteString(format(item.Unwrap()))
+	}
+
+	return builder.String()
+}
+
+// FoldFirst folds over the Iterator, using its first element as the accumulator initial value.
+func (i IteratorForSliceOfEntryForStringToInt) FoldFirst(reducer func(acc, item SliceOfEntryForStringToInt) SliceOfEntryForStringToInt) OptionForSliceOfEntryForStringToInt {
+	first := i.Next()
+	if first.IsNone() {
+		i.Close()
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	return SomeSliceOfEntryForStringToInt(i.FoldForSliceOfEntryForStringToInt(first.Unwrap(), reducer))
+}
+
+// Count consumes the Iterator and returns the number of elements it yielded.
+// Vectors and Ranges are counted without iterating.
+func (i IteratorForSliceOfEntryForStringToInt) Count() uint {
+	if d, ok := i.iter.(drainable); ok {
+		defer i.Close()
+		return d.drain()
+	}
+
+	return i.FoldForUint(uint(0), func(acc uint, item SliceOfEntryForStringToInt) uint {
+		return acc + 1
+	})
+}
+
+// Last returns the last element of the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) Last() OptionForSliceOfEntryForStringToInt {
+	return i.FoldForOptionForSliceOfEntryForStringToInt(NoneSliceOfEntryForStringToInt(), func(acc OptionForSliceOfEntryForStringToInt, item SliceOfEntryForStringToInt) OptionForSliceOfEntryForStringToInt {
+		return SomeSliceOfEntryForStringToInt(item)
+	})
+}
+
+// ForEach runs a callback for every element of the iterator.
+func (i IteratorForSliceOfEntryForStringToInt) ForEach(callback func(item SliceOfEntryForStringToInt)) {
+	i.FoldForEmpty(Empty{}, func(acc Empty, item SliceOfEntryForStringToInt) Empty {
+		callback(item)
+		return acc
+	})
+}
+
+// All checks if all the elements of the Iterator validates a predicate.
+func (i IteratorForSliceOfEntryForStringToInt) All(predicate func(item SliceOfEntryForStringToInt) bool) bool {
+	_, ok := i.TryFoldForEmpty(Empty{}, func(acc Empty, item SliceOfEntryForStringToInt) (Empty, bool) {
+		return acc, predicate(item)
+	})
+	return ok
+}
+
+// Any checks if at least one element of the Iterator validates a predicate.
+func (i IteratorForSliceOfEntryForStringToInt) Any(predicate func(item SliceOfEntryForStringToInt) bool) bool {
+	_, ok := i.TryFoldForEmpty(Empty{}, func(acc Empty, item SliceOfEntryForStringToInt) (Empty, bool) {
+		return acc, !predicate(item)
+	})
+	return !ok
+}
+
+// Find returns the first element of the Iterator that validates a predicate.
+func (i IteratorForSliceOfEntryForStringToInt) Find(predicate func(item SliceOfEntryForStringToInt) bool) OptionForSliceOfEntryForStringToInt {
+	r, ok := i.TryFoldForOptionForSliceOfEntryForStringToInt(NoneSliceOfEntryForStringToInt(), func(acc OptionForSliceOfEntryForStringToInt, item SliceOfEntryForStringToInt) (OptionForSliceOfEntryForStringToInt, bool) {
+		return SomeSliceOfEntryForStringToInt(item), !predicate(item)
+	})
+
+	if ok {
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	return r
+}
+
+// Position returns the position of the first element of the Iterator that validates a predicate.
+func (i IteratorForSliceOfEntryForStringToInt) Position(predicate func(item SliceOfEntryForStringToInt) bool) OptionForUint {
+	r, ok := i.TryFoldForUint(uint(0), func(acc uint, item SliceOfEntryForStringToInt) (uint, bool) {
+		if predicate(item) {
+			return acc, false
+		}
+
+		return acc + 1, true
+	})
+
+	if ok {
+		return NoneUint()
+	}
+
+	return SomeUint(r)
+}
+
+// find returns the next element validating a predicate, without closing the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) find(predicate func(item SliceOfEntryForStringToInt) bool) OptionForSliceOfEntryForStringToInt {
+	item := i.Next()
+	for item.IsSome() {
+		if predicate(item.Unwrap()) {
+			return item
+		}
+
+		item = i.Next()
+	}
+
+	return item
+}
+
+// SkipWhile skips the next elements until it reaches one which validates predicate.
+func (i IteratorForSliceOfEntryForStringToInt) SkipWhile(predicate func(item SliceOfEntryForStringToInt) bool) IteratorForSliceOfEntryForStringToInt {
+	i.find(predicate)
+
+	return i
+}
+
+// EqBy checks if the Iterator yields elements equal to those of other according to eq, in the same order.
+// Both are consumed up to their first difference, then closed.
+func (i IteratorForSliceOfEntryForStringToInt) EqBy(other IteratorForSliceOfEntryForStringToInt, eq func(a, b SliceOfEntryForStringToInt) bool) bool {
+	defer i.Close()
+	defer other.Close()
+
+	lower, upper := i.SizeHint()
+	otherLower, otherUpper := other.SizeHint()
+
+	if (upper.IsSome() && upper.Unwrap() < otherLower) || (otherUpper.IsSome() && otherUpper.Unwrap() < lower) {
+		return false
+	}
+
+	for {
+		a, b := i.Next(), other.Next()
+		if a.IsNone() || b.IsNone() {
+			return a.IsNone() && b.IsNone()
+		}
+
+		if !eq(a.Unwrap(), b.Unwrap()) {
+			return false
+		}
+	}
+}
+
+// Cmp compares lexicographically the elements of the Iterator with those of other according to cmp,
+// which returns a negative number, zero or a positive number when its first argument is smaller, equal or greater.
+// An Iterator ending before the other is smaller. Both are consumed up to their first difference, then closed.
+func (i IteratorForSliceOfEntryForStringToInt) Cmp(other IteratorForSliceOfEntryForStringToInt, cmp func(a, b SliceOfEntryForStringToInt) int) int {
+	defer i.Close()
+	defer other.Close()
+
+	for {
+		a, b := i.Next(), other.Next()
+
+		switch {
+		case a.IsNone() && b.IsNone():
+			return 0
+		case a.IsNone():
+			return -1
+		case b.IsNone():
+			return 1
+		}
+
+		if c := cmp(a.Unwrap(), b.Unwrap()); c != 0 {
+			return c
+		}
+	}
+}
+
+// Lt checks if the Iterator is lexicographically smaller than other according to cmp.
+func (i IteratorForSliceOfEntryForStringToInt) Lt(other IteratorForSliceOfEntryForStringToInt, cmp func(a, b SliceOfEntryForStringToInt) int) bool {
+	return i.Cmp(other, cmp) < 0
+}
+
+// Le checks if the Iterator is lexicographically smaller than or equal to other according to cmp.
+func (i IteratorForSliceOfEntryForStringToInt) Le(other IteratorForSliceOfEntryForStringToInt, cmp func(a, b SliceOfEntryForStringToInt) int) bool {
+	return i.Cmp(other, cmp) <= 0
+}
+
+// Gt checks if the Iterator is lexicographically greater than other according to cmp.
+func (i IteratorForSliceOfEntryForStringToInt) Gt(other IteratorForSliceOfEntryForStringToInt, cmp func(a, b SliceOfEntryForStringToInt) int) bool {
+	return i.Cmp(other, cmp) > 0
+}
+
+// Ge checks if the Iterator is lexicographically greater than or equal to other according to cmp.
+func (i IteratorForSliceOfEntryForStringToInt) Ge(other IteratorForSliceOfEntryForStringToInt, cmp func(a, b SliceOfEntryForStringToInt) int) bool {
+	return i.Cmp(other, cmp) >= 0
+}
+
+// Map returns a new Iterator applying a mapper function to every element.
+func (i IteratorForSliceOfEntryForStringToInt) Map(mapper func(item SliceOfEntryForStringToInt) SliceOfEntryForStringToInt) IteratorForSliceOfEntryForStringToInt {
+	return IteratorForSliceOfEntryForStringToInt{iter: &mapIterableForSliceOfEntryForStringToInt{mapper: mapper, iter: i.iter}}
+}
+
+// Chain returns a new Iterator sequentially joining the two it was built on.
+func (i IteratorForSliceOfEntryForStringToInt) Chain(iter IteratorForSliceOfEntryForStringToInt) IteratorForSliceOfEntryForStringToInt {
+	return IteratorForSliceOfEntryForStringToInt{iter: &chainForSliceOfEntryForStringToInt{first: i.iter, second: iter.iter, flag: false}}
+}
+
+// Cycle returns a new Iterator endlessly repeating the elements of the Iterator.
+// The elements are buffered during the first pass, unless the Iterator is a Vector.
+func (i IteratorForSliceOfEntryForStringToInt) Cycle() IteratorForSliceOfEntryForStringToInt {
+	if v, ok := i.iter.(*vectorForSliceOfEntryForStringToInt); ok {
+		buffer := v.slice[v.cursor:]
+		v.cursor = uint(len(v.slice))
+
+		return IteratorForSliceOfEntryForStringToInt{iter: &cycleForSliceOfEntryForStringToInt{iter: v, buffer: buffer, cursor: 0, flag: true}}
+	}
+
+	return IteratorForSliceOfEntryForStringToInt{iter: &cycleForSliceOfEntryForStringToInt{iter: i.iter, buffer: []SliceOfEntryForStringToInt{}, cursor: 0, flag: false}}
+}
+
+// Tee returns n Iterators each yielding all the elements of the Iterator.
+// SliceOfEntryForStringToInts are buffered until every one of the n Iterators has yielded them.
+// The Iterator it was built on must not be used directly anymore. It is closed right away if n is zero.
+func (i IteratorForSliceOfEntryForStringToInt) Tee(n uint) []IteratorForSliceOfEntryForStringToInt {
+	if n == 0 {
+		i.Close()
+		return []IteratorForSliceOfEntryForStringToInt{}
+	}
+
+	buffer := &teeBufferForSliceOfEntryForStringToInt{iter: i.iter, items: []SliceOfEntryForStringToInt{}, offset: 0, positions: make([]uint, n), open: n, flag: false}
+
+	iterators := make([]IteratorForSliceOfEntryForStringToInt, n)
+	for k := range iterators {
+		iterators[k] = IteratorForSliceOfEntryForStringToInt{iter: &teeForSliceOfEntryForStringToInt{buffer: buffer, branch: uint(k), closed: false}}
+	}
+
+	return iterators
+}
+
+// TakeWhile returns a new Iterator yielding elements until predicate becomes false.
+func (i IteratorForSliceOfEntryForStringToInt) TakeWhile(predicate func(item SliceOfEntryForStringToInt) bool) IteratorForSliceOfEntryForStringToInt {
+	return IteratorForSliceOfEntryForStringToInt{iter: &takeWhileForSliceOfEntryForStringToInt{iter: i.iter, predicate: predicate, flag: false}}
+}
+
+// Take returns a new Iterator yielding only the n next elements.
+func (i IteratorForSliceOfEntryForStringToInt) Take(n uint) IteratorForSliceOfEntryForStringToInt {
+	return IteratorForSliceOfEntryForStringToInt{iter: &takeForSliceOfEntryForStringToInt{iter: i.iter, count: 0, max: n, flag: false}}
+}
+
+// Filter returns a new Iterator yielding only elements validating a predicate.
+func (i IteratorForSliceOfEntryForStringToInt) Filter(predicate func(item SliceOfEntryForStringToInt) bool) IteratorForSliceOfEntryForStringToInt {
+	return IteratorForSliceOfEntryForStringToInt{iter: &filterForSliceOfEntryForStringToInt{iter: i, predicate: predicate}}
+}
+
+// IterableForCSVRecord describes a struct that can be iterated over.
+// Iterables holding resources can also implement io.Closer: adapters pass Close through to their sources,
+// and terminal operations close them once exhausted or short-circuited.
+type IterableForCSVRecord interface {
+	Next() OptionForCSVRecord
+	SizeHint() (lower uint, upper OptionForUint)
+}
+
+// CloneableForCSVRecord is optionally implemented by Iterables which can be copied along with their progress.
+type CloneableForCSVRecord interface {
+	Clone() (IterableForCSVRecord, bool)
+}
+
+// ResettableForCSVRecord is optionally implemented by Iterables which can rewind to their first element.
+type ResettableForCSVRecord interface {
+	Reset()
+}
+
+// SinkForCSVRecord describes a container which elements can be pushed to, such as a buffer or a ring.
+type SinkForCSVRecord interface {
+	Push(item CSVRecord)
+}
+
+// splittableForCSVRecord describes an Iterable which can be split into independent parts.
+type splittableForCSVRecord interface {
+	split(parts uint) []IterableForCSVRecord
+}
+
+// IteratorForCSVRecord embeds an Iterable and provides util functions for it.
+type IteratorForCSVRecord struct {
+	iter IterableForCSVRecord
+}
+
+// Iterator implements Iterable.
+var _ IterableForCSVRecord = IteratorForCSVRecord{}
+
+// Next returns the next element of the Iterator.
+func (i IteratorForCSVRecord) Next() OptionForCSVRecord {
+	return i.iter.Next()
+}
+
+// SizeHint returns the bounds on the number of elements left in the Iterator.
+// The upper bound is None if it is unknown or does not fit in a uint.
+func (i IteratorForCSVRecord) SizeHint() (lower uint, upper OptionForUint) {
+	return i.iter.SizeHint()
+}
+
+// Err returns the error which ended the Iterator early, if any.
+func (i IteratorForCSVRecord) Err() error {
+	return errOf(i.iter)
+}
+
+// Close releases the resources held by the Iterator sources, if any.
+func (i IteratorForCSVRecord) Close() error {
+	return closeOf(i.iter)
+}
+
+// Clone returns a copy of the Iterator which can be advanced independently from it.
+// It returns false if the Iterator is not only built on Cloneable Iterables.
+func (i IteratorForCSVRecord) Clone() (IteratorForCSVRecord, bool) {
+	clone, ok := cloneOfCSVRecord(i.iter)
+	if !ok {
+		return i, false
+	}
+
+	return IteratorForCSVRecord{iter: clone}, true
+}
+
+// Reset rewinds the Iterator to its first element.
+// It returns false if the Iterator is not directly built on a Resettable source such as a Vector or a Range.
+func (i IteratorForCSVRecord) Reset() bool {
+	r, ok := i.iter.(ResettableForCSVRecord)
+	if ok {
+		r.Reset()
+	}
+
+	return ok
+}
+
+// AdvanceBy calls Next n times.
+// It returns an error if it reached the end of the Iterator
+// before it finished to iterate.
+func (i IteratorForCSVRecord) AdvanceBy(n uint) error {
+	for k := uint(0); k < n; k++ {
+		if i.Next().IsNone() {
+			return &errAdvanceBy{}
+		}
+	}
+
+	return nil
+}
+
+// Nth returns the nth element of the Iterator.
+func (i IteratorForCSVRecord) Nth(n uint) OptionForCSVRecord {
+	i.AdvanceBy(n)
+	return i.Next()
+}
+
+// Skip the next n iterations.
+func (i IteratorForCSVRecord) Skip(n uint) IteratorForCSVRecord {
+	i.AdvanceBy(n)
+	return i
+}
+
+// Collect returns a slice containing the elements of the Iterator.
+// The slice is preallocated with the lower bound of the Iterator SizeHint.
+func (i IteratorForCSVRecord) Collect() []CSVRecord {
+	lower, _ := i.SizeHint()
+
+	return i.AppendTo(make([]CSVRecord, 0, lower))
+}
+
+// AppendTo appends the elements of the Iterator to dst and returns the extended slice.
+// dst is only reallocated if its capacity is lower than required by the lower bound of the Iterator SizeHint,
+// so that a buffer can be reused with AppendTo(buffer[:0]).
+func (i IteratorForCSVRecord) AppendTo(dst []CSVRecord) []CSVRecord {
+	defer i.Close()
+
+	if lower, _ := i.SizeHint(); uint(cap(dst)-len(dst)) < lower {
+		grown := make([]CSVRecord, len(dst), uint(len(dst))+lower)
+		copy(grown, dst)
+		dst = grown
+	}
+
+	item := i.Next()
+	for item.IsSome() {
+		dst = append(dst, item.Unwrap())
+
+		item = i.Next()
+	}
+
+	return dst
+}
+
+// Extend pushes every element of the Iterator to sink.
+func (i IteratorForCSVRecord) Extend(sink SinkForCSVRecord) {
+	i.ForEach(sink.Push)
+}
+
+// Partition returns two slices containing the elements of the Iterator that validate a predicate and the others.
+func (i IteratorForCSVRecord) Partition(predicate func(item CSVRecord) bool) ([]CSVRecord, []CSVRecord) {
+	matching, others := []CSVRecord{}, []CSVRecord{}
+
+	i.ForEach(func(item CSVRecord) {
+		if predicate(item) {
+			matching = append(matching, item)
+		} else {
+			others = append(others, item)
+		}
+	})
+
+	return matching, others
+}
+
+// JoinWith concatenates the elements of the Iterator formatted by format, or by fmt if format is nil, separated by sep.
+// The result is preallocated for the lower bound of the Iterator SizeHint.
+func (i IteratorForCSVRecord) JoinWith(sep string, format func(item CSVRecord) string) string {
+	if format == nil {
+		format = func(item CSVRecord) string { return fmt.Sprint(item) }
+	}
+
+	var builder strings.Builder
+	if lower, _ := i.SizeHint(); lower > 0 {
+		builder.Grow(int(lower) * (len(sep) + 1))
+	}
+
+	return i.joinInto(&builder, sep, format)
+}
+
+// joinInto writes the elements of the Iterator formatted by format and separated by sep to builder, then closes the Iterator.
+func (i IteratorForCSVRecord) joinInto(builder *strings.Builder, sep string, format func(item CSVRecord) string) string {
+	defer i.Close()
+
+	for item, first := i.Next(), true; item.IsSome(); item, first = i.Next(), false {
+		if !first {
+			builder.WriteString(sep)
+		}
+
+		builder.WriteString(format(item.Unwrap()))
+	}
+
+	return builder.String()
+}
+
+// FoldFirst folds over the Iterator, using its first element as the accumulator initial value.
+func (i IteratorForCSVRecord) FoldFirst(reducer func(acc, item CSVRecord) CSVRecord) OptionForCSVRecord {
+	first := i.Next()
+	if first.IsNone() {
+		i.Close()
+		return NoneCSVRecord()
+	}
+
+	return SomeCSVRecord(i.FoldForCSVRecord(first.Unwrap(), reducer))
+}
+
+// Count consumes the Iterator and returns the number of elements it yielded.
+// Vectors and Ranges are counted without iterating.
+func (i IteratorForCSVRecord) Count() uint {
+	if d, ok := i.iter.(drainable); ok {
+		defer i.Close()
+		return d.drain()
+	}
+
+	return i.FoldForUint(uint(0), func(acc uint, item CSVRecord) uint {
+		return acc + 1
+	})
+}
+
+// Last returns the last element of the Iterator.
+func (i IteratorForCSVRecord) Last() OptionForCSVRecord {
+	return i.FoldForOptionForCSVRecord(NoneCSVRecord(), func(acc OptionForCSVRecord, item CSVRecord) OptionForCSVRecord {
+		return SomeCSVRecord(item)
+	})
+}
+
+// ForEach runs a callback for every element of the iterator.
+func (i IteratorForCSVRecord) ForEach(callback func(item CSVRecord)) {
+	i.FoldForEmpty(Empty{}, func(acc Empty, item CSVRecord) Empty {
+		callback(item)
+		return acc
+	})
+}
+
+// All checks if all the elements of the Iterator validates a predicate.
+func (i IteratorForCSVRecord) All(predicate func(item CSVRecord) bool) bool {
+	_, ok := i.TryFoldForEmpty(Empty{}, func(acc Empty, item CSVRecord) (Empty, bool) {
+		return acc, predicate(item)
+	})
+	return ok
+}
+
+// Any checks if at least one element of the Iterator validates a predicate.
+func (i IteratorForCSVRecord) Any(predicate func(item CSVRecord) bool) bool {
+	_, ok := i.TryFoldForEmpty(Empty{}, func(acc Empty, item CSVRecord) (Empty, bool) {
+		return acc, !predicate(item)
+	})
+	return !ok
+}
+
+// Find returns the first element of the Iterator that validates a predicate.
+func (i IteratorForCSVRecord) Find(predicate func(item CSVRecord) bool) OptionForCSVRecord {
+	r, ok := i.TryFoldForOptionForCSVRecord(NoneCSVRecord(), func(acc OptionForCSVRecord, item CSVRecord) (OptionForCSVRecord, bool) {
+		return SomeCSVRecord(item), !predicate(item)
+	})
+
+	if ok {
+		return NoneCSVRecord()
+	}
+
+	return r
+}
+
+// Position returns the position of the first element of the Iterator that validates a predicate.
+func (i IteratorForCSVRecord) Position(predicate func(item CSVRecord) bool) OptionForUint {
+	r, ok := i.TryFoldForUint(uint(0), func(acc uint, item CSVRecord) (uint, bool) {
+		if predicate(item) {
+			return acc, false
+		}
+
+		return acc + 1, true
+	})
+
+	if ok {
+		return NoneUint()
+	}
+
+	return SomeUint(r)
+}
+
+// find returns the next element validating a predicate, without closing the Iterator.
+func (i IteratorForCSVRecord) find(predicate func(item CSVRecord) bool) OptionForCSVRecord {
+	item := i.Next()
+	for item.IsSome() {
+		if predicate(item.Unwrap()) {
+			return item
+		}
+
+		item = i.Next()
+	}
+
+	return item
+}
+
+// SkipWhile skips the next elements until it reaches one which validates predicate.
+func (i IteratorForCSVRecord) SkipWhile(predicate func(item CSVRecord) bool) IteratorForCSVRecord {
+	i.find(predicate)
+
+	return i
+}
+
+// EqBy checks if the Iterator yields elements equal to those of other according to eq, in the same order.
+// Both are consumed up to their first difference, then closed.
+func (i IteratorForCSVRecord) EqBy(other IteratorForCSVRecord, eq func(a, b CSVRecord) bool) bool {
+	defer i.Close()
+	defer other.Close()
+
+	lower, upper := i.SizeHint()
+	otherLower, otherUpper := other.SizeHint()
+
+	if (upper.IsSome() && upper.Unwrap() < otherLower) || (otherUpper.IsSome() && otherUpper.Unwrap() < lower) {
+		return false
+	}
+
+	for {
+		a, b := i.Next(), other.Next()
+		if a.IsNone() || b.IsNone() {
+			return a.IsNone() && b.IsNone()
+		}
+
+		if !eq(a.Unwrap(), b.Unwrap()) {
+			return false
+		}
+	}
+}
+
+// Cmp compares lexicographically the elements of the Iterator with those of other according to cmp,
+// which returns a negative number, zero or a positive number when its first argument is smaller, equal or greater.
+// An Iterator ending before the other is smaller. Both are consumed up to their first difference, then closed.
+func (i IteratorForCSVRecord) Cmp(other IteratorForCSVRecord, cmp func(a, b CSVRecord) int) int {
+	defer i.Close()
+	defer other.Close()
+
+	for {
+		a, b := i.Next(), other.Next()
+
+		switch {
+		case a.IsNone() && b.IsNone():
+			return 0
+		case a.IsNone():
+			return -1
+		case b.IsNone():
+			return 1
+		}
+
+		if c := cmp(a.Unwrap(), b.Unwrap()); c != 0 {
+			return c
+		}
+	}
+}
+
+// Lt checks if the Iterator is lexicographically smaller than other according to cmp.
+func (i IteratorForCSVRecord) Lt(other IteratorForCSVRecord, cmp func(a, b CSVRecord) int) bool {
+	return i.Cmp(other, cmp) < 0
+}
+
+// Le checks if the Iterator is lexicographically smaller than or equal to other according to cmp.
+func (i IteratorForCSVRecord) Le(other IteratorForCSVRecord, cmp func(a, b CSVRecord) int) bool {
+	return i.Cmp(other, cmp) <= 0
+}
+
+// Gt checks if the Iterator is lexicographically greater than other according to cmp.
+func (i IteratorForCSVRecord) Gt(other IteratorForCSVRecord, cmp func(a, b CSVRecord) int) bool {
+	return i.Cmp(other, cmp) > 0
+}
+
+// Ge checks if the Iterator is lexicographically greater than or equal to other according to cmp.
+func (i IteratorForCSVRecord) Ge(other IteratorForCSVRecord, cmp func(a, b CSVRecord) int) bool {
+	return i.Cmp(other, cmp) >= 0
+}
+
+// Map returns a new Iterator applying a mapper function to every element.
+func (i IteratorForCSVRecord) Map(mapper func(item CSVRecord) CSVRecord) IteratorForCSVRecord {
+	return IteratorForCSVRecord{iter: &mapIterableForCSVRecord{mapper: mapper, iter: i.iter}}
+}
+
+// Chain returns a new Iterator sequentially joining the two it was built on.
+func (i IteratorForCSVRecord) Chain(iter IteratorForCSVRecord) IteratorForCSVRecord {
+	return IteratorForCSVRecord{iter: &chainForCSVRecord{first: i.iter, second: iter.iter, flag: false}}
+}
+
+// Cycle returns a new Iterator endlessly repeating the elements of the Iterator.
+// The elements are buffered during the first pass, unless the Iterator is a Vector.
+func (i IteratorForCSVRecord) Cycle() IteratorForCSVRecord {
+	if v, ok := i.iter.(*vectorForCSVRecord); ok {
+		buffer := v.slice[v.cursor:]
+		v.cursor = uint(len(v.slice))
+
+		return IteratorForCSVRecord{iter: &cycleForCSVRecord{iter: v, buffer: buffer, cursor: 0, flag: true}}
+	}
+
+	return IteratorForCSVRecord{iter: &cycleForCSVRecord{iter: i.iter, buffer: []CSVRecord{}, cursor: 0, flag: false}}
+}
+
+// Tee returns n Iterators each yielding all the elements of the Iterator.
+// CSVRecords are buffered until every one of the n Iterators has yielded them.
+// The Iterator it was built on must not be used directly anymore. It is closed right away if n is zero.
+func (i IteratorForCSVRecord) Tee(n uint) []IteratorForCSVRecord {
+	if n == 0 {
+		i.Close()
+		return []IteratorForCSVRecord{}
+	}
+
+	buffer := &teeBufferForCSVRecord{iter: i.iter, items: []CSVRecord{}, offset: 0, positions: make([]uint, n), open: n, flag: false}
+
+	iterators := make([]IteratorForCSVRecord, n)
+	for k := range iterators {
+		iterators[k] = IteratorForCSVRecord{iter: &teeForCSVRecord{buffer: buffer, branch: uint(k), closed: false}}
+	}
+
+	return iterators
+}
+
+// TakeWhile returns a new Iterator yielding elements until predicate becomes false.
+func (i IteratorForCSVRecord) TakeWhile(predicate func(item CSVRecord) bool) IteratorForCSVRecord {
+	return IteratorForCSVRecord{iter: &takeWhileForCSVRecord{iter: i.iter, predicate: predicate, flag: false}}
+}
+
+// Take returns a new Iterator yielding only the n next elements.
+func (i IteratorForCSVRecord) Take(n uint) IteratorForCSVRecord {
+	return IteratorForCSVRecord{iter: &takeForCSVRecord{iter: i.iter, count: 0, max: n, flag: false}}
+}
+
+// Filter returns a new Iterator yielding only elements validating a predicate.
+func (i IteratorForCSVRecord) Filter(predicate func(item CSVRecord) bool) IteratorForCSVRecord {
+	return IteratorForCSVRecord{iter: &filterForCSVRecord{iter: i, predicate: predicate}}
+}
@@ -4,6 +4,16 @@
 
 package iter
 
+// cloneOfInt clones an Iterable if it is Cloneable.
+func cloneOfInt(iter IterableForInt) (IterableForInt, bool) {
+	c, ok := iter.(CloneableForInt)
+	if !ok {
+		return nil, false
+	}
+
+	return c.Clone()
+}
+
 type mapIterableForInt struct {
 	iter   IterableForInt
 	mapper func(item int) int
@@ -18,6 +28,27 @@ func (m *mapIterableForInt) Next() OptionForInt {
 	return SomeInt(m.mapper(item.Unwrap()))
 }
 
+func (m *mapIterableForInt) SizeHint() (uint, OptionForUint) {
+	return m.iter.SizeHint()
+}
+
+func (m *mapIterableForInt) Clone() (IterableForInt, bool) {
+	iter, ok := cloneOfInt(m.iter)
+	if !ok {
+		return nil, false
+	}
+
+	return &mapIterableForInt{iter: iter, mapper: m.mapper}, true
+}
+
+func (m *mapIterableForInt) Err() error {
+	return errOf(m.iter)
+}
+
+func (m *mapIterableForInt) Close() error {
+	return closeOf(m.iter)
+}
+
 var _ IterableForInt = &mapIterableForInt{}
 
 type chainForInt struct {
@@ -40,8 +71,249 @@ func (c *chainForInt) Next() OptionForInt {
 	return item
 }
 
+func (c *chainForInt) SizeHint() (uint, OptionForUint) {
+	secondLower, secondUpper := c.second.SizeHint()
+	if c.flag {
+		return secondLower, secondUpper
+	}
+
+	firstLower, firstUpper := c.first.SizeHint()
+
+	lower := firstLower + secondLower
+	if lower < firstLower {
+		lower = ^uint(0)
+	}
+
+	if firstUpper.IsNone() || secondUpper.IsNone() {
+		return lower, NoneUint()
+	}
+
+	upper := firstUpper.Unwrap() + secondUpper.Unwrap()
+	if upper < firstUpper.Unwrap() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(upper)
+}
+
+func (c *chainForInt) Clone() (IterableForInt, bool) {
+	first, ok := cloneOfInt(c.first)
+	if !ok {
+		return nil, false
+	}
+
+	second, ok := cloneOfInt(c.second)
+	if !ok {
+		return nil, false
+	}
+
+	return &chainForInt{first: first, second: second, flag: c.flag}, true
+}
+
+func (c *chainForInt) Err() error {
+	if err := errOf(c.first); err != nil {
+		return err
+	}
+
+	return errOf(c.second)
+}
+
+func (c *chainForInt) Close() error {
+	firstErr := closeOf(c.first)
+	if err := closeOf(c.second); firstErr == nil {
+		return err
+	}
+
+	return firstErr
+}
+
 var _ IterableForInt = &chainForInt{}
 
+type cycleForInt struct {
+	iter   IterableForInt
+	buffer []int
+	cursor uint
+	flag   bool
+}
+
+func (c *cycleForInt) Next() OptionForInt {
+	if !c.flag {
+		item := c.iter.Next()
+		if item.IsSome() {
+			c.buffer = append(c.buffer, item.Unwrap())
+			return item
+		}
+
+		c.flag = true
+	}
+
+	if len(c.buffer) == 0 {
+		return NoneInt()
+	}
+
+	item := c.buffer[c.cursor]
+	c.cursor = (c.cursor + 1) % uint(len(c.buffer))
+
+	return SomeInt(item)
+}
+
+func (c *cycleForInt) SizeHint() (uint, OptionForUint) {
+	if len(c.buffer) > 0 {
+		return ^uint(0), NoneUint()
+	}
+
+	if c.flag {
+		return 0, SomeUint(0)
+	}
+
+	lower, upper := c.iter.SizeHint()
+	if lower > 0 {
+		return ^uint(0), NoneUint()
+	}
+
+	if upper.IsSome() && upper.Unwrap() == 0 {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (c *cycleForInt) Err() error {
+	return errOf(c.iter)
+}
+
+func (c *cycleForInt) Close() error {
+	return closeOf(c.iter)
+}
+
+var _ IterableForInt = &cycleForInt{}
+
+type teeBufferForInt struct {
+	iter      IterableForInt
+	items     []int
+	offset    uint
+	positions []uint
+	open      uint
+	flag      bool
+}
+
+func (b *teeBufferForInt) next(branch uint) OptionForInt {
+	index := b.positions[branch] - b.offset
+	if index >= uint(len(b.items)) {
+		if b.flag {
+			return NoneInt()
+		}
+
+		item := b.iter.Next()
+		if item.IsNone() {
+			b.flag = true
+			return NoneInt()
+		}
+
+		b.items = append(b.items, item.Unwrap())
+	}
+
+	item := b.items[index]
+	b.positions[branch]++
+	b.trim()
+
+	return SomeInt(item)
+}
+
+// trim drops the buffered elements which every branch has yielded.
+func (b *teeBufferForInt) trim() {
+	slowest := b.positions[0]
+	for _, position := range b.positions[1:] {
+		if position < slowest {
+			slowest = position
+		}
+	}
+
+	if end := b.offset + uint(len(b.items)); slowest > end {
+		slowest = end
+	}
+
+	if slowest == b.offset {
+		return
+	}
+
+	if drop := slowest - b.offset; drop == uint(len(b.items)) {
+		b.items = b.items[:0]
+	} else {
+		b.items = b.items[drop:]
+	}
+
+	b.offset = slowest
+}
+
+// close releases a branch and closes the source once every branch is closed.
+func (b *teeBufferForInt) close(branch uint) error {
+	b.positions[branch] = ^uint(0)
+	b.trim()
+
+	b.open--
+	if b.open == 0 {
+		return closeOf(b.iter)
+	}
+
+	return nil
+}
+
+type teeForInt struct {
+	buffer *teeBufferForInt
+	branch uint
+	closed bool
+}
+
+func (t *teeForInt) Next() OptionForInt {
+	if t.closed {
+		return NoneInt()
+	}
+
+	return t.buffer.next(t.branch)
+}
+
+func (t *teeForInt) SizeHint() (uint, OptionForUint) {
+	if t.closed {
+		return 0, SomeUint(0)
+	}
+
+	buffered := t.buffer.offset + uint(len(t.buffer.items)) - t.buffer.positions[t.branch]
+	if t.buffer.flag {
+		return buffered, SomeUint(buffered)
+	}
+
+	lower, upper := t.buffer.iter.SizeHint()
+
+	if lower+buffered < lower {
+		lower = ^uint(0)
+	} else {
+		lower += buffered
+	}
+
+	if upper.IsNone() || upper.Unwrap()+buffered < upper.Unwrap() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(upper.Unwrap() + buffered)
+}
+
+func (t *teeForInt) Err() error {
+	return errOf(t.buffer.iter)
+}
+
+func (t *teeForInt) Close() error {
+	if t.closed {
+		return nil
+	}
+
+	t.closed = true
+
+	return t.buffer.close(t.branch)
+}
+
+var _ IterableForInt = &teeForInt{}
+
 // PairForInt is a 2-tuple.
 type PairForInt struct {
 	First  int
@@ -73,6 +345,24 @@ func (t *takeWhileForInt) Next() OptionForInt {
 	return item
 }
 
+func (t *takeWhileForInt) SizeHint() (uint, OptionForUint) {
+	if t.flag {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := t.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (t *takeWhileForInt) Err() error {
+	return errOf(t.iter)
+}
+
+func (t *takeWhileForInt) Close() error {
+	return closeOf(t.iter)
+}
+
 var _ IterableForInt = &takeWhileForInt{}
 
 type takeForInt struct {
@@ -87,13 +377,13 @@ func (t *takeForInt) Next() OptionForInt {
 		return NoneInt()
 	}
 
-	item := t.iter.Next()
-	if item.IsNone() {
+	if t.count >= t.max {
 		t.flag = true
 		return NoneInt()
 	}
 
-	if t.count >= t.max {
+	item := t.iter.Next()
+	if item.IsNone() {
 		t.flag = true
 		return NoneInt()
 	}
@@ -103,6 +393,42 @@ func (t *takeForInt) Next() OptionForInt {
 	return item
 }
 
+func (t *takeForInt) SizeHint() (uint, OptionForUint) {
+	if t.flag {
+		return 0, SomeUint(0)
+	}
+
+	remaining := t.max - t.count
+
+	lower, upper := t.iter.SizeHint()
+	if lower > remaining {
+		lower = remaining
+	}
+
+	if upper.IsSome() && upper.Unwrap() < remaining {
+		return lower, upper
+	}
+
+	return lower, SomeUint(remaining)
+}
+
+func (t *takeForInt) Clone() (IterableForInt, bool) {
+	iter, ok := cloneOfInt(t.iter)
+	if !ok {
+		return nil, false
+	}
+
+	return &takeForInt{iter: iter, max: t.max, count: t.count, flag: t.flag}, true
+}
+
+func (t *takeForInt) Err() error {
+	return errOf(t.iter)
+}
+
+func (t *takeForInt) Close() error {
+	return closeOf(t.iter)
+}
+
 var _ IterableForInt = &takeForInt{}
 
 type filterForInt struct {
@@ -111,11 +437,44 @@ type filterForInt struct {
 }
 
 func (f *filterForInt) Next() OptionForInt {
-	return f.iter.Find(f.predicate)
+	return f.iter.find(f.predicate)
+}
+
+func (f *filterForInt) SizeHint() (uint, OptionForUint) {
+	_, upper := f.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (f *filterForInt) Clone() (IterableForInt, bool) {
+	iter, ok := f.iter.Clone()
+	if !ok {
+		return nil, false
+	}
+
+	return &filterForInt{iter: iter, predicate: f.predicate}, true
+}
+
+func (f *filterForInt) Err() error {
+	return f.iter.Err()
+}
+
+func (f *filterForInt) Close() error {
+	return f.iter.Close()
 }
 
 var _ IterableForInt = &filterForInt{}
 
+// cloneOfString clones an Iterable if it is Cloneable.
+func cloneOfString(iter IterableForString) (IterableForString, bool) {
+	c, ok := iter.(CloneableForString)
+	if !ok {
+		return nil, false
+	}
+
+	return c.Clone()
+}
+
 type mapIterableForString struct {
 	iter   IterableForString
 	mapper func(item string) string
@@ -130,6 +489,27 @@ func (m *mapIterableForString) Next() OptionForString {
 	return SomeString(m.mapper(item.Unwrap()))
 }
 
+func (m *mapIterableForString) SizeHint() (uint, OptionForUint) {
+	return m.iter.SizeHint()
+}
+
+func (m *mapIterableForString) Clone() (IterableForString, bool) {
+	iter, ok := cloneOfString(m.iter)
+	if !ok {
+		return nil, false
+	}
+
+	return &mapIterableForString{iter: iter, mapper: m.mapper}, true
+}
+
+func (m *mapIterableForString) Err() error {
+	return errOf(m.iter)
+}
+
+func (m *mapIterableForString) Close() error {
+	return closeOf(m.iter)
+}
+
 var _ IterableForString = &mapIterableForString{}
 
 type chainForString struct {
@@ -152,78 +532,2701 @@ func (c *chainForString) Next() OptionForString {
 	return item
 }
 
-var _ IterableForString = &chainForString{}
+func (c *chainForString) SizeHint() (uint, OptionForUint) {
+	secondLower, secondUpper := c.second.SizeHint()
+	if c.flag {
+		return secondLower, secondUpper
+	}
 
-// PairForString is a 2-tuple.
-type PairForString struct {
-	First  string
-	Second string
-}
+	firstLower, firstUpper := c.first.SizeHint()
 
-type takeWhileForString struct {
-	iter      IterableForString
-	predicate func(item string) bool
-	flag      bool
+	lower := firstLower + secondLower
+	if lower < firstLower {
+		lower = ^uint(0)
+	}
+
+	if firstUpper.IsNone() || secondUpper.IsNone() {
+		return lower, NoneUint()
+	}
+
+	upper := firstUpper.Unwrap() + secondUpper.Unwrap()
+	if upper < firstUpper.Unwrap() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(upper)
 }
 
-func (t *takeWhileForString) Next() OptionForString {
-	if t.flag {
-		return NoneString()
+func (c *chainForString) Clone() (IterableForString, bool) {
+	first, ok := cloneOfString(c.first)
+	if !ok {
+		return nil, false
 	}
 
-	item := t.iter.Next()
-	if item.IsNone() {
-		t.flag = true
-		return NoneString()
+	second, ok := cloneOfString(c.second)
+	if !ok {
+		return nil, false
 	}
 
-	if !t.predicate(item.Unwrap()) {
-		t.flag = true
-		return NoneString()
+	return &chainForString{first: first, second: second, flag: c.flag}, true
+}
+
+func (c *chainForString) Err() error {
+	if err := errOf(c.first); err != nil {
+		return err
 	}
 
-	return item
+	return errOf(c.second)
 }
 
-var _ IterableForString = &takeWhileForString{}
+func (c *chainForString) Close() error {
+	firstErr := closeOf(c.first)
+	if err := closeOf(c.second); firstErr == nil {
+		return err
+	}
 
-type takeForString struct {
-	iter  IterableForString
-	max   uint
-	count uint
-	flag  bool
+	return firstErr
 }
 
-func (t *takeForString) Next() OptionForString {
-	if t.flag {
-		return NoneString()
+var _ IterableForString = &chainForString{}
+
+type cycleForString struct {
+	iter   IterableForString
+	buffer []string
+	cursor uint
+	flag   bool
+}
+
+func (c *cycleForString) Next() OptionForString {
+	if !c.flag {
+		item := c.iter.Next()
+		if item.IsSome() {
+			c.buffer = append(c.buffer, item.Unwrap())
+			return item
+		}
+
+		c.flag = true
 	}
 
-	item := t.iter.Next()
-	if item.IsNone() {
-		t.flag = true
+	if len(c.buffer) == 0 {
 		return NoneString()
 	}
 
-	if t.count >= t.max {
-		t.flag = true
-		return NoneString()
+	item := c.buffer[c.cursor]
+	c.cursor = (c.cursor + 1) % uint(len(c.buffer))
+
+	return SomeString(item)
+}
+
+func (c *cycleForString) SizeHint() (uint, OptionForUint) {
+	if len(c.buffer) > 0 {
+		return ^uint(0), NoneUint()
 	}
 
-	t.count++
+	if c.flag {
+		return 0, SomeUint(0)
+	}
 
-	return item
+	lower, upper := c.iter.SizeHint()
+	if lower > 0 {
+		return ^uint(0), NoneUint()
+	}
+
+	if upper.IsSome() && upper.Unwrap() == 0 {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
 }
 
-var _ IterableForString = &takeForString{}
+func (c *cycleForString) Err() error {
+	return errOf(c.iter)
+}
 
-type filterForString struct {
-	iter      IteratorForString
-	predicate func(item string) bool
+func (c *cycleForString) Close() error {
+	return closeOf(c.iter)
 }
 
-func (f *filterForString) Next() OptionForString {
-	return f.iter.Find(f.predicate)
+var _ IterableForString = &cycleForString{}
+
+type teeBufferForString struct {
+	iter      IterableForString
+	items     []string
+	offset    uint
+	positions []uint
+	open      uint
+	flag      bool
+}
+
+func (b *teeBufferForString) next(branch uint) OptionForString {
+	index := b.positions[branch] - b.offset
+	if index >= uint(len(b.items)) {
+		if b.flag {
+			return NoneString()
+		}
+
+		item := b.iter.Next()
+		if item.IsNone() {
+			b.flag = true
+			return NoneString()
+		}
+
+		b.items = append(b.items, item.Unwrap())
+	}
+
+	item := b.items[index]
+	b.positions[branch]++
+	b.trim()
+
+	return SomeString(item)
+}
+
+// trim drops the buffered elements which every branch has yielded.
+func (b *teeBufferForString) trim() {
+	slowest := b.positions[0]
+	for _, position := range b.positions[1:] {
+		if position < slowest {
+			slowest = position
+		}
+	}
+
+	if end := b.offset + uint(len(b.items)); slowest > end {
+		slowest = end
+	}
+
+	if slowest == b.offset {
+		return
+	}
+
+	if drop := slowest - b.offset; drop == uint(len(b.items)) {
+		b.items = b.items[:0]
+	} else {
+		b.items = b.items[drop:]
+	}
+
+	b.offset = slowest
+}
+
+// close releases a branch and closes the source once every branch is closed.
+func (b *teeBufferForString) close(branch uint) error {
+	b.positions[branch] = ^uint(0)
+	b.trim()
+
+	b.open--
+	if b.open == 0 {
+		return closeOf(b.iter)
+	}
+
+	return nil
+}
+
+type teeForString struct {
+	buffer *teeBufferForString
+	branch uint
+	closed bool
+}
+
+func (t *teeForString) Next() OptionForString {
+	if t.closed {
+		return NoneString()
+	}
+
+	return t.buffer.next(t.branch)
+}
+
+func (t *teeForString) SizeHint() (uint, OptionForUint) {
+	if t.closed {
+		return 0, SomeUint(0)
+	}
+
+	buffered := t.buffer.offset + uint(len(t.buffer.items)) - t.buffer.positions[t.branch]
+	if t.buffer.flag {
+		return buffered, SomeUint(buffered)
+	}
+
+	lower, upper := t.buffer.iter.SizeHint()
+
+	if lower+buffered < lower {
+		lower = ^uint(0)
+	} else {
+		lower += buffered
+	}
+
+	if upper.IsNone() || upper.Unwrap()+buffered < upper.Unwrap() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(upper.Unwrap() + buffered)
+}
+
+func (t *teeForString) Err() error {
+	return errOf(t.buffer.iter)
+}
+
+func (t *teeForString) Close() error {
+	if t.closed {
+		return nil
+	}
+
+	t.closed = true
+
+	return t.buffer.close(t.branch)
+}
+
+var _ IterableForString = &teeForString{}
+
+// PairForString is a 2-tuple.
+type PairForString struct {
+	First  string
+	Second string
+}
+
+type takeWhileForString struct {
+	iter      IterableForString
+	predicate func(item string) bool
+	flag      bool
+}
+
+func (t *takeWhileForString) Next() OptionForString {
+	if t.flag {
+		return NoneString()
+	}
+
+	item := t.iter.Next()
+	if item.IsNone() {
+		t.flag = true
+		return NoneString()
+	}
+
+	if !t.predicate(item.Unwrap()) {
+		t.flag = true
+		return NoneString()
+	}
+
+	return item
+}
+
+func (t *takeWhileForString) SizeHint() (uint, OptionForUint) {
+	if t.flag {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := t.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (t *takeWhileForString) Err() error {
+	return errOf(t.iter)
+}
+
+func (t *takeWhileForString) Close() error {
+	return closeOf(t.iter)
+}
+
+var _ IterableForString = &takeWhileForString{}
+
+type takeForString struct {
+	iter  IterableForString
+	max   uint
+	count uint
+	flag  bool
+}
+
+func (t *takeForString) Next() OptionForString {
+	if t.flag {
+		return NoneString()
+	}
+
+	if t.count >= t.max {
+		t.flag = true
+		return NoneString()
+	}
+
+	item := t.iter.Next()
+	if item.IsNone() {
+		t.flag = true
+		return NoneString()
+	}
+
+	t.count++
+
+	return item
+}
+
+func (t *takeForString) SizeHint() (uint, OptionForUint) {
+	if t.flag {
+		return 0, SomeUint(0)
+	}
+
+	remaining := t.max - t.count
+
+	lower, upper := t.iter.SizeHint()
+	if lower > remaining {
+		lower = remaining
+	}
+
+	if upper.IsSome() && upper.Unwrap() < remaining {
+		return lower, upper
+	}
+
+	return lower, SomeUint(remaining)
+}
+
+func (t *takeForString) Clone() (IterableForString, bool) {
+	iter, ok := cloneOfString(t.iter)
+	if !ok {
+		return nil, false
+	}
+
+	return &takeForString{iter: iter, max: t.max, count: t.count, flag: t.flag}, true
+}
+
+func (t *takeForString) Err() error {
+	return errOf(t.iter)
+}
+
+func (t *takeForString) Close() error {
+	return closeOf(t.iter)
+}
+
+var _ IterableForString = &takeForString{}
+
+type filterForString struct {
+	iter      IteratorForString
+	predicate func(item string) bool
+}
+
+func (f *filterForString) Next() OptionForString {
+	return f.iter.find(f.predicate)
+}
+
+func (f *filterForString) SizeHint() (uint, OptionForUint) {
+	_, upper := f.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (f *filterForString) Clone() (IterableForString, bool) {
+	iter, ok := f.iter.Clone()
+	if !ok {
+		return nil, false
+	}
+
+	return &filterForString{iter: iter, predicate: f.predicate}, true
+}
+
+func (f *filterForString) Err() error {
+	return f.iter.Err()
+}
+
+func (f *filterForString) Close() error {
+	return f.iter.Close()
 }
 
 var _ IterableForString = &filterForString{}
+
+// cloneOfEntryForStringToInt clones an Iterable if it is Cloneable.
+func cloneOfEntryForStringToInt(iter IterableForEntryForStringToInt) (IterableForEntryForStringToInt, bool) {
+	c, ok := iter.(CloneableForEntryForStringToInt)
+	if !ok {
+		return nil, false
+	}
+
+	return c.Clone()
+}
+
+type mapIterableForEntryForStringToInt struct {
+	iter   IterableForEntryForStringToInt
+	mapper func(item EntryForStringToInt) EntryForStringToInt
+}
+
+func (m *mapIterableForEntryForStringToInt) Next() OptionForEntryForStringToInt {
+	item := m.iter.Next()
+	if item.IsNone() {
+		return NoneEntryForStringToInt()
+	}
+
+	return SomeEntryForStringToInt(m.mapper(item.Unwrap()))
+}
+
+func (m *mapIterableForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	return m.iter.SizeHint()
+}
+
+func (m *mapIterableForEntryForStringToInt) Clone() (IterableForEntryForStringToInt, bool) {
+	iter, ok := cloneOfEntryForStringToInt(m.iter)
+	if !ok {
+		return nil, false
+	}
+
+	return &mapIterableForEntryForStringToInt{iter: iter, mapper: m.mapper}, true
+}
+
+func (m *mapIterableForEntryForStringToInt) Err() error {
+	return errOf(m.iter)
+}
+
+func (m *mapIterableForEntryForStringToInt) Close() error {
+	return closeOf(m.iter)
+}
+
+var _ IterableForEntryForStringToInt = &mapIterableForEntryForStringToInt{}
+
+type chainForEntryForStringToInt struct {
+	first  IterableForEntryForStringToInt
+	second IterableForEntryForStringToInt
+	flag   bool
+}
+
+func (c *chainForEntryForStringToInt) Next() OptionForEntryForStringToInt {
+	if c.flag {
+		return c.second.Next()
+	}
+
+	item := c.first.Next()
+	if item.IsNone() {
+		c.flag = true
+		return c.second.Next()
+	}
+
+	return item
+}
+
+func (c *chainForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	secondLower, secondUpper := c.second.SizeHint()
+	if c.flag {
+		return secondLower, secondUpper
+	}
+
+	firstLower, firstUpper := c.first.SizeHint()
+
+	lower := firstLower + secondLower
+	if lower < firstLower {
+		lower = ^uint(0)
+	}
+
+	if firstUpper.IsNone() || secondUpper.IsNone() {
+		return lower, NoneUint()
+	}
+
+	upper := firstUpper.Unwrap() + secondUpper.Unwrap()
+	if upper < firstUpper.Unwrap() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(upper)
+}
+
+func (c *chainForEntryForStringToInt) Clone() (IterableForEntryForStringToInt, bool) {
+	first, ok := cloneOfEntryForStringToInt(c.first)
+	if !ok {
+		return nil, false
+	}
+
+	second, ok := cloneOfEntryForStringToInt(c.second)
+	if !ok {
+		return nil, false
+	}
+
+	return &chainForEntryForStringToInt{first: first, second: second, flag: c.flag}, true
+}
+
+func (c *chainForEntryForStringToInt) Err() error {
+	if err := errOf(c.first); err != nil {
+		return err
+	}
+
+	return errOf(c.second)
+}
+
+func (c *chainForEntryForStringToInt) Close() error {
+	firstErr := closeOf(c.first)
+	if err := closeOf(c.second); firstErr == nil {
+		return err
+	}
+
+	return firstErr
+}
+
+var _ IterableForEntryForStringToInt = &chainForEntryForStringToInt{}
+
+type cycleForEntryForStringToInt struct {
+	iter   IterableForEntryForStringToInt
+	buffer []EntryForStringToInt
+	cursor uint
+	flag   bool
+}
+
+func (c *cycleForEntryForStringToInt) Next() OptionForEntryForStringToInt {
+	if !c.flag {
+		item := c.iter.Next()
+		if item.IsSome() {
+			c.buffer = append(c.buffer, item.Unwrap())
+			return item
+		}
+
+		c.flag = true
+	}
+
+	if len(c.buffer) == 0 {
+		return NoneEntryForStringToInt()
+	}
+
+	item := c.buffer[c.cursor]
+	c.cursor = (c.cursor + 1) % uint(len(c.buffer))
+
+	return SomeEntryForStringToInt(item)
+}
+
+func (c *cycleForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if len(c.buffer) > 0 {
+		return ^uint(0), NoneUint()
+	}
+
+	if c.flag {
+		return 0, SomeUint(0)
+	}
+
+	lower, upper := c.iter.SizeHint()
+	if lower > 0 {
+		return ^uint(0), NoneUint()
+	}
+
+	if upper.IsSome() && upper.Unwrap() == 0 {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (c *cycleForEntryForStringToInt) Err() error {
+	return errOf(c.iter)
+}
+
+func (c *cycleForEntryForStringToInt) Close() error {
+	return closeOf(c.iter)
+}
+
+var _ IterableForEntryForStringToInt = &cycleForEntryForStringToInt{}
+
+type teeBufferForEntryForStringToInt struct {
+	iter      IterableForEntryForStringToInt
+	items     []EntryForStringToInt
+	offset    uint
+	positions []uint
+	open      uint
+	flag      bool
+}
+
+func (b *teeBufferForEntryForStringToInt) next(branch uint) OptionForEntryForStringToInt {
+	index := b.positions[branch] - b.offset
+	if index >= uint(len(b.items)) {
+		if b.flag {
+			return NoneEntryForStringToInt()
+		}
+
+		item := b.iter.Next()
+		if item.IsNone() {
+			b.flag = true
+			return NoneEntryForStringToInt()
+		}
+
+		b.items = append(b.items, item.Unwrap())
+	}
+
+	item := b.items[index]
+	b.positions[branch]++
+	b.trim()
+
+	return SomeEntryForStringToInt(item)
+}
+
+// trim drops the buffered elements which every branch has yielded.
+func (b *teeBufferForEntryForStringToInt) trim() {
+	slowest := b.positions[0]
+	for _, position := range b.positions[1:] {
+		if position < slowest {
+			slowest = position
+		}
+	}
+
+	if end := b.offset + uint(len(b.items)); slowest > end {
+		slowest = end
+	}
+
+	if slowest == b.offset {
+		return
+	}
+
+	if drop := slowest - b.offset; drop == uint(len(b.items)) {
+		b.items = b.items[:0]
+	} else {
+		b.items = b.items[drop:]
+	}
+
+	b.offset = slowest
+}
+
+// close releases a branch and closes the source once every branch is closed.
+func (b *teeBufferForEntryForStringToInt) close(branch uint) error {
+	b.positions[branch] = ^uint(0)
+	b.trim()
+
+	b.open--
+	if b.open == 0 {
+		return closeOf(b.iter)
+	}
+
+	return nil
+}
+
+type teeForEntryForStringToInt struct {
+	buffer *teeBufferForEntryForStringToInt
+	branch uint
+	closed bool
+}
+
+func (t *teeForEntryForStringToInt) Next() OptionForEntryForStringToInt {
+	if t.closed {
+		return NoneEntryForStringToInt()
+	}
+
+	return t.buffer.next(t.branch)
+}
+
+func (t *teeForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if t.closed {
+		return 0, SomeUint(0)
+	}
+
+	buffered := t.buffer.offset + uint(len(t.buffer.items)) - t.buffer.positions[t.branch]
+	if t.buffer.flag {
+		return buffered, SomeUint(buffered)
+	}
+
+	lower, upper := t.buffer.iter.SizeHint()
+
+	if lower+buffered < lower {
+		lower = ^uint(0)
+	} else {
+		lower += buffered
+	}
+
+	if upper.IsNone() || upper.Unwrap()+buffered < upper.Unwrap() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(upper.Unwrap() + buffered)
+}
+
+func (t *teeForEntryForStringToInt) Err() error {
+	return errOf(t.buffer.iter)
+}
+
+func (t *teeForEntryForStringToInt) Close() error {
+	if t.closed {
+		return nil
+	}
+
+	t.closed = true
+
+	return t.buffer.close(t.branch)
+}
+
+var _ IterableForEntryForStringToInt = &teeForEntryForStringToInt{}
+
+// PairForEntryForStringToInt is a 2-tuple.
+type PairForEntryForStringToInt struct {
+	First  EntryForStringToInt
+	Second EntryForStringToInt
+}
+
+type takeWhileForEntryForStringToInt struct {
+	iter      IterableForEntryForStringToInt
+	predicate func(item EntryForStringToInt) bool
+	flag      bool
+}
+
+func (t *takeWhileForEntryForStringToInt) Next() OptionForEntryForStringToInt {
+	if t.flag {
+		return NoneEntryForStringToInt()
+	}
+
+	item := t.iter.Next()
+	if item.IsNone() {
+		t.flag = true
+		return NoneEntryForStringToInt()
+	}
+
+	if !t.predicate(item.Unwrap()) {
+		t.flag = true
+		return NoneEntryForStringToInt()
+	}
+
+	return item
+}
+
+func (t *takeWhileForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if t.flag {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := t.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (t *takeWhileForEntryForStringToInt) Err() error {
+	return errOf(t.iter)
+}
+
+func (t *takeWhileForEntryForStringToInt) Close() error {
+	return closeOf(t.iter)
+}
+
+var _ IterableForEntryForStringToInt = &takeWhileForEntryForStringToInt{}
+
+type takeForEntryForStringToInt struct {
+	iter  IterableForEntryForStringToInt
+	max   uint
+	count uint
+	flag  bool
+}
+
+func (t *takeForEntryForStringToInt) Next() OptionForEntryForStringToInt {
+	if t.flag {
+		return NoneEntryForStringToInt()
+	}
+
+	if t.count >= t.max {
+		t.flag = true
+		return NoneEntryForStringToInt()
+	}
+
+	item := t.iter.Next()
+	if item.IsNone() {
+		t.flag = true
+		return NoneEntryForStringToInt()
+	}
+
+	t.count++
+
+	return item
+}
+
+func (t *takeForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if t.flag {
+		return 0, SomeUint(0)
+	}
+
+	remaining := t.max - t.count
+
+	lower, upper := t.iter.SizeHint()
+	if lower > remaining {
+		lower = remaining
+	}
+
+	if upper.IsSome() && upper.Unwrap() < remaining {
+		return lower, upper
+	}
+
+	return lower, SomeUint(remaining)
+}
+
+func (t *takeForEntryForStringToInt) Clone() (IterableForEntryForStringToInt, bool) {
+	iter, ok := cloneOfEntryForStringToInt(t.iter)
+	if !ok {
+		return nil, false
+	}
+
+	return &takeForEntryForStringToInt{iter: iter, max: t.max, count: t.count, flag: t.flag}, true
+}
+
+func (t *takeForEntryForStringToInt) Err() error {
+	return errOf(t.iter)
+}
+
+func (t *takeForEntryForStringToInt) Close() error {
+	return closeOf(t.iter)
+}
+
+var _ IterableForEntryForStringToInt = &takeForEntryForStringToInt{}
+
+type filterForEntryForStringToInt struct {
+	iter      IteratorForEntryForStringToInt
+	predicate func(item EntryForStringToInt) bool
+}
+
+func (f *filterForEntryForStringToInt) Next() OptionForEntryForStringToInt {
+	return f.iter.find(f.predicate)
+}
+
+func (f *filterForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	_, upper := f.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (f *filterForEntryForStringToInt) Clone() (IterableForEntryForStringToInt, bool) {
+	iter, ok := f.iter.Clone()
+	if !ok {
+		return nil, false
+	}
+
+	return &filterForEntryForStringToInt{iter: iter, predicate: f.predicate}, true
+}
+
+func (f *filterForEntryForStringToInt) Err() error {
+	return f.iter.Err()
+}
+
+func (f *filterForEntryForStringToInt) Close() error {
+	return f.iter.Close()
+}
+
+var _ IterableForEntryForStringToInt = &filterForEntryForStringToInt{}
+
+// cloneOfSliceOfInt clones an Iterable if it is Cloneable.
+func cloneOfSliceOfInt(iter IterableForSliceOfInt) (IterableForSliceOfInt, bool) {
+	c, ok := iter.(CloneableForSliceOfInt)
+	if !ok {
+		return nil, false
+	}
+
+	return c.Clone()
+}
+
+type mapIterableForSliceOfInt struct {
+	iter   IterableForSliceOfInt
+	mapper func(item SliceOfInt) SliceOfInt
+}
+
+func (m *mapIterableForSliceOfInt) Next() OptionForSliceOfInt {
+	item := m.iter.Next()
+	if item.IsNone() {
+		return NoneSliceOfInt()
+	}
+
+	return SomeSliceOfInt(m.mapper(item.Unwrap()))
+}
+
+func (m *mapIterableForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	return m.iter.SizeHint()
+}
+
+func (m *mapIterableForSliceOfInt) Clone() (IterableForSliceOfInt, bool) {
+	iter, ok := cloneOfSliceOfInt(m.iter)
+	if !ok {
+		return nil, false
+	}
+
+	return &mapIterableForSliceOfInt{iter: iter, mapper: m.mapper}, true
+}
+
+func (m *mapIterableForSliceOfInt) Err() error {
+	return errOf(m.iter)
+}
+
+func (m *mapIterableForSliceOfInt) Close() error {
+	return closeOf(m.iter)
+}
+
+var _ IterableForSliceOfInt = &mapIterableForSliceOfInt{}
+
+type chainForSliceOfInt struct {
+	first  IterableForSliceOfInt
+	second IterableForSliceOfInt
+	flag   bool
+}
+
+func (c *chainForSliceOfInt) Next() OptionForSliceOfInt {
+	if c.flag {
+		return c.second.Next()
+	}
+
+	item := c.first.Next()
+	if item.IsNone() {
+		c.flag = true
+		return c.second.Next()
+	}
+
+	return item
+}
+
+func (c *chainForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	secondLower, secondUpper := c.second.SizeHint()
+	if c.flag {
+		return secondLower, secondUpper
+	}
+
+	firstLower, firstUpper := c.first.SizeHint()
+
+	lower := firstLower + secondLower
+	if lower < firstLower {
+		lower = ^uint(0)
+	}
+
+	if firstUpper.IsNone() || secondUpper.IsNone() {
+		return lower, NoneUint()
+	}
+
+	upper := firstUpper.Unwrap() + secondUpper.Unwrap()
+	if upper < firstUpper.Unwrap() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(upper)
+}
+
+func (c *chainForSliceOfInt) Clone() (IterableForSliceOfInt, bool) {
+	first, ok := cloneOfSliceOfInt(c.first)
+	if !ok {
+		return nil, false
+	}
+
+	second, ok := cloneOfSliceOfInt(c.second)
+	if !ok {
+		return nil, false
+	}
+
+	return &chainForSliceOfInt{first: first, second: second, flag: c.flag}, true
+}
+
+func (c *chainForSliceOfInt) Err() error {
+	if err := errOf(c.first); err != nil {
+		return err
+	}
+
+	return errOf(c.second)
+}
+
+func (c *chainForSliceOfInt) Close() error {
+	firstErr := closeOf(c.first)
+	if err := closeOf(c.second); firstErr == nil {
+		return err
+	}
+
+	return firstErr
+}
+
+var _ IterableForSliceOfInt = &chainForSliceOfInt{}
+
+type cycleForSliceOfInt struct {
+	iter   IterableForSliceOfInt
+	buffer []SliceOfInt
+	cursor uint
+	flag   bool
+}
+
+func (c *cycleForSliceOfInt) Next() OptionForSliceOfInt {
+	if !c.flag {
+		item := c.iter.Next()
+		if item.IsSome() {
+			c.buffer = append(c.buffer, item.Unwrap())
+			return item
+		}
+
+		c.flag = true
+	}
+
+	if len(c.buffer) == 0 {
+		return NoneSliceOfInt()
+	}
+
+	item := c.buffer[c.cursor]
+	c.cursor = (c.cursor + 1) % uint(len(c.buffer))
+
+	return SomeSliceOfInt(item)
+}
+
+func (c *cycleForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	if len(c.buffer) > 0 {
+		return ^uint(0), NoneUint()
+	}
+
+	if c.flag {
+		return 0, SomeUint(0)
+	}
+
+	lower, upper := c.iter.SizeHint()
+	if lower > 0 {
+		return ^uint(0), NoneUint()
+	}
+
+	if upper.IsSome() && upper.Unwrap() == 0 {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (c *cycleForSliceOfInt) Err() error {
+	return errOf(c.iter)
+}
+
+func (c *cycleForSliceOfInt) Close() error {
+	return closeOf(c.iter)
+}
+
+var _ IterableForSliceOfInt = &cycleForSliceOfInt{}
+
+type teeBufferForSliceOfInt struct {
+	iter      IterableForSliceOfInt
+	items     []SliceOfInt
+	offset    uint
+	positions []uint
+	open      uint
+	flag      bool
+}
+
+func (b *teeBufferForSliceOfInt) next(branch uint) OptionForSliceOfInt {
+	index := b.positions[branch] - b.offset
+	if index >= uint(len(b.items)) {
+		if b.flag {
+			return NoneSliceOfInt()
+		}
+
+		item := b.iter.Next()
+		if item.IsNone() {
+			b.flag = true
+			return NoneSliceOfInt()
+		}
+
+		b.items = append(b.items, item.Unwrap())
+	}
+
+	item := b.items[index]
+	b.positions[branch]++
+	b.trim()
+
+	return SomeSliceOfInt(item)
+}
+
+// trim drops the buffered elements which every branch has yielded.
+func (b *teeBufferForSliceOfInt) trim() {
+	slowest := b.positions[0]
+	for _, position := range b.positions[1:] {
+		if position < slowest {
+			slowest = position
+		}
+	}
+
+	if end := b.offset + uint(len(b.items)); slowest > end {
+		slowest = end
+	}
+
+	if slowest == b.offset {
+		return
+	}
+
+	if drop := slowest - b.offset; drop == uint(len(b.items)) {
+		b.items = b.items[:0]
+	} else {
+		b.items = b.items[drop:]
+	}
+
+	b.offset = slowest
+}
+
+// close releases a branch and closes the source once every branch is closed.
+func (b *teeBufferForSliceOfInt) close(branch uint) error {
+	b.positions[branch] = ^uint(0)
+	b.trim()
+
+	b.open--
+	if b.open == 0 {
+		return closeOf(b.iter)
+	}
+
+	return nil
+}
+
+type teeForSliceOfInt struct {
+	buffer *teeBufferForSliceOfInt
+	branch uint
+	closed bool
+}
+
+func (t *teeForSliceOfInt) Next() OptionForSliceOfInt {
+	if t.closed {
+		return NoneSliceOfInt()
+	}
+
+	return t.buffer.next(t.branch)
+}
+
+func (t *teeForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	if t.closed {
+		return 0, SomeUint(0)
+	}
+
+	buffered := t.buffer.offset + uint(len(t.buffer.items)) - t.buffer.positions[t.branch]
+	if t.buffer.flag {
+		return buffered, SomeUint(buffered)
+	}
+
+	lower, upper := t.buffer.iter.SizeHint()
+
+	if lower+buffered < lower {
+		lower = ^uint(0)
+	} else {
+		lower += buffered
+	}
+
+	if upper.IsNone() || upper.Unwrap()+buffered < upper.Unwrap() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(upper.Unwrap() + buffered)
+}
+
+func (t *teeForSliceOfInt) Err() error {
+	return errOf(t.buffer.iter)
+}
+
+func (t *teeForSliceOfInt) Close() error {
+	if t.closed {
+		return nil
+	}
+
+	t.closed = true
+
+	return t.buffer.close(t.branch)
+}
+
+var _ IterableForSliceOfInt = &teeForSliceOfInt{}
+
+// PairForSliceOfInt is a 2-tuple.
+type PairForSliceOfInt struct {
+	First  SliceOfInt
+	Second SliceOfInt
+}
+
+type takeWhileForSliceOfInt struct {
+	iter      IterableForSliceOfInt
+	predicate func(item SliceOfInt) bool
+	flag      bool
+}
+
+func (t *takeWhileForSliceOfInt) Next() OptionForSliceOfInt {
+	if t.flag {
+		return NoneSliceOfInt()
+	}
+
+	item := t.iter.Next()
+	if item.IsNone() {
+		t.flag = true
+		return NoneSliceOfInt()
+	}
+
+	if !t.predicate(item.Unwrap()) {
+		t.flag = true
+		return NoneSliceOfInt()
+	}
+
+	return item
+}
+
+func (t *takeWhileForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	if t.flag {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := t.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (t *takeWhileForSliceOfInt) Err() error {
+	return errOf(t.iter)
+}
+
+func (t *takeWhileForSliceOfInt) Close() error {
+	return closeOf(t.iter)
+}
+
+var _ IterableForSliceOfInt = &takeWhileForSliceOfInt{}
+
+type takeForSliceOfInt struct {
+	iter  IterableForSliceOfInt
+	max   uint
+	count uint
+	flag  bool
+}
+
+func (t *takeForSliceOfInt) Next() OptionForSliceOfInt {
+	if t.flag {
+		return NoneSliceOfInt()
+	}
+
+	if t.count >= t.max {
+		t.flag = true
+		return NoneSliceOfInt()
+	}
+
+	item := t.iter.Next()
+	if item.IsNone() {
+		t.flag = true
+		return NoneSliceOfInt()
+	}
+
+	t.count++
+
+	return item
+}
+
+func (t *takeForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	if t.flag {
+		return 0, SomeUint(0)
+	}
+
+	remaining := t.max - t.count
+
+	lower, upper := t.iter.SizeHint()
+	if lower > remaining {
+		lower = remaining
+	}
+
+	if upper.IsSome() && upper.Unwrap() < remaining {
+		return lower, upper
+	}
+
+	return lower, SomeUint(remaining)
+}
+
+func (t *takeForSliceOfInt) Clone() (IterableForSliceOfInt, bool) {
+	iter, ok := cloneOfSliceOfInt(t.iter)
+	if !ok {
+		return nil, false
+	}
+
+	return &takeForSliceOfInt{iter: iter, max: t.max, count: t.count, flag: t.flag}, true
+}
+
+func (t *takeForSliceOfInt) Err() error {
+	return errOf(t.iter)
+}
+
+func (t *takeForSliceOfInt) Close() error {
+	return closeOf(t.iter)
+}
+
+var _ IterableForSliceOfInt = &takeForSliceOfInt{}
+
+type filterForSliceOfInt struct {
+	iter      IteratorForSliceOfInt
+	predicate func(item SliceOfInt) bool
+}
+
+func (f *filterForSliceOfInt) Next() OptionForSliceOfInt {
+	return f.iter.find(f.predicate)
+}
+
+func (f *filterForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	_, upper := f.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (f *filterForSliceOfInt) Clone() (IterableForSliceOfInt, bool) {
+	iter, ok := f.iter.Clone()
+	if !ok {
+		return nil, false
+	}
+
+	return &filterForSliceOfInt{iter: iter, predicate: f.predicate}, true
+}
+
+func (f *filterForSliceOfInt) Err() error {
+	return f.iter.Err()
+}
+
+func (f *filterForSliceOfInt) Close() error {
+	return f.iter.Close()
+}
+
+var _ IterableForSliceOfInt = &filterForSliceOfInt{}
+
+// cloneOfSliceOfString clones an Iterable if it is Cloneable.
+func cloneOfSliceOfString(iter IterableForSliceOfString) (IterableForSliceOfString, bool) {
+	c, ok := iter.(CloneableForSliceOfString)
+	if !ok {
+		return nil, false
+	}
+
+	return c.Clone()
+}
+
+type mapIterableForSliceOfString struct {
+	iter   IterableForSliceOfString
+	mapper func(item SliceOfString) SliceOfString
+}
+
+func (m *mapIterableForSliceOfString) Next() OptionForSliceOfString {
+	item := m.iter.Next()
+	if item.IsNone() {
+		return NoneSliceOfString()
+	}
+
+	return SomeSliceOfString(m.mapper(item.Unwrap()))
+}
+
+func (m *mapIterableForSliceOfString) SizeHint() (uint, OptionForUint) {
+	return m.iter.SizeHint()
+}
+
+func (m *mapIterableForSliceOfString) Clone() (IterableForSliceOfString, bool) {
+	iter, ok := cloneOfSliceOfString(m.iter)
+	if !ok {
+		return nil, false
+	}
+
+	return &mapIterableForSliceOfString{iter: iter, mapper: m.mapper}, true
+}
+
+func (m *mapIterableForSliceOfString) Err() error {
+	return errOf(m.iter)
+}
+
+func (m *mapIterableForSliceOfString) Close() error {
+	return closeOf(m.iter)
+}
+
+var _ IterableForSliceOfString = &mapIterableForSliceOfString{}
+
+type chainForSliceOfString struct {
+	first  IterableForSliceOfString
+	second IterableForSliceOfString
+	flag   bool
+}
+
+func (c *chainForSliceOfString) Next() OptionForSliceOfString {
+	if c.flag {
+		return c.second.Next()
+	}
+
+	item := c.first.Next()
+	if item.IsNone() {
+		c.flag = true
+		return c.second.Next()
+	}
+
+	return item
+}
+
+func (c *chainForSliceOfString) SizeHint() (uint, OptionForUint) {
+	secondLower, secondUpper := c.second.SizeHint()
+	if c.flag {
+		return secondLower, secondUpper
+	}
+
+	firstLower, firstUpper := c.first.SizeHint()
+
+	lower := firstLower + secondLower
+	if lower < firstLower {
+		lower = ^uint(0)
+	}
+
+	if firstUpper.IsNone() || secondUpper.IsNone() {
+		return lower, NoneUint()
+	}
+
+	upper := firstUpper.Unwrap() + secondUpper.Unwrap()
+	if upper < firstUpper.Unwrap() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(upper)
+}
+
+func (c *chainForSliceOfString) Clone() (IterableForSliceOfString, bool) {
+	first, ok := cloneOfSliceOfString(c.first)
+	if !ok {
+		return nil, false
+	}
+
+	second, ok := cloneOfSliceOfString(c.second)
+	if !ok {
+		return nil, false
+	}
+
+	return &chainForSliceOfString{first: first, second: second, flag: c.flag}, true
+}
+
+func (c *chainForSliceOfString) Err() error {
+	if err := errOf(c.first); err != nil {
+		return err
+	}
+
+	return errOf(c.second)
+}
+
+func (c *chainForSliceOfString) Close() error {
+	firstErr := closeOf(c.first)
+	if err := closeOf(c.second); firstErr == nil {
+		return err
+	}
+
+	return firstErr
+}
+
+var _ IterableForSliceOfString = &chainForSliceOfString{}
+
+type cycleForSliceOfString struct {
+	iter   IterableForSliceOfString
+	buffer []SliceOfString
+	cursor uint
+	flag   bool
+}
+
+func (c *cycleForSliceOfString) Next() OptionForSliceOfString {
+	if !c.flag {
+		item := c.iter.Next()
+		if item.IsSome() {
+			c.buffer = append(c.buffer, item.Unwrap())
+			return item
+		}
+
+		c.flag = true
+	}
+
+	if len(c.buffer) == 0 {
+		return NoneSliceOfString()
+	}
+
+	item := c.buffer[c.cursor]
+	c.cursor = (c.cursor + 1) % uint(len(c.buffer))
+
+	return SomeSliceOfString(item)
+}
+
+func (c *cycleForSliceOfString) SizeHint() (uint, OptionForUint) {
+	if len(c.buffer) > 0 {
+		return ^uint(0), NoneUint()
+	}
+
+	if c.flag {
+		return 0, SomeUint(0)
+	}
+
+	lower, upper := c.iter.SizeHint()
+	if lower > 0 {
+		return ^uint(0), NoneUint()
+	}
+
+	if upper.IsSome() && upper.Unwrap() == 0 {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (c *cycleForSliceOfString) Err() error {
+	return errOf(c.iter)
+}
+
+func (c *cycleForSliceOfString) Close() error {
+	return closeOf(c.iter)
+}
+
+var _ IterableForSliceOfString = &cycleForSliceOfString{}
+
+type teeBufferForSliceOfString struct {
+	iter      IterableForSliceOfString
+	items     []SliceOfString
+	offset    uint
+	positions []uint
+	open      uint
+	flag      bool
+}
+
+func (b *teeBufferForSliceOfString) next(branch uint) OptionForSliceOfString {
+	index := b.positions[branch] - b.offset
+	if index >= uint(len(b.items)) {
+		if b.flag {
+			return NoneSliceOfString()
+		}
+
+		item := b.iter.Next()
+		if item.IsNone() {
+			b.flag = true
+			return NoneSliceOfString()
+		}
+
+		b.items = append(b.items, item.Unwrap())
+	}
+
+	item := b.items[index]
+	b.positions[branch]++
+	b.trim()
+
+	return SomeSliceOfString(item)
+}
+
+// trim drops the buffered elements which every branch has yielded.
+func (b *teeBufferForSliceOfString) trim() {
+	slowest := b.positions[0]
+	for _, position := range b.positions[1:] {
+		if position < slowest {
+			slowest = position
+		}
+	}
+
+	if end := b.offset + uint(len(b.items)); slowest > end {
+		slowest = end
+	}
+
+	if slowest == b.offset {
+		return
+	}
+
+	if drop := slowest - b.offset; drop == uint(len(b.items)) {
+		b.items = b.items[:0]
+	} else {
+		b.items = b.items[drop:]
+	}
+
+	b.offset = slowest
+}
+
+// close releases a branch and closes the source once every branch is closed.
+func (b *teeBufferForSliceOfString) close(branch uint) error {
+	b.positions[branch] = ^uint(0)
+	b.trim()
+
+	b.open--
+	if b.open == 0 {
+		return closeOf(b.iter)
+	}
+
+	return nil
+}
+
+type teeForSliceOfString struct {
+	buffer *teeBufferForSliceOfString
+	branch uint
+	closed bool
+}
+
+func (t *teeForSliceOfString) Next() OptionForSliceOfString {
+	if t.closed {
+		return NoneSliceOfString()
+	}
+
+	return t.buffer.next(t.branch)
+}
+
+func (t *teeForSliceOfString) SizeHint() (uint, OptionForUint) {
+	if t.closed {
+		return 0, SomeUint(0)
+	}
+
+	buffered := t.buffer.offset + uint(len(t.buffer.items)) - t.buffer.positions[t.branch]
+	if t.buffer.flag {
+		return buffered, SomeUint(buffered)
+	}
+
+	lower, upper := t.buffer.iter.SizeHint()
+
+	if lower+buffered < lower {
+		lower = ^uint(0)
+	} else {
+		lower += buffered
+	}
+
+	if upper.IsNone() || upper.Unwrap()+buffered < upper.Unwrap() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(upper.Unwrap() + buffered)
+}
+
+func (t *teeForSliceOfString) Err() error {
+	return errOf(t.buffer.iter)
+}
+
+func (t *teeForSliceOfString) Close() error {
+	if t.closed {
+		return nil
+	}
+
+	t.closed = true
+
+	return t.buffer.close(t.branch)
+}
+
+var _ IterableForSliceOfString = &teeForSliceOfString{}
+
+// PairForSliceOfString is a 2-tuple.
+type PairForSliceOfString struct {
+	First  SliceOfString
+	Second SliceOfString
+}
+
+type takeWhileForSliceOfString struct {
+	iter      IterableForSliceOfString
+	predicate func(item SliceOfString) bool
+	flag      bool
+}
+
+func (t *takeWhileForSliceOfString) Next() OptionForSliceOfString {
+	if t.flag {
+		return NoneSliceOfString()
+	}
+
+	item := t.iter.Next()
+	if item.IsNone() {
+		t.flag = true
+		return NoneSliceOfString()
+	}
+
+	if !t.predicate(item.Unwrap()) {
+		t.flag = true
+		return NoneSliceOfString()
+	}
+
+	return item
+}
+
+func (t *takeWhileForSliceOfString) SizeHint() (uint, OptionForUint) {
+	if t.flag {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := t.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (t *takeWhileForSliceOfString) Err() error {
+	return errOf(t.iter)
+}
+
+func (t *takeWhileForSliceOfString) Close() error {
+	return closeOf(t.iter)
+}
+
+var _ IterableForSliceOfString = &takeWhileForSliceOfString{}
+
+type takeForSliceOfString struct {
+	iter  IterableForSliceOfString
+	max   uint
+	count uint
+	flag  bool
+}
+
+func (t *takeForSliceOfString) Next() OptionForSliceOfString {
+	if t.flag {
+		return NoneSliceOfString()
+	}
+
+	if t.count >= t.max {
+		t.flag = true
+		return NoneSliceOfString()
+	}
+
+	item := t.iter.Next()
+	if item.IsNone() {
+		t.flag = true
+		return NoneSliceOfString()
+	}
+
+	t.count++
+
+	return item
+}
+
+func (t *takeForSliceOfString) SizeHint() (uint, OptionForUint) {
+	if t.flag {
+		return 0, SomeUint(0)
+	}
+
+	remaining := t.max - t.count
+
+	lower, upper := t.iter.SizeHint()
+	if lower > remaining {
+		lower = remaining
+	}
+
+	if upper.IsSome() && upper.Unwrap() < remaining {
+		return lower, upper
+	}
+
+	return lower, SomeUint(remaining)
+}
+
+func (t *takeForSliceOfString) Clone() (IterableForSliceOfString, bool) {
+	iter, ok := cloneOfSliceOfString(t.iter)
+	if !ok {
+		return nil, false
+	}
+
+	return &takeForSliceOfString{iter: iter, max: t.max, count: t.count, flag: t.flag}, true
+}
+
+func (t *takeForSliceOfString) Err() error {
+	return errOf(t.iter)
+}
+
+func (t *takeForSliceOfString) Close() error {
+	return closeOf(t.iter)
+}
+
+var _ IterableForSliceOfString = &takeForSliceOfString{}
+
+type filterForSliceOfString struct {
+	iter      IteratorForSliceOfString
+	predicate func(item SliceOfString) bool
+}
+
+func (f *filterForSliceOfString) Next() OptionForSliceOfString {
+	return f.iter.find(f.predicate)
+}
+
+func (f *filterForSliceOfString) SizeHint() (uint, OptionForUint) {
+	_, upper := f.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (f *filterForSliceOfString) Clone() (IterableForSliceOfString, bool) {
+	iter, ok := f.iter.Clone()
+	if !ok {
+		return nil, false
+	}
+
+	return &filterForSliceOfString{iter: iter, predicate: f.predicate}, true
+}
+
+func (f *filterForSliceOfString) Err() error {
+	return f.iter.Err()
+}
+
+func (f *filterForSliceOfString) Close() error {
+	return f.iter.Close()
+}
+
+var _ IterableForSliceOfString = &filterForSliceOfString{}
+
+// cloneOfSliceOfEntryForStringToInt clones an Iterable if it is Cloneable.
+func cloneOfSliceOfEntryForStringToInt(iter IterableForSliceOfEntryForStringToInt) (IterableForSliceOfEntryForStringToInt, bool) {
+	c, ok := iter.(CloneableForSliceOfEntryForStringToInt)
+	if !ok {
+		return nil, false
+	}
+
+	return c.Clone()
+}
+
+type mapIterableForSliceOfEntryForStringToInt struct {
+	iter   IterableForSliceOfEntryForStringToInt
+	mapper func(item SliceOfEntryForStringToInt) SliceOfEntryForStringToInt
+}
+
+func (m *mapIterableForSliceOfEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	item := m.iter.Next()
+	if item.IsNone() {
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	return SomeSliceOfEntryForStringToInt(m.mapper(item.Unwrap()))
+}
+
+func (m *mapIterableForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	return m.iter.SizeHint()
+}
+
+func (m *mapIterableForSliceOfEntryForStringToInt) Clone() (IterableForSliceOfEntryForStringToInt, bool) {
+	iter, ok := cloneOfSliceOfEntryForStringToInt(m.iter)
+	if !ok {
+		return nil, false
+	}
+
+	return &mapIterableForSliceOfEntryForStringToInt{iter: iter, mapper: m.mapper}, true
+}
+
+func (m *mapIterableForSliceOfEntryForStringToInt) Err() error {
+	return errOf(m.iter)
+}
+
+func (m *mapIterableForSliceOfEntryForStringToInt) Close() error {
+	return closeOf(m.iter)
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &mapIterableForSliceOfEntryForStringToInt{}
+
+type chainForSliceOfEntryForStringToInt struct {
+	first  IterableForSliceOfEntryForStringToInt
+	second IterableForSliceOfEntryForStringToInt
+	flag   bool
+}
+
+func (c *chainForSliceOfEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	if c.flag {
+		return c.second.Next()
+	}
+
+	item := c.first.Next()
+	if item.IsNone() {
+		c.flag = true
+		return c.second.Next()
+	}
+
+	return item
+}
+
+func (c *chainForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	secondLower, secondUpper := c.second.SizeHint()
+	if c.flag {
+		return secondLower, secondUpper
+	}
+
+	firstLower, firstUpper := c.first.SizeHint()
+
+	lower := firstLower + secondLower
+	if lower < firstLower {
+		lower = ^uint(0)
+	}
+
+	if firstUpper.IsNone() || secondUpper.IsNone() {
+		return lower, NoneUint()
+	}
+
+	upper := firstUpper.Unwrap() + secondUpper.Unwrap()
+	if upper < firstUpper.Unwrap() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(upper)
+}
+
+func (c *chainForSliceOfEntryForStringToInt) Clone() (IterableForSliceOfEntryForStringToInt, bool) {
+	first, ok := cloneOfSliceOfEntryForStringToInt(c.first)
+	if !ok {
+		return nil, false
+	}
+
+	second, ok := cloneOfSliceOfEntryForStringToInt(c.second)
+	if !ok {
+		return nil, false
+	}
+
+	return &chainForSliceOfEntryForStringToInt{first: first, second: second, flag: c.flag}, true
+}
+
+func (c *chainForSliceOfEntryForStringToInt) Err() error {
+	if err := errOf(c.first); err != nil {
+		return err
+	}
+
+	return errOf(c.second)
+}
+
+func (c *chainForSliceOfEntryForStringToInt) Close() error {
+	firstErr := closeOf(c.first)
+	if err := closeOf(c.second); firstErr == nil {
+		return err
+	}
+
+	return firstErr
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &chainForSliceOfEntryForStringToInt{}
+
+type cycleForSliceOfEntryForStringToInt struct {
+	iter   IterableForSliceOfEntryForStringToInt
+	buffer []SliceOfEntryForStringToInt
+	cursor uint
+	flag   bool
+}
+
+func (c *cycleForSliceOfEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	if !c.flag {
+		item := c.iter.Next()
+		if item.IsSome() {
+			c.buffer = append(c.buffer, item.Unwrap())
+			return item
+		}
+
+		c.flag = true
+	}
+
+	if len(c.buffer) == 0 {
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	item := c.buffer[c.cursor]
+	c.cursor = (c.cursor + 1) % uint(len(c.buffer))
+
+	return SomeSliceOfEntryForStringToInt(item)
+}
+
+func (c *cycleForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if len(c.buffer) > 0 {
+		return ^uint(0), NoneUint()
+	}
+
+	if c.flag {
+		return 0, SomeUint(0)
+	}
+
+	lower, upper := c.iter.SizeHint()
+	if lower > 0 {
+		return ^uint(0), NoneUint()
+	}
+
+	if upper.IsSome() && upper.Unwrap() == 0 {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (c *cycleForSliceOfEntryForStringToInt) Err() error {
+	return errOf(c.iter)
+}
+
+func (c *cycleForSliceOfEntryForStringToInt) Close() error {
+	return closeOf(c.iter)
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &cycleForSliceOfEntryForStringToInt{}
+
+type teeBufferForSliceOfEntryForStringToInt struct {
+	iter      IterableForSliceOfEntryForStringToInt
+	items     []SliceOfEntryForStringToInt
+	offset    uint
+	positions []uint
+	open      uint
+	flag      bool
+}
+
+func (b *teeBufferForSliceOfEntryForStringToInt) next(branch uint) OptionForSliceOfEntryForStringToInt {
+	index := b.positions[branch] - b.offset
+	if index >= uint(len(b.items)) {
+		if b.flag {
+			return NoneSliceOfEntryForStringToInt()
+		}
+
+		item := b.iter.Next()
+		if item.IsNone() {
+			b.flag = true
+			return NoneSliceOfEntryForStringToInt()
+		}
+
+		b.items = append(b.items, item.Unwrap())
+	}
+
+	item := b.items[index]
+	b.positions[branch]++
+	b.trim()
+
+	return SomeSliceOfEntryForStringToInt(item)
+}
+
+// trim drops the buffered elements which every branch has yielded.
+func (b *teeBufferForSliceOfEntryForStringToInt) trim() {
+	slowest := b.positions[0]
+	for _, position := range b.positions[1:] {
+		if position < slowest {
+			slowest = position
+		}
+	}
+
+	if end := b.offset + uint(len(b.items)); slowest > end {
+		slowest = end
+	}
+
+	if slowest == b.offset {
+		return
+	}
+
+	if drop := slowest - b.offset; drop == uint(len(b.items)) {
+		b.items = b.items[:0]
+	} else {
+		b.items = b.items[drop:]
+	}
+
+	b.offset = slowest
+}
+
+// close releases a branch and closes the source once every branch is closed.
+func (b *teeBufferForSliceOfEntryForStringToInt) close(branch uint) error {
+	b.positions[branch] = ^uint(0)
+	b.trim()
+
+	b.open--
+	if b.open == 0 {
+		return closeOf(b.iter)
+	}
+
+	return nil
+}
+
+type teeForSliceOfEntryForStringToInt struct {
+	buffer *teeBufferForSliceOfEntryForStringToInt
+	branch uint
+	closed bool
+}
+
+func (t *teeForSliceOfEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	if t.closed {
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	return t.buffer.next(t.branch)
+}
+
+func (t *teeForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if t.closed {
+		return 0, SomeUint(0)
+	}
+
+	buffered := t.buffer.offset + uint(len(t.buffer.items)) - t.buffer.positions[t.branch]
+	if t.buffer.flag {
+		return buffered, SomeUint(buffered)
+	}
+
+	lower, upper := t.buffer.iter.SizeHint()
+
+	if lower+buffered < lower {
+		lower = ^uint(0)
+	} else {
+		lower += buffered
+	}
+
+	if upper.IsNone() || upper.Unwrap()+buffered < upper.Unwrap() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(upper.Unwrap() + buffered)
+}
+
+func (t *teeForSliceOfEntryForStringToInt) Err() error {
+	return errOf(t.buffer.iter)
+}
+
+func (t *teeForSliceOfEntryForStringToInt) Close() error {
+	if t.closed {
+		return nil
+	}
+
+	t.closed = true
+
+	return t.buffer.close(t.branch)
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &teeForSliceOfEntryForStringToInt{}
+
+// PairForSliceOfEntryForStringToInt is a 2-tuple.
+type PairForSliceOfEntryForStringToInt struct {
+	First  SliceOfEntryForStringToInt
+	Second SliceOfEntryForStringToInt
+}
+
+type takeWhileForSliceOfEntryForStringToInt struct {
+	iter      IterableForSliceOfEntryForStringToInt
+	predicate func(item SliceOfEntryForStringToInt) bool
+	flag      bool
+}
+
+func (t *takeWhileForSliceOfEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	if t.flag {
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	item := t.iter.Next()
+	if item.IsNone() {
+		t.flag = true
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	if !t.predicate(item.Unwrap()) {
+		t.flag = true
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	return item
+}
+
+func (t *takeWhileForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if t.flag {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := t.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (t *takeWhileForSliceOfEntryForStringToInt) Err() error {
+	return errOf(t.iter)
+}
+
+func (t *takeWhileForSliceOfEntryForStringToInt) Close() error {
+	return closeOf(t.iter)
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &takeWhileForSliceOfEntryForStringToInt{}
+
+type takeForSliceOfEntryForStringToInt struct {
+	iter  IterableForSliceOfEntryForStringToInt
+	max   uint
+	count uint
+	flag  bool
+}
+
+func (t *takeForSliceOfEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	if t.flag {
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	if t.count >= t.max {
+		t.flag = true
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	item := t.iter.Next()
+	if item.IsNone() {
+		t.flag = true
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	t.count++
+
+	return item
+}
+
+func (t *takeForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if t.flag {
+		return 0, SomeUint(0)
+	}
+
+	remaining := t.max - t.count
+
+	lower, upper := t.iter.SizeHint()
+	if lower > remaining {
+		lower = remaining
+	}
+
+	if upper.IsSome() && upper.Unwrap() < remaining {
+		return lower, upper
+	}
+
+	return lower, SomeUint(remaining)
+}
+
+func (t *takeForSliceOfEntryForStringToInt) Clone() (IterableForSliceOfEntryForStringToInt, bool) {
+	iter, ok := cloneOfSliceOfEntryForStringToInt(t.iter)
+	if !ok {
+		return nil, false
+	}
+
+	return &takeForSliceOfEntryForStringToInt{iter: iter, max: t.max, count: t.count, flag: t.flag}, true
+}
+
+func (t *takeForSliceOfEntryForStringToInt) Err() error {
+	return errOf(t.iter)
+}
+
+func (t *takeForSliceOfEntryForStringToInt) Close() error {
+	return closeOf(t.iter)
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &takeForSliceOfEntryForStringToInt{}
+
+type filterForSliceOfEntryForStringToInt struct {
+	iter      IteratorForSliceOfEntryForStringToInt
+	predicate func(item SliceOfEntryForStringToInt) bool
+}
+
+func (f *filterForSliceOfEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	return f.iter.find(f.predicate)
+}
+
+func (f *filterForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	_, upper := f.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (f *filterForSliceOfEntryForStringToInt) Clone() (IterableForSliceOfEntryForStringToInt, bool) {
+	iter, ok := f.iter.Clone()
+	if !ok {
+		return nil, false
+	}
+
+	return &filterForSliceOfEntryForStringToInt{iter: iter, predicate: f.predicate}, true
+}
+
+func (f *filterForSliceOfEntryForStringToInt) Err() error {
+	return f.iter.Err()
+}
+
+func (f *filterForSliceOfEntryForStringToInt) Close() error {
+	return f.iter.Close()
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &filterForSliceOfEntryForStringToInt{}
+
+// cloneOfCSVRecord clones an Iterable if it is Cloneable.
+func cloneOfCSVRecord(iter IterableForCSVRecord) (IterableForCSVRecord, bool) {
+	c, ok := iter.(CloneableForCSVRecord)
+	if !ok {
+		return nil, false
+	}
+
+	return c.Clone()
+}
+
+type mapIterableForCSVRecord struct {
+	iter   IterableForCSVRecord
+	mapper func(item CSVRecord) CSVRecord
+}
+
+func (m *mapIterableForCSVRecord) Next() OptionForCSVRecord {
+	item := m.iter.Next()
+	if item.IsNone() {
+		return NoneCSVRecord()
+	}
+
+	return SomeCSVRecord(m.mapper(item.Unwrap()))
+}
+
+func (m *mapIterableForCSVRecord) SizeHint() (uint, OptionForUint) {
+	return m.iter.SizeHint()
+}
+
+func (m *mapIterableForCSVRecord) Clone() (IterableForCSVRecord, bool) {
+	iter, ok := cloneOfCSVRecord(m.iter)
+	if !ok {
+		return nil, false
+	}
+
+	return &mapIterableForCSVRecord{iter: iter, mapper: m.mapper}, true
+}
+
+func (m *mapIterableForCSVRecord) Err() error {
+	return errOf(m.iter)
+}
+
+func (m *mapIterableForCSVRecord) Close() error {
+	return closeOf(m.iter)
+}
+
+var _ IterableForCSVRecord = &mapIterableForCSVRecord{}
+
+type chainForCSVRecord struct {
+	first  IterableForCSVRecord
+	second IterableForCSVRecord
+	flag   bool
+}
+
+func (c *chainForCSVRecord) Next() OptionForCSVRecord {
+	if c.flag {
+		return c.second.Next()
+	}
+
+	item := c.first.Next()
+	if item.IsNone() {
+		c.flag = true
+		return c.second.Next()
+	}
+
+	return item
+}
+
+func (c *chainForCSVRecord) SizeHint() (uint, OptionForUint) {
+	secondLower, secondUpper := c.second.SizeHint()
+	if c.flag {
+		return secondLower, secondUpper
+	}
+
+	firstLower, firstUpper := c.first.SizeHint()
+
+	lower := firstLower + secondLower
+	if lower < firstLower {
+		lower = ^uint(0)
+	}
+
+	if firstUpper.IsNone() || secondUpper.IsNone() {
+		return lower, NoneUint()
+	}
+
+	upper := firstUpper.Unwrap() + secondUpper.Unwrap()
+	if upper < firstUpper.Unwrap() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(upper)
+}
+
+func (c *chainForCSVRecord) Clone() (IterableForCSVRecord, bool) {
+	first, ok := cloneOfCSVRecord(c.first)
+	if !ok {
+		return nil, false
+	}
+
+	second, ok := cloneOfCSVRecord(c.second)
+	if !ok {
+		return nil, false
+	}
+
+	return &chainForCSVRecord{first: first, second: second, flag: c.flag}, true
+}
+
+func (c *chainForCSVRecord) Err() error {
+	if err := errOf(c.first); err != nil {
+		return err
+	}
+
+	return errOf(c.second)
+}
+
+func (c *chainForCSVRecord) Close() error {
+	firstErr := closeOf(c.first)
+	if err := closeOf(c.second); firstErr == nil {
+		return err
+	}
+
+	return firstErr
+}
+
+var _ IterableForCSVRecord = &chainForCSVRecord{}
+
+type cycleForCSVRecord struct {
+	iter   IterableForCSVRecord
+	buffer []CSVRecord
+	cursor uint
+	flag   bool
+}
+
+func (c *cycleForCSVRecord) Next() OptionForCSVRecord {
+	if !c.flag {
+		item := c.iter.Next()
+		if item.IsSome() {
+			c.buffer = append(c.buffer, item.Unwrap())
+			return item
+		}
+
+		c.flag = true
+	}
+
+	if len(c.buffer) == 0 {
+		return NoneCSVRecord()
+	}
+
+	item := c.buffer[c.cursor]
+	c.cursor = (c.cursor + 1) % uint(len(c.buffer))
+
+	return SomeCSVRecord(item)
+}
+
+func (c *cycleForCSVRecord) SizeHint() (uint, OptionForUint) {
+	if len(c.buffer) > 0 {
+		return ^uint(0), NoneUint()
+	}
+
+	if c.flag {
+		return 0, SomeUint(0)
+	}
+
+	lower, upper := c.iter.SizeHint()
+	if lower > 0 {
+		return ^uint(0), NoneUint()
+	}
+
+	if upper.IsSome() && upper.Unwrap() == 0 {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (c *cycleForCSVRecord) Err() error {
+	return errOf(c.iter)
+}
+
+func (c *cycleForCSVRecord) Close() error {
+	return closeOf(c.iter)
+}
+
+var _ IterableForCSVRecord = &cycleForCSVRecord{}
+
+type teeBufferForCSVRecord struct {
+	iter      IterableForCSVRecord
+	items     []CSVRecord
+	offset    uint
+	positions []uint
+	open      uint
+	flag      bool
+}
+
+func (b *teeBufferForCSVRecord) next(branch uint) OptionForCSVRecord {
+	index := b.positions[branch] - b.offset
+	if index >= uint(len(b.items)) {
+		if b.flag {
+			return NoneCSVRecord()
+		}
+
+		item := b.iter.Next()
+		if item.IsNone() {
+			b.flag = true
+			return NoneCSVRecord()
+		}
+
+		b.items = append(b.items, item.Unwrap())
+	}
+
+	item := b.items[index]
+	b.positions[branch]++
+	b.trim()
+
+	return SomeCSVRecord(item)
+}
+
+// trim drops the buffered elements which every branch has yielded.
+func (b *teeBufferForCSVRecord) trim() {
+	slowest := b.positions[0]
+	for _, position := range b.positions[1:] {
+		if position < slowest {
+			slowest = position
+		}
+	}
+
+	if end := b.offset + uint(len(b.items)); slowest > end {
+		slowest = end
+	}
+
+	if slowest == b.offset {
+		return
+	}
+
+	if drop := slowest - b.offset; drop == uint(len(b.items)) {
+		b.items = b.items[:0]
+	} else {
+		b.items = b.items[drop:]
+	}
+
+	b.offset = slowest
+}
+
+// close releases a branch and closes the source once every branch is closed.
+func (b *teeBufferForCSVRecord) close(branch uint) error {
+	b.positions[branch] = ^uint(0)
+	b.trim()
+
+	b.open--
+	if b.open == 0 {
+		return closeOf(b.iter)
+	}
+
+	return nil
+}
+
+type teeForCSVRecord struct {
+	buffer *teeBufferForCSVRecord
+	branch uint
+	closed bool
+}
+
+func (t *teeForCSVRecord) Next() OptionForCSVRecord {
+	if t.closed {
+		return NoneCSVRecord()
+	}
+
+	return t.buffer.next(t.branch)
+}
+
+func (t *teeForCSVRecord) SizeHint() (uint, OptionForUint) {
+	if t.closed {
+		return 0, SomeUint(0)
+	}
+
+	buffered := t.buffer.offset + uint(len(t.buffer.items)) - t.buffer.positions[t.branch]
+	if t.buffer.flag {
+		return buffered, SomeUint(buffered)
+	}
+
+	lower, upper := t.buffer.iter.SizeHint()
+
+	if lower+buffered < lower {
+		lower = ^uint(0)
+	} else {
+		lower += buffered
+	}
+
+	if upper.IsNone() || upper.Unwrap()+buffered < upper.Unwrap() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(upper.Unwrap() + buffered)
+}
+
+func (t *teeForCSVRecord) Err() error {
+	return errOf(t.buffer.iter)
+}
+
+func (t *teeForCSVRecord) Close() error {
+	if t.closed {
+		return nil
+	}
+
+	t.closed = true
+
+	return t.buffer.close(t.branch)
+}
+
+var _ IterableForCSVRecord = &teeForCSVRecord{}
+
+// PairForCSVRecord is a 2-tuple.
+type PairForCSVRecord struct {
+	First  CSVRecord
+	Second CSVRecord
+}
+
+type takeWhileForCSVRecord struct {
+	iter      IterableForCSVRecord
+	predicate func(item CSVRecord) bool
+	flag      bool
+}
+
+func (t *takeWhileForCSVRecord) Next() OptionForCSVRecord {
+	if t.flag {
+		return NoneCSVRecord()
+	}
+
+	item := t.iter.Next()
+	if item.IsNone() {
+		t.flag = true
+		return NoneCSVRecord()
+	}
+
+	if !t.predicate(item.Unwrap()) {
+		t.flag = true
+		return NoneCSVRecord()
+	}
+
+	return item
+}
+
+func (t *takeWhileForCSVRecord) SizeHint() (uint, OptionForUint) {
+	if t.flag {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := t.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (t *takeWhileForCSVRecord) Err() error {
+	return errOf(t.iter)
+}
+
+func (t *takeWhileForCSVRecord) Close() error {
+	return closeOf(t.iter)
+}
+
+var _ IterableForCSVRecord = &takeWhileForCSVRecord{}
+
+type takeForCSVRecord struct {
+	iter  IterableForCSVRecord
+	max   uint
+	count uint
+	flag  bool
+}
+
+func (t *takeForCSVRecord) Next() OptionForCSVRecord {
+	if t.flag {
+		return NoneCSVRecord()
+	}
+
+	if t.count >= t.max {
+		t.flag = true
+		return NoneCSVRecord()
+	}
+
+	item := t.iter.Next()
+	if item.IsNone() {
+		t.flag = true
+		return NoneCSVRecord()
+	}
+
+	t.count++
+
+	return item
+}
+
+func (t *takeForCSVRecord) SizeHint() (uint, OptionForUint) {
+	if t.flag {
+		return 0, SomeUint(0)
+	}
+
+	remaining := t.max - t.count
+
+	lower, upper := t.iter.SizeHint()
+	if lower > remaining {
+		lower = remaining
+	}
+
+	if upper.IsSome() && upper.Unwrap() < remaining {
+		return lower, upper
+	}
+
+	return lower, SomeUint(remaining)
+}
+
+func (t *takeForCSVRecord) Clone() (IterableForCSVRecord, bool) {
+	iter, ok := cloneOfCSVRecord(t.iter)
+	if !ok {
+		return nil, false
+	}
+
+	return &takeForCSVRecord{iter: iter, max: t.max, count: t.count, flag: t.flag}, true
+}
+
+func (t *takeForCSVRecord) Err() error {
+	return errOf(t.iter)
+}
+
+func (t *takeForCSVRecord) Close() error {
+	return closeOf(t.iter)
+}
+
+var _ IterableForCSVRecord = &takeForCSVRecord{}
+
+type filterForCSVRecord struct {
+	iter      IteratorForCSVRecord
+	predicate func(item CSVRecord) bool
+}
+
+func (f *filterForCSVRecord) Next() OptionForCSVRecord {
+	return f.iter.find(f.predicate)
+}
+
+func (f *filterForCSVRecord) SizeHint() (uint, OptionForUint) {
+	_, upper := f.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (f *filterForCSVRecord) Clone() (IterableForCSVRecord, bool) {
+	iter, ok := f.iter.Clone()
+	if !ok {
+		return nil, false
+	}
+
+	return &filterForCSVRecord{iter: iter, predicate: f.predicate}, true
+}
+
+func (f *filterForCSVRecord) Err() error {
+	return f.iter.Err()
+}
+
+func (f *filterForCSVRecord) Close() error {
+	return f.iter.Close()
+}
+
+var _ IterableForCSVRecord = &filterForCSVRecord{}
@@ -0,0 +1,802 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package iter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// NDJSONForInt builds a fallible Iterator decoding one JSON value per line of r into an Int.
+// r is not closed. The Iterator stops at the first error.
+func NDJSONForInt(r io.Reader) TryIteratorForInt {
+	return TryIteratorForInt{
+		iter: &ndjsonForInt{decoder: json.NewDecoder(r), index: 0, flag: false},
+	}
+}
+
+type ndjsonForInt struct {
+	decoder *json.Decoder
+	index   uint
+	flag    bool
+}
+
+func (n *ndjsonForInt) Next() OptionForResultForInt {
+	if n.flag {
+		return NoneResultForInt()
+	}
+
+	var value int
+	if err := n.decoder.Decode(&value); err != nil {
+		n.flag = true
+
+		if err == io.EOF {
+			return NoneResultForInt()
+		}
+
+		return SomeResultForInt(ErrInt(fmt.Errorf("decoding value %d: %w", n.index, err)))
+	}
+
+	n.index++
+
+	return SomeResultForInt(OkInt(value))
+}
+
+func (n *ndjsonForInt) SizeHint() (uint, OptionForUint) {
+	if n.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+var _ TryIterableForInt = &ndjsonForInt{}
+
+// JSONArrayForInt builds a fallible Iterator decoding the values of the JSON array read from r one at a time.
+// r is not closed. The Iterator stops at the first error.
+func JSONArrayForInt(r io.Reader) TryIteratorForInt {
+	return JSONArrayAtForInt(r, "")
+}
+
+// JSONArrayAtForInt builds a fallible Iterator decoding the values of a JSON array nested in the document read from r.
+// The array is located by a JSON pointer such as "/data/items", the empty pointer designating the document itself.
+// Members preceding the array are decoded and discarded. r is not closed. The Iterator stops at the first error.
+func JSONArrayAtForInt(r io.Reader, pointer string) TryIteratorForInt {
+	return TryIteratorForInt{
+		iter: &jsonArrayForInt{decoder: json.NewDecoder(r), pointer: pointer, index: 0, started: false, flag: false},
+	}
+}
+
+type jsonArrayForInt struct {
+	decoder *json.Decoder
+	pointer string
+	index   uint
+	started bool
+	flag    bool
+}
+
+func (j *jsonArrayForInt) Next() OptionForResultForInt {
+	if j.flag {
+		return NoneResultForInt()
+	}
+
+	if !j.started {
+		j.started = true
+
+		if err := seekJSONArray(j.decoder, j.pointer); err != nil {
+			j.flag = true
+			return SomeResultForInt(ErrInt(err))
+		}
+	}
+
+	if !j.decoder.More() {
+		j.flag = true
+
+		if _, err := j.decoder.Token(); err != nil {
+			return SomeResultForInt(ErrInt(fmt.Errorf("closing array at %q: %w", j.pointer, err)))
+		}
+
+		return NoneResultForInt()
+	}
+
+	var value int
+	if err := j.decoder.Decode(&value); err != nil {
+		j.flag = true
+		return SomeResultForInt(ErrInt(fmt.Errorf("decoding value %d of array at %q: %w", j.index, j.pointer, err)))
+	}
+
+	j.index++
+
+	return SomeResultForInt(OkInt(value))
+}
+
+func (j *jsonArrayForInt) SizeHint() (uint, OptionForUint) {
+	if j.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+var _ TryIterableForInt = &jsonArrayForInt{}
+
+// NDJSONForString builds a fallible Iterator decoding one JSON value per line of r into an String.
+// r is not closed. The Iterator stops at the first error.
+func NDJSONForString(r io.Reader) TryIteratorForString {
+	return TryIteratorForString{
+		iter: &ndjsonForString{decoder: json.NewDecoder(r), index: 0, flag: false},
+	}
+}
+
+type ndjsonForString struct {
+	decoder *json.Decoder
+	index   uint
+	flag    bool
+}
+
+func (n *ndjsonForString) Next() OptionForResultForString {
+	if n.flag {
+		return NoneResultForString()
+	}
+
+	var value string
+	if err := n.decoder.Decode(&value); err != nil {
+		n.flag = true
+
+		if err == io.EOF {
+			return NoneResultForString()
+		}
+
+		return SomeResultForString(ErrString(fmt.Errorf("decoding value %d: %w", n.index, err)))
+	}
+
+	n.index++
+
+	return SomeResultForString(OkString(value))
+}
+
+func (n *ndjsonForString) SizeHint() (uint, OptionForUint) {
+	if n.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+var _ TryIterableForString = &ndjsonForString{}
+
+// JSONArrayForString builds a fallible Iterator decoding the values of the JSON array read from r one at a time.
+// r is not closed. The Iterator stops at the first error.
+func JSONArrayForString(r io.Reader) TryIteratorForString {
+	return JSONArrayAtForString(r, "")
+}
+
+// JSONArrayAtForString builds a fallible Iterator decoding the values of a JSON array nested in the document read from r.
+// The array is located by a JSON pointer such as "/data/items", the empty pointer designating the document itself.
+// Members preceding the array are decoded and discarded. r is not closed. The Iterator stops at the first error.
+func JSONArrayAtForString(r io.Reader, pointer string) TryIteratorForString {
+	return TryIteratorForString{
+		iter: &jsonArrayForString{decoder: json.NewDecoder(r), pointer: pointer, index: 0, started: false, flag: false},
+	}
+}
+
+type jsonArrayForString struct {
+	decoder *json.Decoder
+	pointer string
+	index   uint
+	started bool
+	flag    bool
+}
+
+func (j *jsonArrayForString) Next() OptionForResultForString {
+	if j.flag {
+		return NoneResultForString()
+	}
+
+	if !j.started {
+		j.started = true
+
+		if err := seekJSONArray(j.decoder, j.pointer); err != nil {
+			j.flag = true
+			return SomeResultForString(ErrString(err))
+		}
+	}
+
+	if !j.decoder.More() {
+		j.flag = true
+
+		if _, err := j.decoder.Token(); err != nil {
+			return SomeResultForString(ErrString(fmt.Errorf("closing array at %q: %w", j.pointer, err)))
+		}
+
+		return NoneResultForString()
+	}
+
+	var value string
+	if err := j.decoder.Decode(&value); err != nil {
+		j.flag = true
+		return SomeResultForString(ErrString(fmt.Errorf("decoding value %d of array at %q: %w", j.index, j.pointer, err)))
+	}
+
+	j.index++
+
+	return SomeResultForString(OkString(value))
+}
+
+func (j *jsonArrayForString) SizeHint() (uint, OptionForUint) {
+	if j.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+var _ TryIterableForString = &jsonArrayForString{}
+
+// NDJSONForEntryForStringToInt builds a fallible Iterator decoding one JSON value per line of r into an EntryForStringToInt.
+// r is not closed. The Iterator stops at the first error.
+func NDJSONForEntryForStringToInt(r io.Reader) TryIteratorForEntryForStringToInt {
+	return TryIteratorForEntryForStringToInt{
+		iter: &ndjsonForEntryForStringToInt{decoder: json.NewDecoder(r), index: 0, flag: false},
+	}
+}
+
+type ndjsonForEntryForStringToInt struct {
+	decoder *json.Decoder
+	index   uint
+	flag    bool
+}
+
+func (n *ndjsonForEntryForStringToInt) Next() OptionForResultForEntryForStringToInt {
+	if n.flag {
+		return NoneResultForEntryForStringToInt()
+	}
+
+	var value EntryForStringToInt
+	if err := n.decoder.Decode(&value); err != nil {
+		n.flag = true
+
+		if err == io.EOF {
+			return NoneResultForEntryForStringToInt()
+		}
+
+		return SomeResultForEntryForStringToInt(ErrEntryForStringToInt(fmt.Errorf("decoding value %d: %w", n.index, err)))
+	}
+
+	n.index++
+
+	return SomeResultForEntryForStringToInt(OkEntryForStringToInt(value))
+}
+
+func (n *ndjsonForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if n.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+var _ TryIterableForEntryForStringToInt = &ndjsonForEntryForStringToInt{}
+
+// JSONArrayForEntryForStringToInt builds a fallible Iterator decoding the values of the JSON array read from r one at a time.
+// r is not closed. The Iterator stops at the first error.
+func JSONArrayForEntryForStringToInt(r io.Reader) TryIteratorForEntryForStringToInt {
+	return JSONArrayAtForEntryForStringToInt(r, "")
+}
+
+// JSONArrayAtForEntryForStringToInt builds a fallible Iterator decoding the values of a JSON array nested in the document read from r.
+// The array is located by a JSON pointer such as "/data/items", the empty pointer designating the document itself.
+// Members preceding the array are decoded and discarded. r is not closed. The Iterator stops at the first error.
+func JSONArrayAtForEntryForStringToInt(r io.Reader, pointer string) TryIteratorForEntryForStringToInt {
+	return TryIteratorForEntryForStringToInt{
+		iter: &jsonArrayForEntryForStringToInt{decoder: json.NewDecoder(r), pointer: pointer, index: 0, started: false, flag: false},
+	}
+}
+
+type jsonArrayForEntryForStringToInt struct {
+	decoder *json.Decoder
+	pointer string
+	index   uint
+	started bool
+	flag    bool
+}
+
+func (j *jsonArrayForEntryForStringToInt) Next() OptionForResultForEntryForStringToInt {
+	if j.flag {
+		return NoneResultForEntryForStringToInt()
+	}
+
+	if !j.started {
+		j.started = true
+
+		if err := seekJSONArray(j.decoder, j.pointer); err != nil {
+			j.flag = true
+			return SomeResultForEntryForStringToInt(ErrEntryForStringToInt(err))
+		}
+	}
+
+	if !j.decoder.More() {
+		j.flag = true
+
+		if _, err := j.decoder.Token(); err != nil {
+			return SomeResultForEntryForStringToInt(ErrEntryForStringToInt(fmt.Errorf("closing array at %q: %w", j.pointer, err)))
+		}
+
+		return NoneResultForEntryForStringToInt()
+	}
+
+	var value EntryForStringToInt
+	if err := j.decoder.Decode(&value); err != nil {
+		j.flag = true
+		return SomeResultForEntryForStringToInt(ErrEntryForStringToInt(fmt.Errorf("decoding value %d of array at %q: %w", j.index, j.pointer, err)))
+	}
+
+	j.index++
+
+	return SomeResultForEntryForStringToInt(OkEntryForStringToInt(value))
+}
+
+func (j *jsonArrayForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if j.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+var _ TryIterableForEntryForStringToInt = &jsonArrayForEntryForStringToInt{}
+
+// NDJSONForSliceOfInt builds a fallible Iterator decoding one JSON value per line of r into an SliceOfInt.
+// r is not closed. The Iterator stops at the first error.
+func NDJSONForSliceOfInt(r io.Reader) TryIteratorForSliceOfInt {
+	return TryIteratorForSliceOfInt{
+		iter: &ndjsonForSliceOfInt{decoder: json.NewDecoder(r), index: 0, flag: false},
+	}
+}
+
+type ndjsonForSliceOfInt struct {
+	decoder *json.Decoder
+	index   uint
+	flag    bool
+}
+
+func (n *ndjsonForSliceOfInt) Next() OptionForResultForSliceOfInt {
+	if n.flag {
+		return NoneResultForSliceOfInt()
+	}
+
+	var value SliceOfInt
+	if err := n.decoder.Decode(&value); err != nil {
+		n.flag = true
+
+		if err == io.EOF {
+			return NoneResultForSliceOfInt()
+		}
+
+		return SomeResultForSliceOfInt(ErrSliceOfInt(fmt.Errorf("decoding value %d: %w", n.index, err)))
+	}
+
+	n.index++
+
+	return SomeResultForSliceOfInt(OkSliceOfInt(value))
+}
+
+func (n *ndjsonForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	if n.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+var _ TryIterableForSliceOfInt = &ndjsonForSliceOfInt{}
+
+// JSONArrayForSliceOfInt builds a fallible Iterator decoding the values of the JSON array read from r one at a time.
+// r is not closed. The Iterator stops at the first error.
+func JSONArrayForSliceOfInt(r io.Reader) TryIteratorForSliceOfInt {
+	return JSONArrayAtForSliceOfInt(r, "")
+}
+
+// JSONArrayAtForSliceOfInt builds a fallible Iterator decoding the values of a JSON array nested in the document read from r.
+// The array is located by a JSON pointer such as "/data/items", the empty pointer designating the document itself.
+// Members preceding the array are decoded and discarded. r is not closed. The Iterator stops at the first error.
+func JSONArrayAtForSliceOfInt(r io.Reader, pointer string) TryIteratorForSliceOfInt {
+	return TryIteratorForSliceOfInt{
+		iter: &jsonArrayForSliceOfInt{decoder: json.NewDecoder(r), pointer: pointer, index: 0, started: false, flag: false},
+	}
+}
+
+type jsonArrayForSliceOfInt struct {
+	decoder *json.Decoder
+	pointer string
+	index   uint
+	started bool
+	flag    bool
+}
+
+func (j *jsonArrayForSliceOfInt) Next() OptionForResultForSliceOfInt {
+	if j.flag {
+		return NoneResultForSliceOfInt()
+	}
+
+	if !j.started {
+		j.started = true
+
+		if err := seekJSONArray(j.decoder, j.pointer); err != nil {
+			j.flag = true
+			return SomeResultForSliceOfInt(ErrSliceOfInt(err))
+		}
+	}
+
+	if !j.decoder.More() {
+		j.flag = true
+
+		if _, err := j.decoder.Token(); err != nil {
+			return SomeResultForSliceOfInt(ErrSliceOfInt(fmt.Errorf("closing array at %q: %w", j.pointer, err)))
+		}
+
+		return NoneResultForSliceOfInt()
+	}
+
+	var value SliceOfInt
+	if err := j.decoder.Decode(&value); err != nil {
+		j.flag = true
+		return SomeResultForSliceOfInt(ErrSliceOfInt(fmt.Errorf("decoding value %d of array at %q: %w", j.index, j.pointer, err)))
+	}
+
+	j.index++
+
+	return SomeResultForSliceOfInt(OkSliceOfInt(value))
+}
+
+func (j *jsonArrayForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	if j.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+var _ TryIterableForSliceOfInt = &jsonArrayForSliceOfInt{}
+
+// NDJSONForSliceOfString builds a fallible Iterator decoding one JSON value per line of r into an SliceOfString.
+// r is not closed. The Iterator stops at the first error.
+func NDJSONForSliceOfString(r io.Reader) TryIteratorForSliceOfString {
+	return TryIteratorForSliceOfString{
+		iter: &ndjsonForSliceOfString{decoder: json.NewDecoder(r), index: 0, flag: false},
+	}
+}
+
+type ndjsonForSliceOfString struct {
+	decoder *json.Decoder
+	index   uint
+	flag    bool
+}
+
+func (n *ndjsonForSliceOfString) Next() OptionForResultForSliceOfString {
+	if n.flag {
+		return NoneResultForSliceOfString()
+	}
+
+	var value SliceOfString
+	if err := n.decoder.Decode(&value); err != nil {
+		n.flag = true
+
+		if err == io.EOF {
+			return NoneResultForSliceOfString()
+		}
+
+		return SomeResultForSliceOfString(ErrSliceOfString(fmt.Errorf("decoding value %d: %w", n.index, err)))
+	}
+
+	n.index++
+
+	return SomeResultForSliceOfString(OkSliceOfString(value))
+}
+
+func (n *ndjsonForSliceOfString) SizeHint() (uint, OptionForUint) {
+	if n.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+var _ TryIterableForSliceOfString = &ndjsonForSliceOfString{}
+
+// JSONArrayForSliceOfString builds a fallible Iterator decoding the values of the JSON array read from r one at a time.
+// r is not closed. The Iterator stops at the first error.
+func JSONArrayForSliceOfString(r io.Reader) TryIteratorForSliceOfString {
+	return JSONArrayAtForSliceOfString(r, "")
+}
+
+// JSONArrayAtForSliceOfString builds a fallible Iterator decoding the values of a JSON array nested in the document read from r.
+// The array is located by a JSON pointer such as "/data/items", the empty pointer designating the document itself.
+// Members preceding the array are decoded and discarded. r is not closed. The Iterator stops at the first error.
+func JSONArrayAtForSliceOfString(r io.Reader, pointer string) TryIteratorForSliceOfString {
+	return TryIteratorForSliceOfString{
+		iter: &jsonArrayForSliceOfString{decoder: json.NewDecoder(r), pointer: pointer, index: 0, started: false, flag: false},
+	}
+}
+
+type jsonArrayForSliceOfString struct {
+	decoder *json.Decoder
+	pointer string
+	index   uint
+	started bool
+	flag    bool
+}
+
+func (j *jsonArrayForSliceOfString) Next() OptionForResultForSliceOfString {
+	if j.flag {
+		return NoneResultForSliceOfString()
+	}
+
+	if !j.started {
+		j.started = true
+
+		if err := seekJSONArray(j.decoder, j.pointer); err != nil {
+			j.flag = true
+			return SomeResultForSliceOfString(ErrSliceOfString(err))
+		}
+	}
+
+	if !j.decoder.More() {
+		j.flag = true
+
+		if _, err := j.decoder.Token(); err != nil {
+			return SomeResultForSliceOfString(ErrSliceOfString(fmt.Errorf("closing array at %q: %w", j.pointer, err)))
+		}
+
+		return NoneResultForSliceOfString()
+	}
+
+	var value SliceOfString
+	if err := j.decoder.Decode(&value); err != nil {
+		j.flag = true
+		return SomeResultForSliceOfString(ErrSliceOfString(fmt.Errorf("decoding value %d of array at %q: %w", j.index, j.pointer, err)))
+	}
+
+	j.index++
+
+	return SomeResultForSliceOfString(OkSliceOfString(value))
+}
+
+func (j *jsonArrayForSliceOfString) SizeHint() (uint, OptionForUint) {
+	if j.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+var _ TryIterableForSliceOfString = &jsonArrayForSliceOfString{}
+
+// NDJSONForSliceOfEntryForStringToInt builds a fallible Iterator decoding one JSON value per line of r into an SliceOfEntryForStringToInt.
+// r is not closed. The Iterator stops at the first error.
+func NDJSONForSliceOfEntryForStringToInt(r io.Reader) TryIteratorForSliceOfEntryForStringToInt {
+	return TryIteratorForSliceOfEntryForStringToInt{
+		iter: &ndjsonForSliceOfEntryForStringToInt{decoder: json.NewDecoder(r), index: 0, flag: false},
+	}
+}
+
+type ndjsonForSliceOfEntryForStringToInt struct {
+	decoder *json.Decoder
+	index   uint
+	flag    bool
+}
+
+func (n *ndjsonForSliceOfEntryForStringToInt) Next() OptionForResultForSliceOfEntryForStringToInt {
+	if n.flag {
+		return NoneResultForSliceOfEntryForStringToInt()
+	}
+
+	var value SliceOfEntryForStringToInt
+	if err := n.decoder.Decode(&value); err != nil {
+		n.flag = true
+
+		if err == io.EOF {
+			return NoneResultForSliceOfEntryForStringToInt()
+		}
+
+		return SomeResultForSliceOfEntryForStringToInt(ErrSliceOfEntryForStringToInt(fmt.Errorf("decoding value %d: %w", n.index, err)))
+	}
+
+	n.index++
+
+	return SomeResultForSliceOfEntryForStringToInt(OkSliceOfEntryForStringToInt(value))
+}
+
+func (n *ndjsonForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if n.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+var _ TryIterableForSliceOfEntryForStringToInt = &ndjsonForSliceOfEntryForStringToInt{}
+
+// JSONArrayForSliceOfEntryForStringToInt builds a fallible Iterator decoding the values of the JSON array read from r one at a time.
+// r is not closed. The Iterator stops at the first error.
+func JSONArrayForSliceOfEntryForStringToInt(r io.Reader) TryIteratorForSliceOfEntryForStringToInt {
+	return JSONArrayAtForSliceOfEntryForStringToInt(r, "")
+}
+
+// JSONArrayAtForSliceOfEntryForStringToInt builds a fallible Iterator decoding the values of a JSON array nested in the document read from r.
+// The array is located by a JSON pointer such as "/data/items", the empty pointer designating the document itself.
+// Members preceding the array are decoded and discarded. r is not closed. The Iterator stops at the first error.
+func JSONArrayAtForSliceOfEntryForStringToInt(r io.Reader, pointer string) TryIteratorForSliceOfEntryForStringToInt {
+	return TryIteratorForSliceOfEntryForStringToInt{
+		iter: &jsonArrayForSliceOfEntryForStringToInt{decoder: json.NewDecoder(r), pointer: pointer, index: 0, started: false, flag: false},
+	}
+}
+
+type jsonArrayForSliceOfEntryForStringToInt struct {
+	decoder *json.Decoder
+	pointer string
+	index   uint
+	started bool
+	flag    bool
+}
+
+func (j *jsonArrayForSliceOfEntryForStringToInt) Next() OptionForResultForSliceOfEntryForStringToInt {
+	if j.flag {
+		return NoneResultForSliceOfEntryForStringToInt()
+	}
+
+	if !j.started {
+		j.started = true
+
+		if err := seekJSONArray(j.decoder, j.pointer); err != nil {
+			j.flag = true
+			return SomeResultForSliceOfEntryForStringToInt(ErrSliceOfEntryForStringToInt(err))
+		}
+	}
+
+	if !j.decoder.More() {
+		j.flag = true
+
+		if _, err := j.decoder.Token(); err != nil {
+			return SomeResultForSliceOfEntryForStringToInt(ErrSliceOfEntryForStringToInt(fmt.Errorf("closing array at %q: %w", j.pointer, err)))
+		}
+
+		return NoneResultForSliceOfEntryForStringToInt()
+	}
+
+	var value SliceOfEntryForStringToInt
+	if err := j.decoder.Decode(&value); err != nil {
+		j.flag = true
+		return SomeResultForSliceOfEntryForStringToInt(ErrSliceOfEntryForStringToInt(fmt.Errorf("decoding value %d of array at %q: %w", j.index, j.pointer, err)))
+	}
+
+	j.index++
+
+	return SomeResultForSliceOfEntryForStringToInt(OkSliceOfEntryForStringToInt(value))
+}
+
+func (j *jsonArrayForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if j.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+var _ TryIterableForSliceOfEntryForStringToInt = &jsonArrayForSliceOfEntryForStringToInt{}
+
+// NDJSONForCSVRecord builds a fallible Iterator decoding one JSON value per line of r into an CSVRecord.
+// r is not closed. The Iterator stops at the first error.
+func NDJSONForCSVRecord(r io.Reader) TryIteratorForCSVRecord {
+	return TryIteratorForCSVRecord{
+		iter: &ndjsonForCSVRecord{decoder: json.NewDecoder(r), index: 0, flag: false},
+	}
+}
+
+type ndjsonForCSVRecord struct {
+	decoder *json.Decoder
+	index   uint
+	flag    bool
+}
+
+func (n *ndjsonForCSVRecord) Next() OptionForResultForCSVRecord {
+	if n.flag {
+		return NoneResultForCSVRecord()
+	}
+
+	var value CSVRecord
+	if err := n.decoder.Decode(&value); err != nil {
+		n.flag = true
+
+		if err == io.EOF {
+			return NoneResultForCSVRecord()
+		}
+
+		return SomeResultForCSVRecord(ErrCSVRecord(fmt.Errorf("decoding value %d: %w", n.index, err)))
+	}
+
+	n.index++
+
+	return SomeResultForCSVRecord(OkCSVRecord(value))
+}
+
+func (n *ndjsonForCSVRecord) SizeHint() (uint, OptionForUint) {
+	if n.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+var _ TryIterableForCSVRecord = &ndjsonForCSVRecord{}
+
+// JSONArrayForCSVRecord builds a fallible Iterator decoding the values of the JSON array read from r one at a time.
+// r is not closed. The Iterator stops at the first error.
+func JSONArrayForCSVRecord(r io.Reader) TryIteratorForCSVRecord {
+	return JSONArrayAtForCSVRecord(r, "")
+}
+
+// JSONArrayAtForCSVRecord builds a fallible Iterator decoding the values of a JSON array nested in the document read from r.
+// The array is located by a JSON pointer such as "/data/items", the empty pointer designating the document itself.
+// Members preceding the array are decoded and discarded. r is not closed. The Iterator stops at the first error.
+func JSONArrayAtForCSVRecord(r io.Reader, pointer string) TryIteratorForCSVRecord {
+	return TryIteratorForCSVRecord{
+		iter: &jsonArrayForCSVRecord{decoder: json.NewDecoder(r), pointer: pointer, index: 0, started: false, flag: false},
+	}
+}
+
+type jsonArrayForCSVRecord struct {
+	decoder *json.Decoder
+	pointer string
+	index   uint
+	started bool
+	flag    bool
+}
+
+func (j *jsonArrayForCSVRecord) Next() OptionForResultForCSVRecord {
+	if j.flag {
+		return NoneResultForCSVRecord()
+	}
+
+	if !j.started {
+		j.started = true
+
+		if err := seekJSONArray(j.decoder, j.pointer); err != nil {
+			j.flag = true
+			return SomeResultForCSVRecord(ErrCSVRecord(err))
+		}
+	}
+
+	if !j.decoder.More() {
+		j.flag = true
+
+		if _, err := j.decoder.Token(); err != nil {
+			return SomeResultForCSVRecord(ErrCSVRecord(fmt.Errorf("closing array at %q: %w", j.pointer, err)))
+		}
+
+		return NoneResultForCSVRecord()
+	}
+
+	var value CSVRecord
+	if err := j.decoder.Decode(&value); err != nil {
+		j.flag = true
+		return SomeResultForCSVRecord(ErrCSVRecord(fmt.Errorf("decoding value %d of array at %q: %w", j.index, j.pointer, err)))
+	}
+
+	j.index++
+
+	return SomeResultForCSVRecord(OkCSVRecord(value))
+}
+
+func (j *jsonArrayForCSVRecord) SizeHint() (uint, OptionForUint) {
+	if j.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+var _ TryIterableForCSVRecord = &jsonArrayForCSVRecord{}
@@ -0,0 +1,122 @@
+package iter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNDJSON(t *testing.T) {
+	testCases := map[string][]int{
+		"":            {},
+		"1\n2\n\n3\n": {1, 2, 3},
+		"4\n5":        {4, 5},
+	}
+
+	for input, want := range testCases {
+		got, err := NDJSONForInt(strings.NewReader(input)).TryCollect()
+
+		if !reflect.DeepEqual(got, want) || err != nil {
+			t.Errorf("case: %q; got: (%v, %v); expected: (%v, %v)", input, got, err, want, nil)
+		}
+	}
+}
+
+func TestNDJSONOfRecords(t *testing.T) {
+	input := `{"name": "ada", "age": "36"}
+{"name": "grace", "age": "85"}
+`
+
+	got := NDJSONForCSVRecord(strings.NewReader(input)).Unwrapped().Filter(func(record CSVRecord) bool {
+		return record["name"] == "grace"
+	}).Collect()
+
+	want := []CSVRecord{{"name": "grace", "age": "85"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; expected: %v", got, want)
+	}
+}
+
+func TestNDJSONError(t *testing.T) {
+	iter := NDJSONForString(strings.NewReader("\"a\"\n\"b\"\n3\n\"d\"\n"))
+
+	got, err := iter.TryCollect()
+
+	want := []string{"a", "b"}
+	if !reflect.DeepEqual(got, want) || err == nil || !strings.Contains(err.Error(), "decoding value 2") {
+		t.Errorf("got: (%v, %v); expected: (%v, an error on value 2)", got, err, want)
+	}
+
+	if next := iter.Next(); next.IsSome() {
+		t.Errorf("got: %v; expected: %v", next, NoneResultForString())
+	}
+}
+
+func TestJSONArray(t *testing.T) {
+	testCases := map[string][]int{
+		"[]":           {},
+		"[1, 2, 3]":    {1, 2, 3},
+		" [\n4,\n5\n]": {4, 5},
+	}
+
+	for input, want := range testCases {
+		got, err := JSONArrayForInt(strings.NewReader(input)).TryCollect()
+
+		if !reflect.DeepEqual(got, want) || err != nil {
+			t.Errorf("case: %q; got: (%v, %v); expected: (%v, %v)", input, got, err, want, nil)
+		}
+	}
+}
+
+func TestJSONArrayAt(t *testing.T) {
+	input := `{"meta": {"count": [0]}, "data": {"a/b": [["x"], ["y", "z"]], "items": [1]}}`
+
+	testCases := map[string][]string{
+		"/data/a~1b/1": {"y", "z"},
+		"/data/a~1b/0": {"x"},
+	}
+
+	for pointer, want := range testCases {
+		got, err := JSONArrayAtForString(strings.NewReader(input), pointer).TryCollect()
+
+		if !reflect.DeepEqual(got, want) || err != nil {
+			t.Errorf("case: %q; got: (%v, %v); expected: (%v, %v)", pointer, got, err, want, nil)
+		}
+	}
+}
+
+func TestJSONArrayIsLazy(t *testing.T) {
+	iter := JSONArrayForInt(strings.NewReader("[1, 2, oops"))
+
+	got := []int{iter.Next().Unwrap().Unwrap(), iter.Next().Unwrap().Unwrap()}
+
+	want := []int{1, 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; expected: %v", got, want)
+	}
+
+	if next := iter.Next(); next.IsNone() || next.Unwrap().IsOk() {
+		t.Errorf("got: %v; expected: an error", next)
+	}
+
+	if next := iter.Next(); next.IsSome() {
+		t.Errorf("got: %v; expected: %v", next, NoneResultForInt())
+	}
+}
+
+func TestJSONArrayAtError(t *testing.T) {
+	testCases := map[string]string{
+		"data":        `invalid JSON pointer "data"`,
+		"/missing":    `"missing" not found`,
+		"/meta":       "instead of an array",
+		"/data/items": "",
+	}
+
+	for pointer, want := range testCases {
+		_, err := JSONArrayAtForInt(strings.NewReader(`{"meta": {}, "data": {"items": [1]}}`), pointer).TryCollect()
+
+		if (want == "" && err != nil) || (want != "" && (err == nil || !strings.Contains(err.Error(), want))) {
+			t.Errorf("case: %q; got: %v; expected: %q", pointer, err, want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package iter
+
+import "sort"
+
+// EntryForStringToInt is a key-value pair of a map.
+type EntryForStringToInt struct {
+	Key   string
+	Value int
+}
+
+// KeysOfStringToInt builds an Iterator yielding the keys of m, in no particular order.
+func KeysOfStringToInt(m map[string]int) IteratorForString {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	return VectorOfString(keys)
+}
+
+// ValuesOfStringToInt builds an Iterator yielding the values of m, in no particular order.
+func ValuesOfStringToInt(m map[string]int) IteratorForInt {
+	values := make([]int, 0, len(m))
+	for _, value := range m {
+		values = append(values, value)
+	}
+
+	return VectorOfInt(values)
+}
+
+// EntriesOfStringToInt builds an Iterator yielding the entries of m, in no particular order.
+func EntriesOfStringToInt(m map[string]int) IteratorForEntryForStringToInt {
+	entries := make([]EntryForStringToInt, 0, len(m))
+	for key, value := range m {
+		entries = append(entries, EntryForStringToInt{Key: key, Value: value})
+	}
+
+	return VectorOfEntryForStringToInt(entries)
+}
+
+// SortedKeysOfStringToInt builds an Iterator yielding the keys of m in the order defined by less.
+// If less is nil, keys of an integer, float or string type are yielded in ascending order, other keys require less.
+func SortedKeysOfStringToInt(m map[string]int, less func(a, b string) bool) IteratorForString {
+	return VectorOfString(sortedKeysOfStringToInt(m, less))
+}
+
+// SortedValuesOfStringToInt builds an Iterator yielding the values of m in the order of their keys defined by less.
+// If less is nil, keys of an integer, float or string type are sorted in ascending order, other keys require less.
+func SortedValuesOfStringToInt(m map[string]int, less func(a, b string) bool) IteratorForInt {
+	keys := sortedKeysOfStringToInt(m, less)
+
+	values := make([]int, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, m[key])
+	}
+
+	return VectorOfInt(values)
+}
+
+// SortedEntriesOfStringToInt builds an Iterator yielding the entries of m in the order of their keys defined by less.
+// If less is nil, keys of an integer, float or string type are sorted in ascending order, other keys require less.
+func SortedEntriesOfStringToInt(m map[string]int, less func(a, b string) bool) IteratorForEntryForStringToInt {
+	keys := sortedKeysOfStringToInt(m, less)
+
+	entries := make([]EntryForStringToInt, 0, len(keys))
+	for _, key := range keys {
+		entries = append(entries, EntryForStringToInt{Key: key, Value: m[key]})
+	}
+
+	return VectorOfEntryForStringToInt(entries)
+}
+
+func sortedKeysOfStringToInt(m map[string]int, less func(a, b string) bool) []string {
+	if less == nil {
+		less = ascendingForString
+	}
+
+	if less == nil {
+		panic("Called a sorted map source without a less function on keys which are not ordered.")
+	}
+
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+
+	return keys
+}
@@ -0,0 +1,86 @@
+package iter
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+var ages = map[string]int{"grace": 85, "ada": 36, "alan": 41}
+
+func TestMapSources(t *testing.T) {
+	keys := KeysOfStringToInt(ages).Collect()
+	sort.Strings(keys)
+
+	values := ValuesOfStringToInt(ages).Collect()
+	sort.Ints(values)
+
+	entries := EntriesOfStringToInt(ages).Collect()
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+
+	got := []interface{}{keys, values, entries}
+	want := []interface{}{
+		[]string{"ada", "alan", "grace"},
+		[]int{36, 41, 85},
+		[]EntryForStringToInt{{Key: "ada", Value: 36}, {Key: "alan", Value: 41}, {Key: "grace", Value: 85}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; expected: %v", got, want)
+	}
+}
+
+func TestSortedMapSources(t *testing.T) {
+	descending := func(a, b string) bool { return a > b }
+
+	testCases := map[string][]interface{}{
+		"ordered": {
+			SortedKeysOfStringToInt(ages, nil).Collect(),
+			SortedValuesOfStringToInt(ages, nil).Collect(),
+			SortedEntriesOfStringToInt(ages, nil).Collect(),
+		},
+		"less": {
+			SortedKeysOfStringToInt(ages, descending).Collect(),
+			SortedValuesOfStringToInt(ages, descending).Collect(),
+			SortedEntriesOfStringToInt(ages, descending).Collect(),
+		},
+	}
+
+	expected := map[string][]interface{}{
+		"ordered": {
+			[]string{"ada", "alan", "grace"},
+			[]int{36, 41, 85},
+			[]EntryForStringToInt{{Key: "ada", Value: 36}, {Key: "alan", Value: 41}, {Key: "grace", Value: 85}},
+		},
+		"less": {
+			[]string{"grace", "alan", "ada"},
+			[]int{85, 41, 36},
+			[]EntryForStringToInt{{Key: "grace", Value: 85}, {Key: "alan", Value: 41}, {Key: "ada", Value: 36}},
+		},
+	}
+
+	for name, got := range testCases {
+		if want := expected[name]; !reflect.DeepEqual(got, want) {
+			t.Errorf("case: %s; got: %v; expected: %v", name, got, want)
+		}
+	}
+}
+
+func TestSortedMapSourcesWithAdapters(t *testing.T) {
+	got := SortedEntriesOfStringToInt(ages, nil).Filter(func(entry EntryForStringToInt) bool {
+		return entry.Value > 40
+	}).Collect()
+
+	want := []EntryForStringToInt{{Key: "alan", Value: 41}, {Key: "grace", Value: 85}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; expected: %v", got, want)
+	}
+}
+
+func TestMapSourcesSizeHint(t *testing.T) {
+	lower, upper := EntriesOfStringToInt(ages).SizeHint()
+
+	if lower != 3 || upper != SomeUint(3) {
+		t.Errorf("got: (%d, %v); expected: (%d, %v)", lower, upper, 3, SomeUint(3))
+	}
+}
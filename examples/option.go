@@ -128,6 +128,750 @@ func (o OptionForString) UnwrapNone() {
 	o.ExpectNone("Called `UnwrapNone` on a `Some` value")
 }
 
+// OptionForEntryForStringToInt can hold an EntryForStringToInt value or not.
+type OptionForEntryForStringToInt struct {
+	value  EntryForStringToInt
+	isNone bool
+}
+
+// SomeEntryForStringToInt returns an Option holding an EntryForStringToInt value.
+func SomeEntryForStringToInt(value EntryForStringToInt) OptionForEntryForStringToInt {
+	return OptionForEntryForStringToInt{value: value, isNone: false}
+}
+
+// NoneEntryForStringToInt returns an Option holding no EntryForStringToInt value.
+func NoneEntryForStringToInt() OptionForEntryForStringToInt {
+	return OptionForEntryForStringToInt{isNone: true}
+}
+
+func (o OptionForEntryForStringToInt) IsSome() bool {
+	return !o.isNone
+}
+
+func (o OptionForEntryForStringToInt) IsNone() bool {
+	return o.isNone
+}
+
+func (o OptionForEntryForStringToInt) Expect(msg string) EntryForStringToInt {
+	if o.isNone {
+		panic(msg)
+	}
+
+	return o.value
+}
+
+func (o OptionForEntryForStringToInt) ExpectNone(msg string) {
+	if !o.isNone {
+		panic(msg)
+	}
+}
+
+func (o OptionForEntryForStringToInt) Unwrap() EntryForStringToInt {
+	return o.Expect("Called `Unwrap` on a `None` Option.")
+}
+
+func (o OptionForEntryForStringToInt) UnwrapOr(defaultValue EntryForStringToInt) EntryForStringToInt {
+	if o.isNone {
+		return defaultValue
+	}
+
+	return o.value
+}
+
+func (o OptionForEntryForStringToInt) UnwrapOrElse(f func() EntryForStringToInt) EntryForStringToInt {
+	if o.isNone {
+		return f()
+	}
+
+	return o.value
+}
+
+func (o OptionForEntryForStringToInt) UnwrapNone() {
+	o.ExpectNone("Called `UnwrapNone` on a `Some` value")
+}
+
+// OptionForSliceOfInt can hold an SliceOfInt value or not.
+type OptionForSliceOfInt struct {
+	value  SliceOfInt
+	isNone bool
+}
+
+// SomeSliceOfInt returns an Option holding an SliceOfInt value.
+func SomeSliceOfInt(value SliceOfInt) OptionForSliceOfInt {
+	return OptionForSliceOfInt{value: value, isNone: false}
+}
+
+// NoneSliceOfInt returns an Option holding no SliceOfInt value.
+func NoneSliceOfInt() OptionForSliceOfInt {
+	return OptionForSliceOfInt{isNone: true}
+}
+
+func (o OptionForSliceOfInt) IsSome() bool {
+	return !o.isNone
+}
+
+func (o OptionForSliceOfInt) IsNone() bool {
+	return o.isNone
+}
+
+func (o OptionForSliceOfInt) Expect(msg string) SliceOfInt {
+	if o.isNone {
+		panic(msg)
+	}
+
+	return o.value
+}
+
+func (o OptionForSliceOfInt) ExpectNone(msg string) {
+	if !o.isNone {
+		panic(msg)
+	}
+}
+
+func (o OptionForSliceOfInt) Unwrap() SliceOfInt {
+	return o.Expect("Called `Unwrap` on a `None` Option.")
+}
+
+func (o OptionForSliceOfInt) UnwrapOr(defaultValue SliceOfInt) SliceOfInt {
+	if o.isNone {
+		return defaultValue
+	}
+
+	return o.value
+}
+
+func (o OptionForSliceOfInt) UnwrapOrElse(f func() SliceOfInt) SliceOfInt {
+	if o.isNone {
+		return f()
+	}
+
+	return o.value
+}
+
+func (o OptionForSliceOfInt) UnwrapNone() {
+	o.ExpectNone("Called `UnwrapNone` on a `Some` value")
+}
+
+// OptionForSliceOfString can hold an SliceOfString value or not.
+type OptionForSliceOfString struct {
+	value  SliceOfString
+	isNone bool
+}
+
+// SomeSliceOfString returns an Option holding an SliceOfString value.
+func SomeSliceOfString(value SliceOfString) OptionForSliceOfString {
+	return OptionForSliceOfString{value: value, isNone: false}
+}
+
+// NoneSliceOfString returns an Option holding no SliceOfString value.
+func NoneSliceOfString() OptionForSliceOfString {
+	return OptionForSliceOfString{isNone: true}
+}
+
+func (o OptionForSliceOfString) IsSome() bool {
+	return !o.isNone
+}
+
+func (o OptionForSliceOfString) IsNone() bool {
+	return o.isNone
+}
+
+func (o OptionForSliceOfString) Expect(msg string) SliceOfString {
+	if o.isNone {
+		panic(msg)
+	}
+
+	return o.value
+}
+
+func (o OptionForSliceOfString) ExpectNone(msg string) {
+	if !o.isNone {
+		panic(msg)
+	}
+}
+
+func (o OptionForSliceOfString) Unwrap() SliceOfString {
+	return o.Expect("Called `Unwrap` on a `None` Option.")
+}
+
+func (o OptionForSliceOfString) UnwrapOr(defaultValue SliceOfString) SliceOfString {
+	if o.isNone {
+		return defaultValue
+	}
+
+	return o.value
+}
+
+func (o OptionForSliceOfString) UnwrapOrElse(f func() SliceOfString) SliceOfString {
+	if o.isNone {
+		return f()
+	}
+
+	return o.value
+}
+
+func (o OptionForSliceOfString) UnwrapNone() {
+	o.ExpectNone("Called `UnwrapNone` on a `Some` value")
+}
+
+// OptionForSliceOfEntryForStringToInt can hold an SliceOfEntryForStringToInt value or not.
+type OptionForSliceOfEntryForStringToInt struct {
+	value  SliceOfEntryForStringToInt
+	isNone bool
+}
+
+// SomeSliceOfEntryForStringToInt returns an Option holding an SliceOfEntryForStringToInt value.
+func SomeSliceOfEntryForStringToInt(value SliceOfEntryForStringToInt) OptionForSliceOfEntryForStringToInt {
+	return OptionForSliceOfEntryForStringToInt{value: value, isNone: false}
+}
+
+// NoneSliceOfEntryForStringToInt returns an Option holding no SliceOfEntryForStringToInt value.
+func NoneSliceOfEntryForStringToInt() OptionForSliceOfEntryForStringToInt {
+	return OptionForSliceOfEntryForStringToInt{isNone: true}
+}
+
+func (o OptionForSliceOfEntryForStringToInt) IsSome() bool {
+	return !o.isNone
+}
+
+func (o OptionForSliceOfEntryForStringToInt) IsNone() bool {
+	return o.isNone
+}
+
+func (o OptionForSliceOfEntryForStringToInt) Expect(msg string) SliceOfEntryForStringToInt {
+	if o.isNone {
+		panic(msg)
+	}
+
+	return o.value
+}
+
+func (o OptionForSliceOfEntryForStringToInt) ExpectNone(msg string) {
+	if !o.isNone {
+		panic(msg)
+	}
+}
+
+func (o OptionForSliceOfEntryForStringToInt) Unwrap() SliceOfEntryForStringToInt {
+	return o.Expect("Called `Unwrap` on a `None` Option.")
+}
+
+func (o OptionForSliceOfEntryForStringToInt) UnwrapOr(defaultValue SliceOfEntryForStringToInt) SliceOfEntryForStringToInt {
+	if o.isNone {
+		return defaultValue
+	}
+
+	return o.value
+}
+
+func (o OptionForSliceOfEntryForStringToInt) UnwrapOrElse(f func() SliceOfEntryForStringToInt) SliceOfEntryForStringToInt {
+	if o.isNone {
+		return f()
+	}
+
+	return o.value
+}
+
+func (o OptionForSliceOfEntryForStringToInt) UnwrapNone() {
+	o.ExpectNone("Called `UnwrapNone` on a `Some` value")
+}
+
+// OptionForCSVRecord can hold an CSVRecord value or not.
+type OptionForCSVRecord struct {
+	value  CSVRecord
+	isNone bool
+}
+
+// SomeCSVRecord returns an Option holding an CSVRecord value.
+func SomeCSVRecord(value CSVRecord) OptionForCSVRecord {
+	return OptionForCSVRecord{value: value, isNone: false}
+}
+
+// NoneCSVRecord returns an Option holding no CSVRecord value.
+func NoneCSVRecord() OptionForCSVRecord {
+	return OptionForCSVRecord{isNone: true}
+}
+
+func (o OptionForCSVRecord) IsSome() bool {
+	return !o.isNone
+}
+
+func (o OptionForCSVRecord) IsNone() bool {
+	return o.isNone
+}
+
+func (o OptionForCSVRecord) Expect(msg string) CSVRecord {
+	if o.isNone {
+		panic(msg)
+	}
+
+	return o.value
+}
+
+func (o OptionForCSVRecord) ExpectNone(msg string) {
+	if !o.isNone {
+		panic(msg)
+	}
+}
+
+func (o OptionForCSVRecord) Unwrap() CSVRecord {
+	return o.Expect("Called `Unwrap` on a `None` Option.")
+}
+
+func (o OptionForCSVRecord) UnwrapOr(defaultValue CSVRecord) CSVRecord {
+	if o.isNone {
+		return defaultValue
+	}
+
+	return o.value
+}
+
+func (o OptionForCSVRecord) UnwrapOrElse(f func() CSVRecord) CSVRecord {
+	if o.isNone {
+		return f()
+	}
+
+	return o.value
+}
+
+func (o OptionForCSVRecord) UnwrapNone() {
+	o.ExpectNone("Called `UnwrapNone` on a `Some` value")
+}
+
+// OptionForResultForInt can hold an ResultForInt value or not.
+type OptionForResultForInt struct {
+	value  ResultForInt
+	isNone bool
+}
+
+// SomeResultForInt returns an Option holding an ResultForInt value.
+func SomeResultForInt(value ResultForInt) OptionForResultForInt {
+	return OptionForResultForInt{value: value, isNone: false}
+}
+
+// NoneResultForInt returns an Option holding no ResultForInt value.
+func NoneResultForInt() OptionForResultForInt {
+	return OptionForResultForInt{isNone: true}
+}
+
+func (o OptionForResultForInt) IsSome() bool {
+	return !o.isNone
+}
+
+func (o OptionForResultForInt) IsNone() bool {
+	return o.isNone
+}
+
+func (o OptionForResultForInt) Expect(msg string) ResultForInt {
+	if o.isNone {
+		panic(msg)
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForInt) ExpectNone(msg string) {
+	if !o.isNone {
+		panic(msg)
+	}
+}
+
+func (o OptionForResultForInt) Unwrap() ResultForInt {
+	return o.Expect("Called `Unwrap` on a `None` Option.")
+}
+
+func (o OptionForResultForInt) UnwrapOr(defaultValue ResultForInt) ResultForInt {
+	if o.isNone {
+		return defaultValue
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForInt) UnwrapOrElse(f func() ResultForInt) ResultForInt {
+	if o.isNone {
+		return f()
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForInt) UnwrapNone() {
+	o.ExpectNone("Called `UnwrapNone` on a `Some` value")
+}
+
+// OptionForResultForString can hold an ResultForString value or not.
+type OptionForResultForString struct {
+	value  ResultForString
+	isNone bool
+}
+
+// SomeResultForString returns an Option holding an ResultForString value.
+func SomeResultForString(value ResultForString) OptionForResultForString {
+	return OptionForResultForString{value: value, isNone: false}
+}
+
+// NoneResultForString returns an Option holding no ResultForString value.
+func NoneResultForString() OptionForResultForString {
+	return OptionForResultForString{isNone: true}
+}
+
+func (o OptionForResultForString) IsSome() bool {
+	return !o.isNone
+}
+
+func (o OptionForResultForString) IsNone() bool {
+	return o.isNone
+}
+
+func (o OptionForResultForString) Expect(msg string) ResultForString {
+	if o.isNone {
+		panic(msg)
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForString) ExpectNone(msg string) {
+	if !o.isNone {
+		panic(msg)
+	}
+}
+
+func (o OptionForResultForString) Unwrap() ResultForString {
+	return o.Expect("Called `Unwrap` on a `None` Option.")
+}
+
+func (o OptionForResultForString) UnwrapOr(defaultValue ResultForString) ResultForString {
+	if o.isNone {
+		return defaultValue
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForString) UnwrapOrElse(f func() ResultForString) ResultForString {
+	if o.isNone {
+		return f()
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForString) UnwrapNone() {
+	o.ExpectNone("Called `UnwrapNone` on a `Some` value")
+}
+
+// OptionForResultForEntryForStringToInt can hold an ResultForEntryForStringToInt value or not.
+type OptionForResultForEntryForStringToInt struct {
+	value  ResultForEntryForStringToInt
+	isNone bool
+}
+
+// SomeResultForEntryForStringToInt returns an Option holding an ResultForEntryForStringToInt value.
+func SomeResultForEntryForStringToInt(value ResultForEntryForStringToInt) OptionForResultForEntryForStringToInt {
+	return OptionForResultForEntryForStringToInt{value: value, isNone: false}
+}
+
+// NoneResultForEntryForStringToInt returns an Option holding no ResultForEntryForStringToInt value.
+func NoneResultForEntryForStringToInt() OptionForResultForEntryForStringToInt {
+	return OptionForResultForEntryForStringToInt{isNone: true}
+}
+
+func (o OptionForResultForEntryForStringToInt) IsSome() bool {
+	return !o.isNone
+}
+
+func (o OptionForResultForEntryForStringToInt) IsNone() bool {
+	return o.isNone
+}
+
+func (o OptionForResultForEntryForStringToInt) Expect(msg string) ResultForEntryForStringToInt {
+	if o.isNone {
+		panic(msg)
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForEntryForStringToInt) ExpectNone(msg string) {
+	if !o.isNone {
+		panic(msg)
+	}
+}
+
+func (o OptionForResultForEntryForStringToInt) Unwrap() ResultForEntryForStringToInt {
+	return o.Expect("Called `Unwrap` on a `None` Option.")
+}
+
+func (o OptionForResultForEntryForStringToInt) UnwrapOr(defaultValue ResultForEntryForStringToInt) ResultForEntryForStringToInt {
+	if o.isNone {
+		return defaultValue
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForEntryForStringToInt) UnwrapOrElse(f func() ResultForEntryForStringToInt) ResultForEntryForStringToInt {
+	if o.isNone {
+		return f()
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForEntryForStringToInt) UnwrapNone() {
+	o.ExpectNone("Called `UnwrapNone` on a `Some` value")
+}
+
+// OptionForResultForSliceOfInt can hold an ResultForSliceOfInt value or not.
+type OptionForResultForSliceOfInt struct {
+	value  ResultForSliceOfInt
+	isNone bool
+}
+
+// SomeResultForSliceOfInt returns an Option holding an ResultForSliceOfInt value.
+func SomeResultForSliceOfInt(value ResultForSliceOfInt) OptionForResultForSliceOfInt {
+	return OptionForResultForSliceOfInt{value: value, isNone: false}
+}
+
+// NoneResultForSliceOfInt returns an Option holding no ResultForSliceOfInt value.
+func NoneResultForSliceOfInt() OptionForResultForSliceOfInt {
+	return OptionForResultForSliceOfInt{isNone: true}
+}
+
+func (o OptionForResultForSliceOfInt) IsSome() bool {
+	return !o.isNone
+}
+
+func (o OptionForResultForSliceOfInt) IsNone() bool {
+	return o.isNone
+}
+
+func (o OptionForResultForSliceOfInt) Expect(msg string) ResultForSliceOfInt {
+	if o.isNone {
+		panic(msg)
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForSliceOfInt) ExpectNone(msg string) {
+	if !o.isNone {
+		panic(msg)
+	}
+}
+
+func (o OptionForResultForSliceOfInt) Unwrap() ResultForSliceOfInt {
+	return o.Expect("Called `Unwrap` on a `None` Option.")
+}
+
+func (o OptionForResultForSliceOfInt) UnwrapOr(defaultValue ResultForSliceOfInt) ResultForSliceOfInt {
+	if o.isNone {
+		return defaultValue
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForSliceOfInt) UnwrapOrElse(f func() ResultForSliceOfInt) ResultForSliceOfInt {
+	if o.isNone {
+		return f()
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForSliceOfInt) UnwrapNone() {
+	o.ExpectNone("Called `UnwrapNone` on a `Some` value")
+}
+
+// OptionForResultForSliceOfString can hold an ResultForSliceOfString value or not.
+type OptionForResultForSliceOfString struct {
+	value  ResultForSliceOfString
+	isNone bool
+}
+
+// SomeResultForSliceOfString returns an Option holding an ResultForSliceOfString value.
+func SomeResultForSliceOfString(value ResultForSliceOfString) OptionForResultForSliceOfString {
+	return OptionForResultForSliceOfString{value: value, isNone: false}
+}
+
+// NoneResultForSliceOfString returns an Option holding no ResultForSliceOfString value.
+func NoneResultForSliceOfString() OptionForResultForSliceOfString {
+	return OptionForResultForSliceOfString{isNone: true}
+}
+
+func (o OptionForResultForSliceOfString) IsSome() bool {
+	return !o.isNone
+}
+
+func (o OptionForResultForSliceOfString) IsNone() bool {
+	return o.isNone
+}
+
+func (o OptionForResultForSliceOfString) Expect(msg string) ResultForSliceOfString {
+	if o.isNone {
+		panic(msg)
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForSliceOfString) ExpectNone(msg string) {
+	if !o.isNone {
+		panic(msg)
+	}
+}
+
+func (o OptionForResultForSliceOfString) Unwrap() ResultForSliceOfString {
+	return o.Expect("Called `Unwrap` on a `None` Option.")
+}
+
+func (o OptionForResultForSliceOfString) UnwrapOr(defaultValue ResultForSliceOfString) ResultForSliceOfString {
+	if o.isNone {
+		return defaultValue
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForSliceOfString) UnwrapOrElse(f func() ResultForSliceOfString) ResultForSliceOfString {
+	if o.isNone {
+		return f()
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForSliceOfString) UnwrapNone() {
+	o.ExpectNone("Called `UnwrapNone` on a `Some` value")
+}
+
+// OptionForResultForSliceOfEntryForStringToInt can hold an ResultForSliceOfEntryForStringToInt value or not.
+type OptionForResultForSliceOfEntryForStringToInt struct {
+	value  ResultForSliceOfEntryForStringToInt
+	isNone bool
+}
+
+// SomeResultForSliceOfEntryForStringToInt returns an Option holding an ResultForSliceOfEntryForStringToInt value.
+func SomeResultForSliceOfEntryForStringToInt(value ResultForSliceOfEntryForStringToInt) OptionForResultForSliceOfEntryForStringToInt {
+	return OptionForResultForSliceOfEntryForStringToInt{value: value, isNone: false}
+}
+
+// NoneResultForSliceOfEntryForStringToInt returns an Option holding no ResultForSliceOfEntryForStringToInt value.
+func NoneResultForSliceOfEntryForStringToInt() OptionForResultForSliceOfEntryForStringToInt {
+	return OptionForResultForSliceOfEntryForStringToInt{isNone: true}
+}
+
+func (o OptionForResultForSliceOfEntryForStringToInt) IsSome() bool {
+	return !o.isNone
+}
+
+func (o OptionForResultForSliceOfEntryForStringToInt) IsNone() bool {
+	return o.isNone
+}
+
+func (o OptionForResultForSliceOfEntryForStringToInt) Expect(msg string) ResultForSliceOfEntryForStringToInt {
+	if o.isNone {
+		panic(msg)
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForSliceOfEntryForStringToInt) ExpectNone(msg string) {
+	if !o.isNone {
+		panic(msg)
+	}
+}
+
+func (o OptionForResultForSliceOfEntryForStringToInt) Unwrap() ResultForSliceOfEntryForStringToInt {
+	return o.Expect("Called `Unwrap` on a `None` Option.")
+}
+
+func (o OptionForResultForSliceOfEntryForStringToInt) UnwrapOr(defaultValue ResultForSliceOfEntryForStringToInt) ResultForSliceOfEntryForStringToInt {
+	if o.isNone {
+		return defaultValue
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForSliceOfEntryForStringToInt) UnwrapOrElse(f func() ResultForSliceOfEntryForStringToInt) ResultForSliceOfEntryForStringToInt {
+	if o.isNone {
+		return f()
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForSliceOfEntryForStringToInt) UnwrapNone() {
+	o.ExpectNone("Called `UnwrapNone` on a `Some` value")
+}
+
+// OptionForResultForCSVRecord can hold an ResultForCSVRecord value or not.
+type OptionForResultForCSVRecord struct {
+	value  ResultForCSVRecord
+	isNone bool
+}
+
+// SomeResultForCSVRecord returns an Option holding an ResultForCSVRecord value.
+func SomeResultForCSVRecord(value ResultForCSVRecord) OptionForResultForCSVRecord {
+	return OptionForResultForCSVRecord{value: value, isNone: false}
+}
+
+// NoneResultForCSVRecord returns an Option holding no ResultForCSVRecord value.
+func NoneResultForCSVRecord() OptionForResultForCSVRecord {
+	return OptionForResultForCSVRecord{isNone: true}
+}
+
+func (o OptionForResultForCSVRecord) IsSome() bool {
+	return !o.isNone
+}
+
+func (o OptionForResultForCSVRecord) IsNone() bool {
+	return o.isNone
+}
+
+func (o OptionForResultForCSVRecord) Expect(msg string) ResultForCSVRecord {
+	if o.isNone {
+		panic(msg)
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForCSVRecord) ExpectNone(msg string) {
+	if !o.isNone {
+		panic(msg)
+	}
+}
+
+func (o OptionForResultForCSVRecord) Unwrap() ResultForCSVRecord {
+	return o.Expect("Called `Unwrap` on a `None` Option.")
+}
+
+func (o OptionForResultForCSVRecord) UnwrapOr(defaultValue ResultForCSVRecord) ResultForCSVRecord {
+	if o.isNone {
+		return defaultValue
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForCSVRecord) UnwrapOrElse(f func() ResultForCSVRecord) ResultForCSVRecord {
+	if o.isNone {
+		return f()
+	}
+
+	return o.value
+}
+
+func (o OptionForResultForCSVRecord) UnwrapNone() {
+	o.ExpectNone("Called `UnwrapNone` on a `Some` value")
+}
+
 // OptionForUint can hold an uint value or not.
 type OptionForUint struct {
 	value  uint
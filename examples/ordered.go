@@ -0,0 +1,10 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package iter
+
+// ascendingForString is the default less function of sorted map sources over string keys.
+var ascendingForString = func(a, b string) bool {
+	return a < b
+}
@@ -0,0 +1,2303 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package iter
+
+import "sync"
+
+// ParMap returns a new Iterator applying a mapper function to every element from a pool of goroutines.
+// Results are yielded in input order unless the Unordered option is given.
+// At most twice as many elements as workers are processed or buffered at once.
+// A panic in the mapper is propagated to the caller of Next.
+func (i IteratorForInt) ParMap(workers int, mapper func(item int) int, options ...ParMapOption) IteratorForInt {
+	if workers < 1 {
+		panic("Called `ParMap` with less than one worker.")
+	}
+
+	config := newParMapConfig(options)
+
+	return IteratorForInt{iter: &parMapForInt{
+		iter:    i.iter,
+		mapper:  mapper,
+		workers: workers,
+		ordered: config.ordered,
+		pending: map[uint]parMapResultForInt{},
+	}}
+}
+
+type parMapResultForInt struct {
+	index    uint
+	value    int
+	panicked interface{}
+}
+
+type parMapForInt struct {
+	iter    IterableForInt
+	mapper  func(item int) int
+	workers int
+	ordered bool
+
+	tokens  chan Empty
+	results chan parMapResultForInt
+	done    chan Empty
+
+	pending map[uint]parMapResultForInt
+	index   uint
+	lower   uint
+	upper   OptionForUint
+	yielded uint
+	started bool
+	stopped bool
+	flag    bool
+}
+
+func (p *parMapForInt) Next() OptionForInt {
+	if p.flag {
+		return NoneInt()
+	}
+
+	if !p.started {
+		p.start()
+	}
+
+	for {
+		if p.ordered {
+			if result, ok := p.pending[p.index]; ok {
+				delete(p.pending, p.index)
+				p.index++
+
+				return p.yield(result)
+			}
+		}
+
+		result, ok := <-p.results
+		if !ok {
+			p.flag = true
+			return NoneInt()
+		}
+
+		if result.panicked != nil {
+			p.flag = true
+			p.stop()
+			panic(result.panicked)
+		}
+
+		if !p.ordered {
+			return p.yield(result)
+		}
+
+		p.pending[result.index] = result
+	}
+}
+
+func (p *parMapForInt) SizeHint() (uint, OptionForUint) {
+	if p.flag {
+		return 0, SomeUint(0)
+	}
+
+	if !p.started {
+		return p.iter.SizeHint()
+	}
+
+	lower := uint(0)
+	if p.lower > p.yielded {
+		lower = p.lower - p.yielded
+	}
+
+	if p.upper.IsNone() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(p.upper.Unwrap() - p.yielded)
+}
+
+func (p *parMapForInt) Err() error {
+	if p.started && !p.flag {
+		return nil
+	}
+
+	return errOf(p.iter)
+}
+
+func (p *parMapForInt) Close() error {
+	p.flag = true
+
+	if p.started {
+		p.stop()
+
+		for range p.results {
+		}
+	}
+
+	return closeOf(p.iter)
+}
+
+func (p *parMapForInt) start() {
+	p.started = true
+	p.lower, p.upper = p.iter.SizeHint()
+
+	p.tokens = make(chan Empty, 2*p.workers)
+	p.results = make(chan parMapResultForInt, 2*p.workers)
+	p.done = make(chan Empty)
+
+	jobs := make(chan parMapResultForInt, p.workers)
+
+	var wg sync.WaitGroup
+	wg.Add(p.workers + 1)
+
+	go p.dispatch(jobs, &wg)
+
+	for k := 0; k < p.workers; k++ {
+		go p.work(jobs, &wg)
+	}
+
+	go func() {
+		wg.Wait()
+		close(p.results)
+	}()
+}
+
+func (p *parMapForInt) dispatch(jobs chan<- parMapResultForInt, wg *sync.WaitGroup) {
+	defer wg.Done()
+	defer close(jobs)
+
+	defer func() {
+		if r := recover(); r != nil {
+			select {
+			case p.results <- parMapResultForInt{panicked: r}:
+			case <-p.done:
+			}
+		}
+	}()
+
+	for index := uint(0); ; index++ {
+		select {
+		case p.tokens <- Empty{}:
+		case <-p.done:
+			return
+		}
+
+		item := p.iter.Next()
+		if item.IsNone() {
+			return
+		}
+
+		select {
+		case jobs <- parMapResultForInt{index: index, value: item.Unwrap()}:
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *parMapForInt) work(jobs <-chan parMapResultForInt, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	for job := range jobs {
+		select {
+		case p.results <- p.apply(job):
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *parMapForInt) apply(job parMapResultForInt) (result parMapResultForInt) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = parMapResultForInt{index: job.index, panicked: r}
+		}
+	}()
+
+	return parMapResultForInt{index: job.index, value: p.mapper(job.value)}
+}
+
+func (p *parMapForInt) yield(result parMapResultForInt) OptionForInt {
+	<-p.tokens
+	p.yielded++
+
+	return SomeInt(result.value)
+}
+
+func (p *parMapForInt) stop() {
+	if !p.stopped {
+		p.stopped = true
+		close(p.done)
+	}
+}
+
+var _ IterableForInt = &parMapForInt{}
+
+// Synchronized returns a new Iterator which can be consumed from several goroutines at once.
+// Its source is closed once, when it is exhausted or on the first call to Close, after which it yields no more elements.
+// Use Share instead if consumers may stop early while others still pull from the source.
+// The Iterator it was built on must not be used directly anymore.
+func (i IteratorForInt) Synchronized() IteratorForInt {
+	return i.Share(1)[0]
+}
+
+// Share returns n Iterators pulling from a Synchronized queue of the Iterator, one for each of n consumers.
+// Each element is yielded by only one of them. The source is closed once, when it is exhausted
+// or when all the n Iterators are closed, so that a consumer stopping early does not close it for the others.
+// The Iterator it was built on must not be used directly anymore.
+func (i IteratorForInt) Share(n uint) []IteratorForInt {
+	queue := &synchronizedQueueForInt{iter: i.iter, open: n, closed: false, err: nil}
+	if n == 0 {
+		queue.close()
+	}
+
+	iterators := make([]IteratorForInt, n)
+	for k := range iterators {
+		iterators[k] = IteratorForInt{iter: &synchronizedForInt{queue: queue, closed: false}}
+	}
+
+	return iterators
+}
+
+type synchronizedQueueForInt struct {
+	mutex  sync.Mutex
+	iter   IterableForInt
+	open   uint
+	closed bool
+	err    error
+}
+
+// close closes the source if it was not already and returns the error it got. The mutex must be held.
+func (q *synchronizedQueueForInt) close() error {
+	if !q.closed {
+		q.closed = true
+		q.err = closeOf(q.iter)
+	}
+
+	return q.err
+}
+
+type synchronizedForInt struct {
+	queue  *synchronizedQueueForInt
+	closed bool
+}
+
+func (s *synchronizedForInt) Next() OptionForInt {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed || s.queue.closed {
+		return NoneInt()
+	}
+
+	item := s.queue.iter.Next()
+	if item.IsNone() {
+		s.queue.close()
+	}
+
+	return item
+}
+
+func (s *synchronizedForInt) SizeHint() (uint, OptionForUint) {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed || s.queue.closed {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := s.queue.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (s *synchronizedForInt) Err() error {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	return errOf(s.queue.iter)
+}
+
+func (s *synchronizedForInt) Close() error {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	s.closed = true
+
+	s.queue.open--
+	if s.queue.open == 0 {
+		return s.queue.close()
+	}
+
+	return nil
+}
+
+var _ IterableForInt = &synchronizedForInt{}
+
+// ParMap returns a new Iterator applying a mapper function to every element from a pool of goroutines.
+// Results are yielded in input order unless the Unordered option is given.
+// At most twice as many elements as workers are processed or buffered at once.
+// A panic in the mapper is propagated to the caller of Next.
+func (i IteratorForString) ParMap(workers int, mapper func(item string) string, options ...ParMapOption) IteratorForString {
+	if workers < 1 {
+		panic("Called `ParMap` with less than one worker.")
+	}
+
+	config := newParMapConfig(options)
+
+	return IteratorForString{iter: &parMapForString{
+		iter:    i.iter,
+		mapper:  mapper,
+		workers: workers,
+		ordered: config.ordered,
+		pending: map[uint]parMapResultForString{},
+	}}
+}
+
+type parMapResultForString struct {
+	index    uint
+	value    string
+	panicked interface{}
+}
+
+type parMapForString struct {
+	iter    IterableForString
+	mapper  func(item string) string
+	workers int
+	ordered bool
+
+	tokens  chan Empty
+	results chan parMapResultForString
+	done    chan Empty
+
+	pending map[uint]parMapResultForString
+	index   uint
+	lower   uint
+	upper   OptionForUint
+	yielded uint
+	started bool
+	stopped bool
+	flag    bool
+}
+
+func (p *parMapForString) Next() OptionForString {
+	if p.flag {
+		return NoneString()
+	}
+
+	if !p.started {
+		p.start()
+	}
+
+	for {
+		if p.ordered {
+			if result, ok := p.pending[p.index]; ok {
+				delete(p.pending, p.index)
+				p.index++
+
+				return p.yield(result)
+			}
+		}
+
+		result, ok := <-p.results
+		if !ok {
+			p.flag = true
+			return NoneString()
+		}
+
+		if result.panicked != nil {
+			p.flag = true
+			p.stop()
+			panic(result.panicked)
+		}
+
+		if !p.ordered {
+			return p.yield(result)
+		}
+
+		p.pending[result.index] = result
+	}
+}
+
+func (p *parMapForString) SizeHint() (uint, OptionForUint) {
+	if p.flag {
+		return 0, SomeUint(0)
+	}
+
+	if !p.started {
+		return p.iter.SizeHint()
+	}
+
+	lower := uint(0)
+	if p.lower > p.yielded {
+		lower = p.lower - p.yielded
+	}
+
+	if p.upper.IsNone() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(p.upper.Unwrap() - p.yielded)
+}
+
+func (p *parMapForString) Err() error {
+	if p.started && !p.flag {
+		return nil
+	}
+
+	return errOf(p.iter)
+}
+
+func (p *parMapForString) Close() error {
+	p.flag = true
+
+	if p.started {
+		p.stop()
+
+		for range p.results {
+		}
+	}
+
+	return closeOf(p.iter)
+}
+
+func (p *parMapForString) start() {
+	p.started = true
+	p.lower, p.upper = p.iter.SizeHint()
+
+	p.tokens = make(chan Empty, 2*p.workers)
+	p.results = make(chan parMapResultForString, 2*p.workers)
+	p.done = make(chan Empty)
+
+	jobs := make(chan parMapResultForString, p.workers)
+
+	var wg sync.WaitGroup
+	wg.Add(p.workers + 1)
+
+	go p.dispatch(jobs, &wg)
+
+	for k := 0; k < p.workers; k++ {
+		go p.work(jobs, &wg)
+	}
+
+	go func() {
+		wg.Wait()
+		close(p.results)
+	}()
+}
+
+func (p *parMapForString) dispatch(jobs chan<- parMapResultForString, wg *sync.WaitGroup) {
+	defer wg.Done()
+	defer close(jobs)
+
+	defer func() {
+		if r := recover(); r != nil {
+			select {
+			case p.results <- parMapResultForString{panicked: r}:
+			case <-p.done:
+			}
+		}
+	}()
+
+	for index := uint(0); ; index++ {
+		select {
+		case p.tokens <- Empty{}:
+		case <-p.done:
+			return
+		}
+
+		item := p.iter.Next()
+		if item.IsNone() {
+			return
+		}
+
+		select {
+		case jobs <- parMapResultForString{index: index, value: item.Unwrap()}:
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *parMapForString) work(jobs <-chan parMapResultForString, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	for job := range jobs {
+		select {
+		case p.results <- p.apply(job):
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *parMapForString) apply(job parMapResultForString) (result parMapResultForString) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = parMapResultForString{index: job.index, panicked: r}
+		}
+	}()
+
+	return parMapResultForString{index: job.index, value: p.mapper(job.value)}
+}
+
+func (p *parMapForString) yield(result parMapResultForString) OptionForString {
+	<-p.tokens
+	p.yielded++
+
+	return SomeString(result.value)
+}
+
+func (p *parMapForString) stop() {
+	if !p.stopped {
+		p.stopped = true
+		close(p.done)
+	}
+}
+
+var _ IterableForString = &parMapForString{}
+
+// Synchronized returns a new Iterator which can be consumed from several goroutines at once.
+// Its source is closed once, when it is exhausted or on the first call to Close, after which it yields no more elements.
+// Use Share instead if consumers may stop early while others still pull from the source.
+// The Iterator it was built on must not be used directly anymore.
+func (i IteratorForString) Synchronized() IteratorForString {
+	return i.Share(1)[0]
+}
+
+// Share returns n Iterators pulling from a Synchronized queue of the Iterator, one for each of n consumers.
+// Each element is yielded by only one of them. The source is closed once, when it is exhausted
+// or when all the n Iterators are closed, so that a consumer stopping early does not close it for the others.
+// The Iterator it was built on must not be used directly anymore.
+func (i IteratorForString) Share(n uint) []IteratorForString {
+	queue := &synchronizedQueueForString{iter: i.iter, open: n, closed: false, err: nil}
+	if n == 0 {
+		queue.close()
+	}
+
+	iterators := make([]IteratorForString, n)
+	for k := range iterators {
+		iterators[k] = IteratorForString{iter: &synchronizedForString{queue: queue, closed: false}}
+	}
+
+	return iterators
+}
+
+type synchronizedQueueForString struct {
+	mutex  sync.Mutex
+	iter   IterableForString
+	open   uint
+	closed bool
+	err    error
+}
+
+// close closes the source if it was not already and returns the error it got. The mutex must be held.
+func (q *synchronizedQueueForString) close() error {
+	if !q.closed {
+		q.closed = true
+		q.err = closeOf(q.iter)
+	}
+
+	return q.err
+}
+
+type synchronizedForString struct {
+	queue  *synchronizedQueueForString
+	closed bool
+}
+
+func (s *synchronizedForString) Next() OptionForString {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed || s.queue.closed {
+		return NoneString()
+	}
+
+	item := s.queue.iter.Next()
+	if item.IsNone() {
+		s.queue.close()
+	}
+
+	return item
+}
+
+func (s *synchronizedForString) SizeHint() (uint, OptionForUint) {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed || s.queue.closed {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := s.queue.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (s *synchronizedForString) Err() error {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	return errOf(s.queue.iter)
+}
+
+func (s *synchronizedForString) Close() error {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	s.closed = true
+
+	s.queue.open--
+	if s.queue.open == 0 {
+		return s.queue.close()
+	}
+
+	return nil
+}
+
+var _ IterableForString = &synchronizedForString{}
+
+// ParMap returns a new Iterator applying a mapper function to every element from a pool of goroutines.
+// Results are yielded in input order unless the Unordered option is given.
+// At most twice as many elements as workers are processed or buffered at once.
+// A panic in the mapper is propagated to the caller of Next.
+func (i IteratorForEntryForStringToInt) ParMap(workers int, mapper func(item EntryForStringToInt) EntryForStringToInt, options ...ParMapOption) IteratorForEntryForStringToInt {
+	if workers < 1 {
+		panic("Called `ParMap` with less than one worker.")
+	}
+
+	config := newParMapConfig(options)
+
+	return IteratorForEntryForStringToInt{iter: &parMapForEntryForStringToInt{
+		iter:    i.iter,
+		mapper:  mapper,
+		workers: workers,
+		ordered: config.ordered,
+		pending: map[uint]parMapResultForEntryForStringToInt{},
+	}}
+}
+
+type parMapResultForEntryForStringToInt struct {
+	index    uint
+	value    EntryForStringToInt
+	panicked interface{}
+}
+
+type parMapForEntryForStringToInt struct {
+	iter    IterableForEntryForStringToInt
+	mapper  func(item EntryForStringToInt) EntryForStringToInt
+	workers int
+	ordered bool
+
+	tokens  chan Empty
+	results chan parMapResultForEntryForStringToInt
+	done    chan Empty
+
+	pending map[uint]parMapResultForEntryForStringToInt
+	index   uint
+	lower   uint
+	upper   OptionForUint
+	yielded uint
+	started bool
+	stopped bool
+	flag    bool
+}
+
+func (p *parMapForEntryForStringToInt) Next() OptionForEntryForStringToInt {
+	if p.flag {
+		return NoneEntryForStringToInt()
+	}
+
+	if !p.started {
+		p.start()
+	}
+
+	for {
+		if p.ordered {
+			if result, ok := p.pending[p.index]; ok {
+				delete(p.pending, p.index)
+				p.index++
+
+				return p.yield(result)
+			}
+		}
+
+		result, ok := <-p.results
+		if !ok {
+			p.flag = true
+			return NoneEntryForStringToInt()
+		}
+
+		if result.panicked != nil {
+			p.flag = true
+			p.stop()
+			panic(result.panicked)
+		}
+
+		if !p.ordered {
+			return p.yield(result)
+		}
+
+		p.pending[result.index] = result
+	}
+}
+
+func (p *parMapForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if p.flag {
+		return 0, SomeUint(0)
+	}
+
+	if !p.started {
+		return p.iter.SizeHint()
+	}
+
+	lower := uint(0)
+	if p.lower > p.yielded {
+		lower = p.lower - p.yielded
+	}
+
+	if p.upper.IsNone() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(p.upper.Unwrap() - p.yielded)
+}
+
+func (p *parMapForEntryForStringToInt) Err() error {
+	if p.started && !p.flag {
+		return nil
+	}
+
+	return errOf(p.iter)
+}
+
+func (p *parMapForEntryForStringToInt) Close() error {
+	p.flag = true
+
+	if p.started {
+		p.stop()
+
+		for range p.results {
+		}
+	}
+
+	return closeOf(p.iter)
+}
+
+func (p *parMapForEntryForStringToInt) start() {
+	p.started = true
+	p.lower, p.upper = p.iter.SizeHint()
+
+	p.tokens = make(chan Empty, 2*p.workers)
+	p.results = make(chan parMapResultForEntryForStringToInt, 2*p.workers)
+	p.done = make(chan Empty)
+
+	jobs := make(chan parMapResultForEntryForStringToInt, p.workers)
+
+	var wg sync.WaitGroup
+	wg.Add(p.workers + 1)
+
+	go p.dispatch(jobs, &wg)
+
+	for k := 0; k < p.workers; k++ {
+		go p.work(jobs, &wg)
+	}
+
+	go func() {
+		wg.Wait()
+		close(p.results)
+	}()
+}
+
+func (p *parMapForEntryForStringToInt) dispatch(jobs chan<- parMapResultForEntryForStringToInt, wg *sync.WaitGroup) {
+	defer wg.Done()
+	defer close(jobs)
+
+	defer func() {
+		if r := recover(); r != nil {
+			select {
+			case p.results <- parMapResultForEntryForStringToInt{panicked: r}:
+			case <-p.done:
+			}
+		}
+	}()
+
+	for index := uint(0); ; index++ {
+		select {
+		case p.tokens <- Empty{}:
+		case <-p.done:
+			return
+		}
+
+		item := p.iter.Next()
+		if item.IsNone() {
+			return
+		}
+
+		select {
+		case jobs <- parMapResultForEntryForStringToInt{index: index, value: item.Unwrap()}:
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *parMapForEntryForStringToInt) work(jobs <-chan parMapResultForEntryForStringToInt, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	for job := range jobs {
+		select {
+		case p.results <- p.apply(job):
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *parMapForEntryForStringToInt) apply(job parMapResultForEntryForStringToInt) (result parMapResultForEntryForStringToInt) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = parMapResultForEntryForStringToInt{index: job.index, panicked: r}
+		}
+	}()
+
+	return parMapResultForEntryForStringToInt{index: job.index, value: p.mapper(job.value)}
+}
+
+func (p *parMapForEntryForStringToInt) yield(result parMapResultForEntryForStringToInt) OptionForEntryForStringToInt {
+	<-p.tokens
+	p.yielded++
+
+	return SomeEntryForStringToInt(result.value)
+}
+
+func (p *parMapForEntryForStringToInt) stop() {
+	if !p.stopped {
+		p.stopped = true
+		close(p.done)
+	}
+}
+
+var _ IterableForEntryForStringToInt = &parMapForEntryForStringToInt{}
+
+// Synchronized returns a new Iterator which can be consumed from several goroutines at once.
+// Its source is closed once, when it is exhausted or on the first call to Close, after which it yields no more elements.
+// Use Share instead if consumers may stop early while others still pull from the source.
+// The Iterator it was built on must not be used directly anymore.
+func (i IteratorForEntryForStringToInt) Synchronized() IteratorForEntryForStringToInt {
+	return i.Share(1)[0]
+}
+
+// Share returns n Iterators pulling from a Synchronized queue of the Iterator, one for each of n consumers.
+// Each element is yielded by only one of them. The source is closed once, when it is exhausted
+// or when all the n Iterators are closed, so that a consumer stopping early does not close it for the others.
+// The Iterator it was built on must not be used directly anymore.
+func (i IteratorForEntryForStringToInt) Share(n uint) []IteratorForEntryForStringToInt {
+	queue := &synchronizedQueueForEntryForStringToInt{iter: i.iter, open: n, closed: false, err: nil}
+	if n == 0 {
+		queue.close()
+	}
+
+	iterators := make([]IteratorForEntryForStringToInt, n)
+	for k := range iterators {
+		iterators[k] = IteratorForEntryForStringToInt{iter: &synchronizedForEntryForStringToInt{queue: queue, closed: false}}
+	}
+
+	return iterators
+}
+
+type synchronizedQueueForEntryForStringToInt struct {
+	mutex  sync.Mutex
+	iter   IterableForEntryForStringToInt
+	open   uint
+	closed bool
+	err    error
+}
+
+// close closes the source if it was not already and returns the error it got. The mutex must be held.
+func (q *synchronizedQueueForEntryForStringToInt) close() error {
+	if !q.closed {
+		q.closed = true
+		q.err = closeOf(q.iter)
+	}
+
+	return q.err
+}
+
+type synchronizedForEntryForStringToInt struct {
+	queue  *synchronizedQueueForEntryForStringToInt
+	closed bool
+}
+
+func (s *synchronizedForEntryForStringToInt) Next() OptionForEntryForStringToInt {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed || s.queue.closed {
+		return NoneEntryForStringToInt()
+	}
+
+	item := s.queue.iter.Next()
+	if item.IsNone() {
+		s.queue.close()
+	}
+
+	return item
+}
+
+func (s *synchronizedForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed || s.queue.closed {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := s.queue.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (s *synchronizedForEntryForStringToInt) Err() error {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	return errOf(s.queue.iter)
+}
+
+func (s *synchronizedForEntryForStringToInt) Close() error {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	s.closed = true
+
+	s.queue.open--
+	if s.queue.open == 0 {
+		return s.queue.close()
+	}
+
+	return nil
+}
+
+var _ IterableForEntryForStringToInt = &synchronizedForEntryForStringToInt{}
+
+// ParMap returns a new Iterator applying a mapper function to every element from a pool of goroutines.
+// Results are yielded in input order unless the Unordered option is given.
+// At most twice as many elements as workers are processed or buffered at once.
+// A panic in the mapper is propagated to the caller of Next.
+func (i IteratorForSliceOfInt) ParMap(workers int, mapper func(item SliceOfInt) SliceOfInt, options ...ParMapOption) IteratorForSliceOfInt {
+	if workers < 1 {
+		panic("Called `ParMap` with less than one worker.")
+	}
+
+	config := newParMapConfig(options)
+
+	return IteratorForSliceOfInt{iter: &parMapForSliceOfInt{
+		iter:    i.iter,
+		mapper:  mapper,
+		workers: workers,
+		ordered: config.ordered,
+		pending: map[uint]parMapResultForSliceOfInt{},
+	}}
+}
+
+type parMapResultForSliceOfInt struct {
+	index    uint
+	value    SliceOfInt
+	panicked interface{}
+}
+
+type parMapForSliceOfInt struct {
+	iter    IterableForSliceOfInt
+	mapper  func(item SliceOfInt) SliceOfInt
+	workers int
+	ordered bool
+
+	tokens  chan Empty
+	results chan parMapResultForSliceOfInt
+	done    chan Empty
+
+	pending map[uint]parMapResultForSliceOfInt
+	index   uint
+	lower   uint
+	upper   OptionForUint
+	yielded uint
+	started bool
+	stopped bool
+	flag    bool
+}
+
+func (p *parMapForSliceOfInt) Next() OptionForSliceOfInt {
+	if p.flag {
+		return NoneSliceOfInt()
+	}
+
+	if !p.started {
+		p.start()
+	}
+
+	for {
+		if p.ordered {
+			if result, ok := p.pending[p.index]; ok {
+				delete(p.pending, p.index)
+				p.index++
+
+				return p.yield(result)
+			}
+		}
+
+		result, ok := <-p.results
+		if !ok {
+			p.flag = true
+			return NoneSliceOfInt()
+		}
+
+		if result.panicked != nil {
+			p.flag = true
+			p.stop()
+			panic(result.panicked)
+		}
+
+		if !p.ordered {
+			return p.yield(result)
+		}
+
+		p.pending[result.index] = result
+	}
+}
+
+func (p *parMapForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	if p.flag {
+		return 0, SomeUint(0)
+	}
+
+	if !p.started {
+		return p.iter.SizeHint()
+	}
+
+	lower := uint(0)
+	if p.lower > p.yielded {
+		lower = p.lower - p.yielded
+	}
+
+	if p.upper.IsNone() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(p.upper.Unwrap() - p.yielded)
+}
+
+func (p *parMapForSliceOfInt) Err() error {
+	if p.started && !p.flag {
+		return nil
+	}
+
+	return errOf(p.iter)
+}
+
+func (p *parMapForSliceOfInt) Close() error {
+	p.flag = true
+
+	if p.started {
+		p.stop()
+
+		for range p.results {
+		}
+	}
+
+	return closeOf(p.iter)
+}
+
+func (p *parMapForSliceOfInt) start() {
+	p.started = true
+	p.lower, p.upper = p.iter.SizeHint()
+
+	p.tokens = make(chan Empty, 2*p.workers)
+	p.results = make(chan parMapResultForSliceOfInt, 2*p.workers)
+	p.done = make(chan Empty)
+
+	jobs := make(chan parMapResultForSliceOfInt, p.workers)
+
+	var wg sync.WaitGroup
+	wg.Add(p.workers + 1)
+
+	go p.dispatch(jobs, &wg)
+
+	for k := 0; k < p.workers; k++ {
+		go p.work(jobs, &wg)
+	}
+
+	go func() {
+		wg.Wait()
+		close(p.results)
+	}()
+}
+
+func (p *parMapForSliceOfInt) dispatch(jobs chan<- parMapResultForSliceOfInt, wg *sync.WaitGroup) {
+	defer wg.Done()
+	defer close(jobs)
+
+	defer func() {
+		if r := recover(); r != nil {
+			select {
+			case p.results <- parMapResultForSliceOfInt{panicked: r}:
+			case <-p.done:
+			}
+		}
+	}()
+
+	for index := uint(0); ; index++ {
+		select {
+		case p.tokens <- Empty{}:
+		case <-p.done:
+			return
+		}
+
+		item := p.iter.Next()
+		if item.IsNone() {
+			return
+		}
+
+		select {
+		case jobs <- parMapResultForSliceOfInt{index: index, value: item.Unwrap()}:
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *parMapForSliceOfInt) work(jobs <-chan parMapResultForSliceOfInt, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	for job := range jobs {
+		select {
+		case p.results <- p.apply(job):
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *parMapForSliceOfInt) apply(job parMapResultForSliceOfInt) (result parMapResultForSliceOfInt) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = parMapResultForSliceOfInt{index: job.index, panicked: r}
+		}
+	}()
+
+	return parMapResultForSliceOfInt{index: job.index, value: p.mapper(job.value)}
+}
+
+func (p *parMapForSliceOfInt) yield(result parMapResultForSliceOfInt) OptionForSliceOfInt {
+	<-p.tokens
+	p.yielded++
+
+	return SomeSliceOfInt(result.value)
+}
+
+func (p *parMapForSliceOfInt) stop() {
+	if !p.stopped {
+		p.stopped = true
+		close(p.done)
+	}
+}
+
+var _ IterableForSliceOfInt = &parMapForSliceOfInt{}
+
+// Synchronized returns a new Iterator which can be consumed from several goroutines at once.
+// Its source is closed once, when it is exhausted or on the first call to Close, after which it yields no more elements.
+// Use Share instead if consumers may stop early while others still pull from the source.
+// The Iterator it was built on must not be used directly anymore.
+func (i IteratorForSliceOfInt) Synchronized() IteratorForSliceOfInt {
+	return i.Share(1)[0]
+}
+
+// Share returns n Iterators pulling from a Synchronized queue of the Iterator, one for each of n consumers.
+// Each element is yielded by only one of them. The source is closed once, when it is exhausted
+// or when all the n Iterators are closed, so that a consumer stopping early does not close it for the others.
+// The Iterator it was built on must not be used directly anymore.
+func (i IteratorForSliceOfInt) Share(n uint) []IteratorForSliceOfInt {
+	queue := &synchronizedQueueForSliceOfInt{iter: i.iter, open: n, closed: false, err: nil}
+	if n == 0 {
+		queue.close()
+	}
+
+	iterators := make([]IteratorForSliceOfInt, n)
+	for k := range iterators {
+		iterators[k] = IteratorForSliceOfInt{iter: &synchronizedForSliceOfInt{queue: queue, closed: false}}
+	}
+
+	return iterators
+}
+
+type synchronizedQueueForSliceOfInt struct {
+	mutex  sync.Mutex
+	iter   IterableForSliceOfInt
+	open   uint
+	closed bool
+	err    error
+}
+
+// close closes the source if it was not already and returns the error it got. The mutex must be held.
+func (q *synchronizedQueueForSliceOfInt) close() error {
+	if !q.closed {
+		q.closed = true
+		q.err = closeOf(q.iter)
+	}
+
+	return q.err
+}
+
+type synchronizedForSliceOfInt struct {
+	queue  *synchronizedQueueForSliceOfInt
+	closed bool
+}
+
+func (s *synchronizedForSliceOfInt) Next() OptionForSliceOfInt {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed || s.queue.closed {
+		return NoneSliceOfInt()
+	}
+
+	item := s.queue.iter.Next()
+	if item.IsNone() {
+		s.queue.close()
+	}
+
+	return item
+}
+
+func (s *synchronizedForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed || s.queue.closed {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := s.queue.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (s *synchronizedForSliceOfInt) Err() error {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	return errOf(s.queue.iter)
+}
+
+func (s *synchronizedForSliceOfInt) Close() error {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	s.closed = true
+
+	s.queue.open--
+	if s.queue.open == 0 {
+		return s.queue.close()
+	}
+
+	return nil
+}
+
+var _ IterableForSliceOfInt = &synchronizedForSliceOfInt{}
+
+// ParMap returns a new Iterator applying a mapper function to every element from a pool of goroutines.
+// Results are yielded in input order unless the Unordered option is given.
+// At most twice as many elements as workers are processed or buffered at once.
+// A panic in the mapper is propagated to the caller of Next.
+func (i IteratorForSliceOfString) ParMap(workers int, mapper func(item SliceOfString) SliceOfString, options ...ParMapOption) IteratorForSliceOfString {
+	if workers < 1 {
+		panic("Called `ParMap` with less than one worker.")
+	}
+
+	config := newParMapConfig(options)
+
+	return IteratorForSliceOfString{iter: &parMapForSliceOfString{
+		iter:    i.iter,
+		mapper:  mapper,
+		workers: workers,
+		ordered: config.ordered,
+		pending: map[uint]parMapResultForSliceOfString{},
+	}}
+}
+
+type parMapResultForSliceOfString struct {
+	index    uint
+	value    SliceOfString
+	panicked interface{}
+}
+
+type parMapForSliceOfString struct {
+	iter    IterableForSliceOfString
+	mapper  func(item SliceOfString) SliceOfString
+	workers int
+	ordered bool
+
+	tokens  chan Empty
+	results chan parMapResultForSliceOfString
+	done    chan Empty
+
+	pending map[uint]parMapResultForSliceOfString
+	index   uint
+	lower   uint
+	upper   OptionForUint
+	yielded uint
+	started bool
+	stopped bool
+	flag    bool
+}
+
+func (p *parMapForSliceOfString) Next() OptionForSliceOfString {
+	if p.flag {
+		return NoneSliceOfString()
+	}
+
+	if !p.started {
+		p.start()
+	}
+
+	for {
+		if p.ordered {
+			if result, ok := p.pending[p.index]; ok {
+				delete(p.pending, p.index)
+				p.index++
+
+				return p.yield(result)
+			}
+		}
+
+		result, ok := <-p.results
+		if !ok {
+			p.flag = true
+			return NoneSliceOfString()
+		}
+
+		if result.panicked != nil {
+			p.flag = true
+			p.stop()
+			panic(result.panicked)
+		}
+
+		if !p.ordered {
+			return p.yield(result)
+		}
+
+		p.pending[result.index] = result
+	}
+}
+
+func (p *parMapForSliceOfString) SizeHint() (uint, OptionForUint) {
+	if p.flag {
+		return 0, SomeUint(0)
+	}
+
+	if !p.started {
+		return p.iter.SizeHint()
+	}
+
+	lower := uint(0)
+	if p.lower > p.yielded {
+		lower = p.lower - p.yielded
+	}
+
+	if p.upper.IsNone() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(p.upper.Unwrap() - p.yielded)
+}
+
+func (p *parMapForSliceOfString) Err() error {
+	if p.started && !p.flag {
+		return nil
+	}
+
+	return errOf(p.iter)
+}
+
+func (p *parMapForSliceOfString) Close() error {
+	p.flag = true
+
+	if p.started {
+		p.stop()
+
+		for range p.results {
+		}
+	}
+
+	return closeOf(p.iter)
+}
+
+func (p *parMapForSliceOfString) start() {
+	p.started = true
+	p.lower, p.upper = p.iter.SizeHint()
+
+	p.tokens = make(chan Empty, 2*p.workers)
+	p.results = make(chan parMapResultForSliceOfString, 2*p.workers)
+	p.done = make(chan Empty)
+
+	jobs := make(chan parMapResultForSliceOfString, p.workers)
+
+	var wg sync.WaitGroup
+	wg.Add(p.workers + 1)
+
+	go p.dispatch(jobs, &wg)
+
+	for k := 0; k < p.workers; k++ {
+		go p.work(jobs, &wg)
+	}
+
+	go func() {
+		wg.Wait()
+		close(p.results)
+	}()
+}
+
+func (p *parMapForSliceOfString) dispatch(jobs chan<- parMapResultForSliceOfString, wg *sync.WaitGroup) {
+	defer wg.Done()
+	defer close(jobs)
+
+	defer func() {
+		if r := recover(); r != nil {
+			select {
+			case p.results <- parMapResultForSliceOfString{panicked: r}:
+			case <-p.done:
+			}
+		}
+	}()
+
+	for index := uint(0); ; index++ {
+		select {
+		case p.tokens <- Empty{}:
+		case <-p.done:
+			return
+		}
+
+		item := p.iter.Next()
+		if item.IsNone() {
+			return
+		}
+
+		select {
+		case jobs <- parMapResultForSliceOfString{index: index, value: item.Unwrap()}:
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *parMapForSliceOfString) work(jobs <-chan parMapResultForSliceOfString, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	for job := range jobs {
+		select {
+		case p.results <- p.apply(job):
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *parMapForSliceOfString) apply(job parMapResultForSliceOfString) (result parMapResultForSliceOfString) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = parMapResultForSliceOfString{index: job.index, panicked: r}
+		}
+	}()
+
+	return parMapResultForSliceOfString{index: job.index, value: p.mapper(job.value)}
+}
+
+func (p *parMapForSliceOfString) yield(result parMapResultForSliceOfString) OptionForSliceOfString {
+	<-p.tokens
+	p.yielded++
+
+	return SomeSliceOfString(result.value)
+}
+
+func (p *parMapForSliceOfString) stop() {
+	if !p.stopped {
+		p.stopped = true
+		close(p.done)
+	}
+}
+
+var _ IterableForSliceOfString = &parMapForSliceOfString{}
+
+// Synchronized returns a new Iterator which can be consumed from several goroutines at once.
+// Its source is closed once, when it is exhausted or on the first call to Close, after which it yields no more elements.
+// Use Share instead if consumers may stop early while others still pull from the source.
+// The Iterator it was built on must not be used directly anymore.
+func (i IteratorForSliceOfString) Synchronized() IteratorForSliceOfString {
+	return i.Share(1)[0]
+}
+
+// Share returns n Iterators pulling from a Synchronized queue of the Iterator, one for each of n consumers.
+// Each element is yielded by only one of them. The source is closed once, when it is exhausted
+// or when all the n Iterators are closed, so that a consumer stopping early does not close it for the others.
+// The Iterator it was built on must not be used directly anymore.
+func (i IteratorForSliceOfString) Share(n uint) []IteratorForSliceOfString {
+	queue := &synchronizedQueueForSliceOfString{iter: i.iter, open: n, closed: false, err: nil}
+	if n == 0 {
+		queue.close()
+	}
+
+	iterators := make([]IteratorForSliceOfString, n)
+	for k := range iterators {
+		iterators[k] = IteratorForSliceOfString{iter: &synchronizedForSliceOfString{queue: queue, closed: false}}
+	}
+
+	return iterators
+}
+
+type synchronizedQueueForSliceOfString struct {
+	mutex  sync.Mutex
+	iter   IterableForSliceOfString
+	open   uint
+	closed bool
+	err    error
+}
+
+// close closes the source if it was not already and returns the error it got. The mutex must be held.
+func (q *synchronizedQueueForSliceOfString) close() error {
+	if !q.closed {
+		q.closed = true
+		q.err = closeOf(q.iter)
+	}
+
+	return q.err
+}
+
+type synchronizedForSliceOfString struct {
+	queue  *synchronizedQueueForSliceOfString
+	closed bool
+}
+
+func (s *synchronizedForSliceOfString) Next() OptionForSliceOfString {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed || s.queue.closed {
+		return NoneSliceOfString()
+	}
+
+	item := s.queue.iter.Next()
+	if item.IsNone() {
+		s.queue.close()
+	}
+
+	return item
+}
+
+func (s *synchronizedForSliceOfString) SizeHint() (uint, OptionForUint) {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed || s.queue.closed {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := s.queue.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (s *synchronizedForSliceOfString) Err() error {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	return errOf(s.queue.iter)
+}
+
+func (s *synchronizedForSliceOfString) Close() error {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	s.closed = true
+
+	s.queue.open--
+	if s.queue.open == 0 {
+		return s.queue.close()
+	}
+
+	return nil
+}
+
+var _ IterableForSliceOfString = &synchronizedForSliceOfString{}
+
+// ParMap returns a new Iterator applying a mapper function to every element from a pool of goroutines.
+// Results are yielded in input order unless the Unordered option is given.
+// At most twice as many elements as workers are processed or buffered at once.
+// A panic in the mapper is propagated to the caller of Next.
+func (i IteratorForSliceOfEntryForStringToInt) ParMap(workers int, mapper func(item SliceOfEntryForStringToInt) SliceOfEntryForStringToInt, options ...ParMapOption) IteratorForSliceOfEntryForStringToInt {
+	if workers < 1 {
+		panic("Called `ParMap` with less than one worker.")
+	}
+
+	config := newParMapConfig(options)
+
+	return IteratorForSliceOfEntryForStringToInt{iter: &parMapForSliceOfEntryForStringToInt{
+		iter:    i.iter,
+		mapper:  mapper,
+		workers: workers,
+		ordered: config.ordered,
+		pending: map[uint]parMapResultForSliceOfEntryForStringToInt{},
+	}}
+}
+
+type parMapResultForSliceOfEntryForStringToInt struct {
+	index    uint
+	value    SliceOfEntryForStringToInt
+	panicked interface{}
+}
+
+type parMapForSliceOfEntryForStringToInt struct {
+	iter    IterableForSliceOfEntryForStringToInt
+	mapper  func(item SliceOfEntryForStringToInt) SliceOfEntryForStringToInt
+	workers int
+	ordered bool
+
+	tokens  chan Empty
+	results chan parMapResultForSliceOfEntryForStringToInt
+	done    chan Empty
+
+	pending map[uint]parMapResultForSliceOfEntryForStringToInt
+	index   uint
+	lower   uint
+	upper   OptionForUint
+	yielded uint
+	started bool
+	stopped bool
+	flag    bool
+}
+
+func (p *parMapForSliceOfEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	if p.flag {
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	if !p.started {
+		p.start()
+	}
+
+	for {
+		if p.ordered {
+			if result, ok := p.pending[p.index]; ok {
+				delete(p.pending, p.index)
+				p.index++
+
+				return p.yield(result)
+			}
+		}
+
+		result, ok := <-p.results
+		if !ok {
+			p.flag = true
+			return NoneSliceOfEntryForStringToInt()
+		}
+
+		if result.panicked != nil {
+			p.flag = true
+			p.stop()
+			panic(result.panicked)
+		}
+
+		if !p.ordered {
+			return p.yield(result)
+		}
+
+		p.pending[result.index] = result
+	}
+}
+
+func (p *parMapForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if p.flag {
+		return 0, SomeUint(0)
+	}
+
+	if !p.started {
+		return p.iter.SizeHint()
+	}
+
+	lower := uint(0)
+	if p.lower > p.yielded {
+		lower = p.lower - p.yielded
+	}
+
+	if p.upper.IsNone() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(p.upper.Unwrap() - p.yielded)
+}
+
+func (p *parMapForSliceOfEntryForStringToInt) Err() error {
+	if p.started && !p.flag {
+		return nil
+	}
+
+	return errOf(p.iter)
+}
+
+func (p *parMapForSliceOfEntryForStringToInt) Close() error {
+	p.flag = true
+
+	if p.started {
+		p.stop()
+
+		for range p.results {
+		}
+	}
+
+	return closeOf(p.iter)
+}
+
+func (p *parMapForSliceOfEntryForStringToInt) start() {
+	p.started = true
+	p.lower, p.upper = p.iter.SizeHint()
+
+	p.tokens = make(chan Empty, 2*p.workers)
+	p.results = make(chan parMapResultForSliceOfEntryForStringToInt, 2*p.workers)
+	p.done = make(chan Empty)
+
+	jobs := make(chan parMapResultForSliceOfEntryForStringToInt, p.workers)
+
+	var wg sync.WaitGroup
+	wg.Add(p.workers + 1)
+
+	go p.dispatch(jobs, &wg)
+
+	for k := 0; k < p.workers; k++ {
+		go p.work(jobs, &wg)
+	}
+
+	go func() {
+		wg.Wait()
+		close(p.results)
+	}()
+}
+
+func (p *parMapForSliceOfEntryForStringToInt) dispatch(jobs chan<- parMapResultForSliceOfEntryForStringToInt, wg *sync.WaitGroup) {
+	defer wg.Done()
+	defer close(jobs)
+
+	defer func() {
+		if r := recover(); r != nil {
+			select {
+			case p.results <- parMapResultForSliceOfEntryForStringToInt{panicked: r}:
+			case <-p.done:
+			}
+		}
+	}()
+
+	for index := uint(0); ; index++ {
+		select {
+		case p.tokens <- Empty{}:
+		case <-p.done:
+			return
+		}
+
+		item := p.iter.Next()
+		if item.IsNone() {
+			return
+		}
+
+		select {
+		case jobs <- parMapResultForSliceOfEntryForStringToInt{index: index, value: item.Unwrap()}:
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *parMapForSliceOfEntryForStringToInt) work(jobs <-chan parMapResultForSliceOfEntryForStringToInt, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	for job := range jobs {
+		select {
+		case p.results <- p.apply(job):
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *parMapForSliceOfEntryForStringToInt) apply(job parMapResultForSliceOfEntryForStringToInt) (result parMapResultForSliceOfEntryForStringToInt) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = parMapResultForSliceOfEntryForStringToInt{index: job.index, panicked: r}
+		}
+	}()
+
+	return parMapResultForSliceOfEntryForStringToInt{index: job.index, value: p.mapper(job.value)}
+}
+
+func (p *parMapForSliceOfEntryForStringToInt) yield(result parMapResultForSliceOfEntryForStringToInt) OptionForSliceOfEntryForStringToInt {
+	<-p.tokens
+	p.yielded++
+
+	return SomeSliceOfEntryForStringToInt(result.value)
+}
+
+func (p *parMapForSliceOfEntryForStringToInt) stop() {
+	if !p.stopped {
+		p.stopped = true
+		close(p.done)
+	}
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &parMapForSliceOfEntryForStringToInt{}
+
+// Synchronized returns a new Iterator which can be consumed from several goroutines at once.
+// Its source is closed once, when it is exhausted or on the first call to Close, after which it yields no more elements.
+// Use Share instead if consumers may stop early while others still pull from the source.
+// The Iterator it was built on must not be used directly anymore.
+func (i IteratorForSliceOfEntryForStringToInt) Synchronized() IteratorForSliceOfEntryForStringToInt {
+	return i.Share(1)[0]
+}
+
+// Share returns n Iterators pulling from a Synchronized queue of the Iterator, one for each of n consumers.
+// Each element is yielded by only one of them. The source is closed once, when it is exhausted
+// or when all the n Iterators are closed, so that a consumer stopping early does not close it for the others.
+// The Iterator it was built on must not be used directly anymore.
+func (i IteratorForSliceOfEntryForStringToInt) Share(n uint) []IteratorForSliceOfEntryForStringToInt {
+	queue := &synchronizedQueueForSliceOfEntryForStringToInt{iter: i.iter, open: n, closed: false, err: nil}
+	if n == 0 {
+		queue.close()
+	}
+
+	iterators := make([]IteratorForSliceOfEntryForStringToInt, n)
+	for k := range iterators {
+		iterators[k] = IteratorForSliceOfEntryForStringToInt{iter: &synchronizedForSliceOfEntryForStringToInt{queue: queue, closed: false}}
+	}
+
+	return iterators
+}
+
+type synchronizedQueueForSliceOfEntryForStringToInt struct {
+	mutex  sync.Mutex
+	iter   IterableForSliceOfEntryForStringToInt
+	open   uint
+	closed bool
+	err    error
+}
+
+// close closes the source if it was not already and returns the error it got. The mutex must be held.
+func (q *synchronizedQueueForSliceOfEntryForStringToInt) close() error {
+	if !q.closed {
+		q.closed = true
+		q.err = closeOf(q.iter)
+	}
+
+	return q.err
+}
+
+type synchronizedForSliceOfEntryForStringToInt struct {
+	queue  *synchronizedQueueForSliceOfEntryForStringToInt
+	closed bool
+}
+
+func (s *synchronizedForSliceOfEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed || s.queue.closed {
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	item := s.queue.iter.Next()
+	if item.IsNone() {
+		s.queue.close()
+	}
+
+	return item
+}
+
+func (s *synchronizedForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed || s.queue.closed {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := s.queue.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (s *synchronizedForSliceOfEntryForStringToInt) Err() error {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	return errOf(s.queue.iter)
+}
+
+func (s *synchronizedForSliceOfEntryForStringToInt) Close() error {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	s.closed = true
+
+	s.queue.open--
+	if s.queue.open == 0 {
+		return s.queue.close()
+	}
+
+	return nil
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &synchronizedForSliceOfEntryForStringToInt{}
+
+// ParMap returns a new Iterator applying a mapper function to every element from a pool of goroutines.
+// Results are yielded in input order unless the Unordered option is given.
+// At most twice as many elements as workers are processed or buffered at once.
+// A panic in the mapper is propagated to the caller of Next.
+func (i IteratorForCSVRecord) ParMap(workers int, mapper func(item CSVRecord) CSVRecord, options ...ParMapOption) IteratorForCSVRecord {
+	if workers < 1 {
+		panic("Called `ParMap` with less than one worker.")
+	}
+
+	config := newParMapConfig(options)
+
+	return IteratorForCSVRecord{iter: &parMapForCSVRecord{
+		iter:    i.iter,
+		mapper:  mapper,
+		workers: workers,
+		ordered: config.ordered,
+		pending: map[uint]parMapResultForCSVRecord{},
+	}}
+}
+
+type parMapResultForCSVRecord struct {
+	index    uint
+	value    CSVRecord
+	panicked interface{}
+}
+
+type parMapForCSVRecord struct {
+	iter    IterableForCSVRecord
+	mapper  func(item CSVRecord) CSVRecord
+	workers int
+	ordered bool
+
+	tokens  chan Empty
+	results chan parMapResultForCSVRecord
+	done    chan Empty
+
+	pending map[uint]parMapResultForCSVRecord
+	index   uint
+	lower   uint
+	upper   OptionForUint
+	yielded uint
+	started bool
+	stopped bool
+	flag    bool
+}
+
+func (p *parMapForCSVRecord) Next() OptionForCSVRecord {
+	if p.flag {
+		return NoneCSVRecord()
+	}
+
+	if !p.started {
+		p.start()
+	}
+
+	for {
+		if p.ordered {
+			if result, ok := p.pending[p.index]; ok {
+				delete(p.pending, p.index)
+				p.index++
+
+				return p.yield(result)
+			}
+		}
+
+		result, ok := <-p.results
+		if !ok {
+			p.flag = true
+			return NoneCSVRecord()
+		}
+
+		if result.panicked != nil {
+			p.flag = true
+			p.stop()
+			panic(result.panicked)
+		}
+
+		if !p.ordered {
+			return p.yield(result)
+		}
+
+		p.pending[result.index] = result
+	}
+}
+
+func (p *parMapForCSVRecord) SizeHint() (uint, OptionForUint) {
+	if p.flag {
+		return 0, SomeUint(0)
+	}
+
+	if !p.started {
+		return p.iter.SizeHint()
+	}
+
+	lower := uint(0)
+	if p.lower > p.yielded {
+		lower = p.lower - p.yielded
+	}
+
+	if p.upper.IsNone() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(p.upper.Unwrap() - p.yielded)
+}
+
+func (p *parMapForCSVRecord) Err() error {
+	if p.started && !p.flag {
+		return nil
+	}
+
+	return errOf(p.iter)
+}
+
+func (p *parMapForCSVRecord) Close() error {
+	p.flag = true
+
+	if p.started {
+		p.stop()
+
+		for range p.results {
+		}
+	}
+
+	return closeOf(p.iter)
+}
+
+func (p *parMapForCSVRecord) start() {
+	p.started = true
+	p.lower, p.upper = p.iter.SizeHint()
+
+	p.tokens = make(chan Empty, 2*p.workers)
+	p.results = make(chan parMapResultForCSVRecord, 2*p.workers)
+	p.done = make(chan Empty)
+
+	jobs := make(chan parMapResultForCSVRecord, p.workers)
+
+	var wg sync.WaitGroup
+	wg.Add(p.workers + 1)
+
+	go p.dispatch(jobs, &wg)
+
+	for k := 0; k < p.workers; k++ {
+		go p.work(jobs, &wg)
+	}
+
+	go func() {
+		wg.Wait()
+		close(p.results)
+	}()
+}
+
+func (p *parMapForCSVRecord) dispatch(jobs chan<- parMapResultForCSVRecord, wg *sync.WaitGroup) {
+	defer wg.Done()
+	defer close(jobs)
+
+	defer func() {
+		if r := recover(); r != nil {
+			select {
+			case p.results <- parMapResultForCSVRecord{panicked: r}:
+			case <-p.done:
+			}
+		}
+	}()
+
+	for index := uint(0); ; index++ {
+		select {
+		case p.tokens <- Empty{}:
+		case <-p.done:
+			return
+		}
+
+		item := p.iter.Next()
+		if item.IsNone() {
+			return
+		}
+
+		select {
+		case jobs <- parMapResultForCSVRecord{index: index, value: item.Unwrap()}:
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *parMapForCSVRecord) work(jobs <-chan parMapResultForCSVRecord, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	for job := range jobs {
+		select {
+		case p.results <- p.apply(job):
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *parMapForCSVRecord) apply(job parMapResultForCSVRecord) (result parMapResultForCSVRecord) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = parMapResultForCSVRecord{index: job.index, panicked: r}
+		}
+	}()
+
+	return parMapResultForCSVRecord{index: job.index, value: p.mapper(job.value)}
+}
+
+func (p *parMapForCSVRecord) yield(result parMapResultForCSVRecord) OptionForCSVRecord {
+	<-p.tokens
+	p.yielded++
+
+	return SomeCSVRecord(result.value)
+}
+
+func (p *parMapForCSVRecord) stop() {
+	if !p.stopped {
+		p.stopped = true
+		close(p.done)
+	}
+}
+
+var _ IterableForCSVRecord = &parMapForCSVRecord{}
+
+// Synchronized returns a new Iterator which can be consumed from several goroutines at once.
+// Its source is closed once, when it is exhausted or on the first call to Close, after which it yields no more elements.
+// Use Share instead if consumers may stop early while others still pull from the source.
+// The Iterator it was built on must not be used directly anymore.
+func (i IteratorForCSVRecord) Synchronized() IteratorForCSVRecord {
+	return i.Share(1)[0]
+}
+
+// Share returns n Iterators pulling from a Synchronized queue of the Iterator, one for each of n consumers.
+// Each element is yielded by only one of them. The source is closed once, when it is exhausted
+// or when all the n Iterators are closed, so that a consumer stopping early does not close it for the others.
+// The Iterator it was built on must not be used directly anymore.
+func (i IteratorForCSVRecord) Share(n uint) []IteratorForCSVRecord {
+	queue := &synchronizedQueueForCSVRecord{iter: i.iter, open: n, closed: false, err: nil}
+	if n == 0 {
+		queue.close()
+	}
+
+	iterators := make([]IteratorForCSVRecord, n)
+	for k := range iterators {
+		iterators[k] = IteratorForCSVRecord{iter: &synchronizedForCSVRecord{queue: queue, closed: false}}
+	}
+
+	return iterators
+}
+
+type synchronizedQueueForCSVRecord struct {
+	mutex  sync.Mutex
+	iter   IterableForCSVRecord
+	open   uint
+	closed bool
+	err    error
+}
+
+// close closes the source if it was not already and returns the error it got. The mutex must be held.
+func (q *synchronizedQueueForCSVRecord) close() error {
+	if !q.closed {
+		q.closed = true
+		q.err = closeOf(q.iter)
+	}
+
+	return q.err
+}
+
+type synchronizedForCSVRecord struct {
+	queue  *synchronizedQueueForCSVRecord
+	closed bool
+}
+
+func (s *synchronizedForCSVRecord) Next() OptionForCSVRecord {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed || s.queue.closed {
+		return NoneCSVRecord()
+	}
+
+	item := s.queue.iter.Next()
+	if item.IsNone() {
+		s.queue.close()
+	}
+
+	return item
+}
+
+func (s *synchronizedForCSVRecord) SizeHint() (uint, OptionForUint) {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed || s.queue.closed {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := s.queue.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (s *synchronizedForCSVRecord) Err() error {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	return errOf(s.queue.iter)
+}
+
+func (s *synchronizedForCSVRecord) Close() error {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	s.closed = true
+
+	s.queue.open--
+	if s.queue.open == 0 {
+		return s.queue.close()
+	}
+
+	return nil
+}
+
+var _ IterableForCSVRecord = &synchronizedForCSVRecord{}
@@ -0,0 +1,278 @@
+package iter
+
+import (
+	"reflect"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func square(item int) int {
+	return item * item
+}
+
+func TestParMap(t *testing.T) {
+	testCases := map[IteratorForInt][]int{
+		Range(0, 0, 1):                  {},
+		Range(0, 1, 1):                  {0},
+		VectorOfInt([]int{0, 1, -2, 3}): {0, 1, 4, 9},
+		Range(0, 10, 1).Filter(isEven):  {0, 4, 16, 36, 64},
+	}
+
+	for iter, want := range testCases {
+		got := iter.ParMap(3, square).Collect()
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("case: %s; got: %v; expected: %v", iter, got, want)
+		}
+	}
+}
+
+func TestParMapPreservesOrder(t *testing.T) {
+	got := Range(0, 100, 1).ParMap(8, func(item int) int {
+		time.Sleep(time.Duration(item%7) * 100 * time.Microsecond)
+		return item
+	}).Collect()
+
+	want := Range(0, 100, 1).Collect()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; expected: %v", got, want)
+	}
+}
+
+func TestParMapUnordered(t *testing.T) {
+	got := Range(0, 100, 1).ParMap(8, square, Unordered()).Collect()
+	sort.Ints(got)
+
+	want := Range(0, 100, 1).Map(square).Collect()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; expected: %v", got, want)
+	}
+}
+
+func TestParMapBackpressure(t *testing.T) {
+	const workers = 2
+
+	var pulled, consumed int64
+	overflow := int64(0)
+
+	source := FromFuncInt(func() OptionForInt {
+		if atomic.AddInt64(&pulled, 1) > atomic.LoadInt64(&consumed)+2*workers+1 {
+			atomic.StoreInt64(&overflow, 1)
+		}
+
+		return SomeInt(1)
+	})
+
+	iter := source.ParMap(workers, square)
+	defer iter.Close()
+
+	for k := 0; k < 50; k++ {
+		iter.Next()
+		atomic.AddInt64(&consumed, 1)
+	}
+
+	if atomic.LoadInt64(&overflow) != 0 {
+		t.Errorf("ParMap pulled more than %d elements ahead of its consumer", 2*workers)
+	}
+}
+
+func TestParMapPanic(t *testing.T) {
+	defer func() {
+		if got := recover(); got != "boom" {
+			t.Errorf("got: %v; expected: %v", got, "boom")
+		}
+	}()
+
+	Range(0, 10, 1).ParMap(4, func(item int) int {
+		if item == 5 {
+			panic("boom")
+		}
+
+		return item
+	}).Collect()
+}
+
+func TestParMapSizeHint(t *testing.T) {
+	iter := Range(0, 10, 1).ParMap(4, square)
+
+	if lower, upper := iter.SizeHint(); lower != 10 || !reflect.DeepEqual(upper, SomeUint(10)) {
+		t.Errorf("got: (%d, %v); expected: (%d, %v)", lower, upper, 10, SomeUint(10))
+	}
+
+	iter.Next()
+
+	if lower, upper := iter.SizeHint(); lower != 9 || !reflect.DeepEqual(upper, SomeUint(9)) {
+		t.Errorf("got: (%d, %v); expected: (%d, %v)", lower, upper, 9, SomeUint(9))
+	}
+}
+
+func TestParFold(t *testing.T) {
+	sum := func(acc, item int) int {
+		return acc + item
+	}
+
+	testCases := map[IteratorForInt]int{
+		Range(0, 0, 1):                     0,
+		Range(0, 4, 1):                     6,
+		Range(0, -5, -2):                   -6,
+		Range(0, 10_000, 3):                16_668_333,
+		VectorOfInt([]int{}):               0,
+		VectorOfInt([]int{0, 1, -2, 3}):    2,
+		Range(0, 10_000, 1).Filter(isEven): 24_995_000,
+	}
+
+	for iter, want := range testCases {
+		got := iter.ParFoldForInt(0, sum, sum, 3)
+
+		if got != want {
+			t.Errorf("case: %s; got: %d; expected: %d", iter, got, want)
+		}
+	}
+}
+
+func TestParFoldPanic(t *testing.T) {
+	testCases := map[string]IteratorForInt{
+		"split":  Range(0, 5_000, 1),
+		"chunks": Range(0, 5_000, 1).Filter(func(item int) bool { return true }),
+	}
+
+	for name, iter := range testCases {
+		func() {
+			defer func() {
+				if got := recover(); got != "boom" {
+					t.Errorf("case: %s; got: %v; expected: %v", name, got, "boom")
+				}
+			}()
+
+			iter.ParFoldForInt(0, func(acc, item int) int {
+				if item == 2_500 {
+					panic("boom")
+				}
+
+				return acc + item
+			}, func(left, right int) int { return left + right }, 4)
+		}()
+	}
+}
+
+func TestParFoldKeepsOrder(t *testing.T) {
+	want := Range(0, 5_000, 1).Collect()
+
+	testCases := []IteratorForInt{
+		Range(0, 5_000, 1),
+		VectorOfInt(want),
+		Range(0, 5_000, 1).Map(func(item int) int { return item }),
+	}
+
+	for _, iter := range testCases {
+		got := iter.ParFoldForSliceOfInt(SliceOfInt{}, func(acc SliceOfInt, item int) SliceOfInt {
+			return append(acc, item)
+		}, func(left, right SliceOfInt) SliceOfInt {
+			return append(left, right...)
+		}, 4)
+
+		if !reflect.DeepEqual([]int(got), want) {
+			t.Errorf("case: %s; got: %d elements out of order; expected: %d elements in order", iter, len(got), len(want))
+		}
+	}
+}
+
+func TestSynchronized(t *testing.T) {
+	const workers = 8
+
+	testCases := map[IteratorForInt][]int{
+		Range(0, 1_000, 1): Range(0, 1_000, 1).Collect(),
+		VectorOfInt(Range(0, 1_000, 1).Collect()).Skip(10): Range(10, 1_000, 1).Collect(),
+		Range(0, 1_000, 1).Filter(isEven).Map(square):      Range(0, 1_000, 2).Map(square).Collect(),
+		Range(0, 10, 1).Cycle().Take(1_000):                Range(0, 10, 1).Cycle().Take(1_000).Collect(),
+	}
+
+	for iter, want := range testCases {
+		shared := iter.Synchronized()
+
+		results := make(chan []int, workers)
+		for k := 0; k < workers; k++ {
+			go func() {
+				got := []int{}
+				shared.ForEach(func(item int) {
+					got = append(got, item)
+				})
+
+				results <- got
+			}()
+		}
+
+		got := []int{}
+		for k := 0; k < workers; k++ {
+			got = append(got, <-results...)
+		}
+
+		sort.Ints(got)
+		sort.Ints(want)
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("case: %s; got: %d elements; expected: %d elements each seen once", iter, len(got), len(want))
+		}
+	}
+}
+
+func TestSynchronizedCount(t *testing.T) {
+	shared := Range(0, 10, 1).Synchronized()
+
+	counts := make(chan uint, 2)
+	for k := 0; k < 2; k++ {
+		go func() {
+			counts <- shared.Count()
+		}()
+	}
+
+	if got := <-counts + <-counts; got != 10 {
+		t.Errorf("got: %d; expected: %d", got, 10)
+	}
+
+	consumers := Range(0, 10, 1).Share(2)
+
+	if got := []uint{consumers[0].Count(), consumers[1].Count()}; !reflect.DeepEqual(got, []uint{10, 0}) {
+		t.Errorf("got: %v; expected: %v", got, []uint{10, 0})
+	}
+}
+
+func TestSynchronizedTake(t *testing.T) {
+	shared := Range(0, 6, 1).Share(2)
+
+	got := [][]int{shared[0].Take(2).Collect(), shared[1].Take(2).Collect()}
+
+	want := [][]int{{0, 1}, {2, 3}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; expected: %v", got, want)
+	}
+}
+
+func TestSynchronizedSizeHint(t *testing.T) {
+	shared := Range(0, 100, 1).Synchronized()
+
+	if lower, upper := shared.SizeHint(); lower != 0 || !reflect.DeepEqual(upper, SomeUint(100)) {
+		t.Errorf("got: (%d, %v); expected: (%d, %v)", lower, upper, 0, SomeUint(100))
+	}
+
+	done := make(chan Empty)
+	for k := 0; k < 4; k++ {
+		go func() {
+			for shared.Next().IsSome() {
+				shared.SizeHint()
+			}
+
+			done <- Empty{}
+		}()
+	}
+
+	for k := 0; k < 4; k++ {
+		<-done
+	}
+
+	if lower, upper := shared.SizeHint(); lower != 0 || !reflect.DeepEqual(upper, SomeUint(0)) || shared.Err() != nil {
+		t.Errorf("got: (%d, %v); expected: (%d, %v)", lower, upper, 0, SomeUint(0))
+	}
+}
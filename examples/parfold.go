@@ -0,0 +1,1967 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package iter
+
+import "sync"
+
+// ParFoldForInt applies a reducer to parts of the Iterator from workers goroutines
+// and merges their results in order with combiner.
+// Each part is folded from init, which must be neutral for combiner, and combiner must be associative.
+// Vector and Range Iterators are split in place, other Iterators are buffered in chunks.
+// A panic in the reducer is propagated to the caller once every worker has stopped.
+func (i IteratorForInt) ParFoldForInt(init int, reducer func(acc int, item int) int, combiner func(left, right int) int, workers int) int {
+	if workers < 1 {
+		panic("Called `ParFold` with less than one worker.")
+	}
+
+	defer i.Close()
+
+	var partials []int
+	var panicked interface{}
+	if s, ok := i.iter.(splittableForInt); ok {
+		partials, panicked = i.parFoldSplitForInt(s.split(uint(workers)), init, reducer)
+	} else {
+		partials, panicked = i.parFoldChunksForInt(init, reducer, workers)
+	}
+
+	if panicked != nil {
+		panic(panicked)
+	}
+
+	if len(partials) == 0 {
+		return init
+	}
+
+	acc := partials[0]
+	for _, partial := range partials[1:] {
+		acc = combiner(acc, partial)
+	}
+
+	return acc
+}
+
+func (i IteratorForInt) parFoldSplitForInt(parts []IterableForInt, init int, reducer func(acc int, item int) int) ([]int, interface{}) {
+	partials := make([]int, len(parts))
+	panics := make([]interface{}, len(parts))
+
+	var wg sync.WaitGroup
+	wg.Add(len(parts))
+
+	for k, part := range parts {
+		go func(k int, part IterableForInt) {
+			defer wg.Done()
+			partials[k], panics[k] = i.parFoldPartForInt(part, init, reducer)
+		}(k, part)
+	}
+
+	wg.Wait()
+
+	for _, panicked := range panics {
+		if panicked != nil {
+			return partials, panicked
+		}
+	}
+
+	return partials, nil
+}
+
+func (i IteratorForInt) parFoldChunksForInt(init int, reducer func(acc int, item int) int, workers int) ([]int, interface{}) {
+	type chunk struct {
+		index uint
+		items []int
+	}
+
+	chunks := make(chan chunk, workers)
+	partials := []int{}
+
+	var panicked interface{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for k := 0; k < workers; k++ {
+		go func() {
+			defer wg.Done()
+
+			for c := range chunks {
+				partial, p := i.parFoldPartForInt(VectorOfInt(c.items).iter, init, reducer)
+
+				mutex.Lock()
+				partials[c.index] = partial
+				if panicked == nil {
+					panicked = p
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for index := uint(0); ; index++ {
+		items := make([]int, 0, parFoldChunkSize)
+		for len(items) < parFoldChunkSize {
+			item := i.Next()
+			if item.IsNone() {
+				break
+			}
+
+			items = append(items, item.Unwrap())
+		}
+
+		if len(items) == 0 {
+			break
+		}
+
+		mutex.Lock()
+		stop := panicked != nil
+		if !stop {
+			partials = append(partials, init)
+		}
+		mutex.Unlock()
+
+		if stop {
+			break
+		}
+
+		chunks <- chunk{index: index, items: items}
+
+		if len(items) < parFoldChunkSize {
+			break
+		}
+	}
+
+	close(chunks)
+	wg.Wait()
+
+	return partials, panicked
+}
+
+// parFoldPartForInt folds a part of the Iterator, recovering a panic of the reducer so that ParFold can propagate it.
+func (i IteratorForInt) parFoldPartForInt(part IterableForInt, init int, reducer func(acc int, item int) int) (partial int, panicked interface{}) {
+	defer func() {
+		panicked = recover()
+	}()
+
+	return IteratorForInt{iter: part}.FoldForInt(init, reducer), nil
+}
+
+// ParFoldForSliceOfInt applies a reducer to parts of the Iterator from workers goroutines
+// and merges their results in order with combiner.
+// Each part is folded from init, which must be neutral for combiner, and combiner must be associative.
+// Vector and Range Iterators are split in place, other Iterators are buffered in chunks.
+// A panic in the reducer is propagated to the caller once every worker has stopped.
+func (i IteratorForInt) ParFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item int) SliceOfInt, combiner func(left, right SliceOfInt) SliceOfInt, workers int) SliceOfInt {
+	if workers < 1 {
+		panic("Called `ParFold` with less than one worker.")
+	}
+
+	defer i.Close()
+
+	var partials []SliceOfInt
+	var panicked interface{}
+	if s, ok := i.iter.(splittableForInt); ok {
+		partials, panicked = i.parFoldSplitForSliceOfInt(s.split(uint(workers)), init, reducer)
+	} else {
+		partials, panicked = i.parFoldChunksForSliceOfInt(init, reducer, workers)
+	}
+
+	if panicked != nil {
+		panic(panicked)
+	}
+
+	if len(partials) == 0 {
+		return init
+	}
+
+	acc := partials[0]
+	for _, partial := range partials[1:] {
+		acc = combiner(acc, partial)
+	}
+
+	return acc
+}
+
+func (i IteratorForInt) parFoldSplitForSliceOfInt(parts []IterableForInt, init SliceOfInt, reducer func(acc SliceOfInt, item int) SliceOfInt) ([]SliceOfInt, interface{}) {
+	partials := make([]SliceOfInt, len(parts))
+	panics := make([]interface{}, len(parts))
+
+	var wg sync.WaitGroup
+	wg.Add(len(parts))
+
+	for k, part := range parts {
+		go func(k int, part IterableForInt) {
+			defer wg.Done()
+			partials[k], panics[k] = i.parFoldPartForSliceOfInt(part, init, reducer)
+		}(k, part)
+	}
+
+	wg.Wait()
+
+	for _, panicked := range panics {
+		if panicked != nil {
+			return partials, panicked
+		}
+	}
+
+	return partials, nil
+}
+
+func (i IteratorForInt) parFoldChunksForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item int) SliceOfInt, workers int) ([]SliceOfInt, interface{}) {
+	type chunk struct {
+		index uint
+		items []int
+	}
+
+	chunks := make(chan chunk, workers)
+	partials := []SliceOfInt{}
+
+	var panicked interface{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for k := 0; k < workers; k++ {
+		go func() {
+			defer wg.Done()
+
+			for c := range chunks {
+				partial, p := i.parFoldPartForSliceOfInt(VectorOfInt(c.items).iter, init, reducer)
+
+				mutex.Lock()
+				partials[c.index] = partial
+				if panicked == nil {
+					panicked = p
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for index := uint(0); ; index++ {
+		items := make([]int, 0, parFoldChunkSize)
+		for len(items) < parFoldChunkSize {
+			item := i.Next()
+			if item.IsNone() {
+				break
+			}
+
+			items = append(items, item.Unwrap())
+		}
+
+		if len(items) == 0 {
+			break
+		}
+
+		mutex.Lock()
+		stop := panicked != nil
+		if !stop {
+			partials = append(partials, init)
+		}
+		mutex.Unlock()
+
+		if stop {
+			break
+		}
+
+		chunks <- chunk{index: index, items: items}
+
+		if len(items) < parFoldChunkSize {
+			break
+		}
+	}
+
+	close(chunks)
+	wg.Wait()
+
+	return partials, panicked
+}
+
+// parFoldPartForSliceOfInt folds a part of the Iterator, recovering a panic of the reducer so that ParFold can propagate it.
+func (i IteratorForInt) parFoldPartForSliceOfInt(part IterableForInt, init SliceOfInt, reducer func(acc SliceOfInt, item int) SliceOfInt) (partial SliceOfInt, panicked interface{}) {
+	defer func() {
+		panicked = recover()
+	}()
+
+	return IteratorForInt{iter: part}.FoldForSliceOfInt(init, reducer), nil
+}
+
+// ParFoldForInt applies a reducer to parts of the Iterator from workers goroutines
+// and merges their results in order with combiner.
+// Each part is folded from init, which must be neutral for combiner, and combiner must be associative.
+// Vector and Range Iterators are split in place, other Iterators are buffered in chunks.
+// A panic in the reducer is propagated to the caller once every worker has stopped.
+func (i IteratorForString) ParFoldForInt(init int, reducer func(acc int, item string) int, combiner func(left, right int) int, workers int) int {
+	if workers < 1 {
+		panic("Called `ParFold` with less than one worker.")
+	}
+
+	defer i.Close()
+
+	var partials []int
+	var panicked interface{}
+	if s, ok := i.iter.(splittableForString); ok {
+		partials, panicked = i.parFoldSplitForInt(s.split(uint(workers)), init, reducer)
+	} else {
+		partials, panicked = i.parFoldChunksForInt(init, reducer, workers)
+	}
+
+	if panicked != nil {
+		panic(panicked)
+	}
+
+	if len(partials) == 0 {
+		return init
+	}
+
+	acc := partials[0]
+	for _, partial := range partials[1:] {
+		acc = combiner(acc, partial)
+	}
+
+	return acc
+}
+
+func (i IteratorForString) parFoldSplitForInt(parts []IterableForString, init int, reducer func(acc int, item string) int) ([]int, interface{}) {
+	partials := make([]int, len(parts))
+	panics := make([]interface{}, len(parts))
+
+	var wg sync.WaitGroup
+	wg.Add(len(parts))
+
+	for k, part := range parts {
+		go func(k int, part IterableForString) {
+			defer wg.Done()
+			partials[k], panics[k] = i.parFoldPartForInt(part, init, reducer)
+		}(k, part)
+	}
+
+	wg.Wait()
+
+	for _, panicked := range panics {
+		if panicked != nil {
+			return partials, panicked
+		}
+	}
+
+	return partials, nil
+}
+
+func (i IteratorForString) parFoldChunksForInt(init int, reducer func(acc int, item string) int, workers int) ([]int, interface{}) {
+	type chunk struct {
+		index uint
+		items []string
+	}
+
+	chunks := make(chan chunk, workers)
+	partials := []int{}
+
+	var panicked interface{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for k := 0; k < workers; k++ {
+		go func() {
+			defer wg.Done()
+
+			for c := range chunks {
+				partial, p := i.parFoldPartForInt(VectorOfString(c.items).iter, init, reducer)
+
+				mutex.Lock()
+				partials[c.index] = partial
+				if panicked == nil {
+					panicked = p
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for index := uint(0); ; index++ {
+		items := make([]string, 0, parFoldChunkSize)
+		for len(items) < parFoldChunkSize {
+			item := i.Next()
+			if item.IsNone() {
+				break
+			}
+
+			items = append(items, item.Unwrap())
+		}
+
+		if len(items) == 0 {
+			break
+		}
+
+		mutex.Lock()
+		stop := panicked != nil
+		if !stop {
+			partials = append(partials, init)
+		}
+		mutex.Unlock()
+
+		if stop {
+			break
+		}
+
+		chunks <- chunk{index: index, items: items}
+
+		if len(items) < parFoldChunkSize {
+			break
+		}
+	}
+
+	close(chunks)
+	wg.Wait()
+
+	return partials, panicked
+}
+
+// parFoldPartForInt folds a part of the Iterator, recovering a panic of the reducer so that ParFold can propagate it.
+func (i IteratorForString) parFoldPartForInt(part IterableForString, init int, reducer func(acc int, item string) int) (partial int, panicked interface{}) {
+	defer func() {
+		panicked = recover()
+	}()
+
+	return IteratorForString{iter: part}.FoldForInt(init, reducer), nil
+}
+
+// ParFoldForSliceOfInt applies a reducer to parts of the Iterator from workers goroutines
+// and merges their results in order with combiner.
+// Each part is folded from init, which must be neutral for combiner, and combiner must be associative.
+// Vector and Range Iterators are split in place, other Iterators are buffered in chunks.
+// A panic in the reducer is propagated to the caller once every worker has stopped.
+func (i IteratorForString) ParFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item string) SliceOfInt, combiner func(left, right SliceOfInt) SliceOfInt, workers int) SliceOfInt {
+	if workers < 1 {
+		panic("Called `ParFold` with less than one worker.")
+	}
+
+	defer i.Close()
+
+	var partials []SliceOfInt
+	var panicked interface{}
+	if s, ok := i.iter.(splittableForString); ok {
+		partials, panicked = i.parFoldSplitForSliceOfInt(s.split(uint(workers)), init, reducer)
+	} else {
+		partials, panicked = i.parFoldChunksForSliceOfInt(init, reducer, workers)
+	}
+
+	if panicked != nil {
+		panic(panicked)
+	}
+
+	if len(partials) == 0 {
+		return init
+	}
+
+	acc := partials[0]
+	for _, partial := range partials[1:] {
+		acc = combiner(acc, partial)
+	}
+
+	return acc
+}
+
+func (i IteratorForString) parFoldSplitForSliceOfInt(parts []IterableForString, init SliceOfInt, reducer func(acc SliceOfInt, item string) SliceOfInt) ([]SliceOfInt, interface{}) {
+	partials := make([]SliceOfInt, len(parts))
+	panics := make([]interface{}, len(parts))
+
+	var wg sync.WaitGroup
+	wg.Add(len(parts))
+
+	for k, part := range parts {
+		go func(k int, part IterableForString) {
+			defer wg.Done()
+			partials[k], panics[k] = i.parFoldPartForSliceOfInt(part, init, reducer)
+		}(k, part)
+	}
+
+	wg.Wait()
+
+	for _, panicked := range panics {
+		if panicked != nil {
+			return partials, panicked
+		}
+	}
+
+	return partials, nil
+}
+
+func (i IteratorForString) parFoldChunksForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item string) SliceOfInt, workers int) ([]SliceOfInt, interface{}) {
+	type chunk struct {
+		index uint
+		items []string
+	}
+
+	chunks := make(chan chunk, workers)
+	partials := []SliceOfInt{}
+
+	var panicked interface{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for k := 0; k < workers; k++ {
+		go func() {
+			defer wg.Done()
+
+			for c := range chunks {
+				partial, p := i.parFoldPartForSliceOfInt(VectorOfString(c.items).iter, init, reducer)
+
+				mutex.Lock()
+				partials[c.index] = partial
+				if panicked == nil {
+					panicked = p
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for index := uint(0); ; index++ {
+		items := make([]string, 0, parFoldChunkSize)
+		for len(items) < parFoldChunkSize {
+			item := i.Next()
+			if item.IsNone() {
+				break
+			}
+
+			items = append(items, item.Unwrap())
+		}
+
+		if len(items) == 0 {
+			break
+		}
+
+		mutex.Lock()
+		stop := panicked != nil
+		if !stop {
+			partials = append(partials, init)
+		}
+		mutex.Unlock()
+
+		if stop {
+			break
+		}
+
+		chunks <- chunk{index: index, items: items}
+
+		if len(items) < parFoldChunkSize {
+			break
+		}
+	}
+
+	close(chunks)
+	wg.Wait()
+
+	return partials, panicked
+}
+
+// parFoldPartForSliceOfInt folds a part of the Iterator, recovering a panic of the reducer so that ParFold can propagate it.
+func (i IteratorForString) parFoldPartForSliceOfInt(part IterableForString, init SliceOfInt, reducer func(acc SliceOfInt, item string) SliceOfInt) (partial SliceOfInt, panicked interface{}) {
+	defer func() {
+		panicked = recover()
+	}()
+
+	return IteratorForString{iter: part}.FoldForSliceOfInt(init, reducer), nil
+}
+
+// ParFoldForInt applies a reducer to parts of the Iterator from workers goroutines
+// and merges their results in order with combiner.
+// Each part is folded from init, which must be neutral for combiner, and combiner must be associative.
+// Vector and Range Iterators are split in place, other Iterators are buffered in chunks.
+// A panic in the reducer is propagated to the caller once every worker has stopped.
+func (i IteratorForEntryForStringToInt) ParFoldForInt(init int, reducer func(acc int, item EntryForStringToInt) int, combiner func(left, right int) int, workers int) int {
+	if workers < 1 {
+		panic("Called `ParFold` with less than one worker.")
+	}
+
+	defer i.Close()
+
+	var partials []int
+	var panicked interface{}
+	if s, ok := i.iter.(splittableForEntryForStringToInt); ok {
+		partials, panicked = i.parFoldSplitForInt(s.split(uint(workers)), init, reducer)
+	} else {
+		partials, panicked = i.parFoldChunksForInt(init, reducer, workers)
+	}
+
+	if panicked != nil {
+		panic(panicked)
+	}
+
+	if len(partials) == 0 {
+		return init
+	}
+
+	acc := partials[0]
+	for _, partial := range partials[1:] {
+		acc = combiner(acc, partial)
+	}
+
+	return acc
+}
+
+func (i IteratorForEntryForStringToInt) parFoldSplitForInt(parts []IterableForEntryForStringToInt, init int, reducer func(acc int, item EntryForStringToInt) int) ([]int, interface{}) {
+	partials := make([]int, len(parts))
+	panics := make([]interface{}, len(parts))
+
+	var wg sync.WaitGroup
+	wg.Add(len(parts))
+
+	for k, part := range parts {
+		go func(k int, part IterableForEntryForStringToInt) {
+			defer wg.Done()
+			partials[k], panics[k] = i.parFoldPartForInt(part, init, reducer)
+		}(k, part)
+	}
+
+	wg.Wait()
+
+	for _, panicked := range panics {
+		if panicked != nil {
+			return partials, panicked
+		}
+	}
+
+	return partials, nil
+}
+
+func (i IteratorForEntryForStringToInt) parFoldChunksForInt(init int, reducer func(acc int, item EntryForStringToInt) int, workers int) ([]int, interface{}) {
+	type chunk struct {
+		index uint
+		items []EntryForStringToInt
+	}
+
+	chunks := make(chan chunk, workers)
+	partials := []int{}
+
+	var panicked interface{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for k := 0; k < workers; k++ {
+		go func() {
+			defer wg.Done()
+
+			for c := range chunks {
+				partial, p := i.parFoldPartForInt(VectorOfEntryForStringToInt(c.items).iter, init, reducer)
+
+				mutex.Lock()
+				partials[c.index] = partial
+				if panicked == nil {
+					panicked = p
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for index := uint(0); ; index++ {
+		items := make([]EntryForStringToInt, 0, parFoldChunkSize)
+		for len(items) < parFoldChunkSize {
+			item := i.Next()
+			if item.IsNone() {
+				break
+			}
+
+			items = append(items, item.Unwrap())
+		}
+
+		if len(items) == 0 {
+			break
+		}
+
+		mutex.Lock()
+		stop := panicked != nil
+		if !stop {
+			partials = append(partials, init)
+		}
+		mutex.Unlock()
+
+		if stop {
+			break
+		}
+
+		chunks <- chunk{index: index, items: items}
+
+		if len(items) < parFoldChunkSize {
+			break
+		}
+	}
+
+	close(chunks)
+	wg.Wait()
+
+	return partials, panicked
+}
+
+// parFoldPartForInt folds a part of the Iterator, recovering a panic of the reducer so that ParFold can propagate it.
+func (i IteratorForEntryForStringToInt) parFoldPartForInt(part IterableForEntryForStringToInt, init int, reducer func(acc int, item EntryForStringToInt) int) (partial int, panicked interface{}) {
+	defer func() {
+		panicked = recover()
+	}()
+
+	return IteratorForEntryForStringToInt{iter: part}.FoldForInt(init, reducer), nil
+}
+
+// ParFoldForSliceOfInt applies a reducer to parts of the Iterator from workers goroutines
+// and merges their results in order with combiner.
+// Each part is folded from init, which must be neutral for combiner, and combiner must be associative.
+// Vector and Range Iterators are split in place, other Iterators are buffered in chunks.
+// A panic in the reducer is propagated to the caller once every worker has stopped.
+func (i IteratorForEntryForStringToInt) ParFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item EntryForStringToInt) SliceOfInt, combiner func(left, right SliceOfInt) SliceOfInt, workers int) SliceOfInt {
+	if workers < 1 {
+		panic("Called `ParFold` with less than one worker.")
+	}
+
+	defer i.Close()
+
+	var partials []SliceOfInt
+	var panicked interface{}
+	if s, ok := i.iter.(splittableForEntryForStringToInt); ok {
+		partials, panicked = i.parFoldSplitForSliceOfInt(s.split(uint(workers)), init, reducer)
+	} else {
+		partials, panicked = i.parFoldChunksForSliceOfInt(init, reducer, workers)
+	}
+
+	if panicked != nil {
+		panic(panicked)
+	}
+
+	if len(partials) == 0 {
+		return init
+	}
+
+	acc := partials[0]
+	for _, partial := range partials[1:] {
+		acc = combiner(acc, partial)
+	}
+
+	return acc
+}
+
+func (i IteratorForEntryForStringToInt) parFoldSplitForSliceOfInt(parts []IterableForEntryForStringToInt, init SliceOfInt, reducer func(acc SliceOfInt, item EntryForStringToInt) SliceOfInt) ([]SliceOfInt, interface{}) {
+	partials := make([]SliceOfInt, len(parts))
+	panics := make([]interface{}, len(parts))
+
+	var wg sync.WaitGroup
+	wg.Add(len(parts))
+
+	for k, part := range parts {
+		go func(k int, part IterableForEntryForStringToInt) {
+			defer wg.Done()
+			partials[k], panics[k] = i.parFoldPartForSliceOfInt(part, init, reducer)
+		}(k, part)
+	}
+
+	wg.Wait()
+
+	for _, panicked := range panics {
+		if panicked != nil {
+			return partials, panicked
+		}
+	}
+
+	return partials, nil
+}
+
+func (i IteratorForEntryForStringToInt) parFoldChunksForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item EntryForStringToInt) SliceOfInt, workers int) ([]SliceOfInt, interface{}) {
+	type chunk struct {
+		index uint
+		items []EntryForStringToInt
+	}
+
+	chunks := make(chan chunk, workers)
+	partials := []SliceOfInt{}
+
+	var panicked interface{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for k := 0; k < workers; k++ {
+		go func() {
+			defer wg.Done()
+
+			for c := range chunks {
+				partial, p := i.parFoldPartForSliceOfInt(VectorOfEntryForStringToInt(c.items).iter, init, reducer)
+
+				mutex.Lock()
+				partials[c.index] = partial
+				if panicked == nil {
+					panicked = p
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for index := uint(0); ; index++ {
+		items := make([]EntryForStringToInt, 0, parFoldChunkSize)
+		for len(items) < parFoldChunkSize {
+			item := i.Next()
+			if item.IsNone() {
+				break
+			}
+
+			items = append(items, item.Unwrap())
+		}
+
+		if len(items) == 0 {
+			break
+		}
+
+		mutex.Lock()
+		stop := panicked != nil
+		if !stop {
+			partials = append(partials, init)
+		}
+		mutex.Unlock()
+
+		if stop {
+			break
+		}
+
+		chunks <- chunk{index: index, items: items}
+
+		if len(items) < parFoldChunkSize {
+			break
+		}
+	}
+
+	close(chunks)
+	wg.Wait()
+
+	return partials, panicked
+}
+
+// parFoldPartForSliceOfInt folds a part of the Iterator, recovering a panic of the reducer so that ParFold can propagate it.
+func (i IteratorForEntryForStringToInt) parFoldPartForSliceOfInt(part IterableForEntryForStringToInt, init SliceOfInt, reducer func(acc SliceOfInt, item EntryForStringToInt) SliceOfInt) (partial SliceOfInt, panicked interface{}) {
+	defer func() {
+		panicked = recover()
+	}()
+
+	return IteratorForEntryForStringToInt{iter: part}.FoldForSliceOfInt(init, reducer), nil
+}
+
+// ParFoldForInt applies a reducer to parts of the Iterator from workers goroutines
+// and merges their results in order with combiner.
+// Each part is folded from init, which must be neutral for combiner, and combiner must be associative.
+// Vector and Range Iterators are split in place, other Iterators are buffered in chunks.
+// A panic in the reducer is propagated to the caller once every worker has stopped.
+func (i IteratorForSliceOfInt) ParFoldForInt(init int, reducer func(acc int, item SliceOfInt) int, combiner func(left, right int) int, workers int) int {
+	if workers < 1 {
+		panic("Called `ParFold` with less than one worker.")
+	}
+
+	defer i.Close()
+
+	var partials []int
+	var panicked interface{}
+	if s, ok := i.iter.(splittableForSliceOfInt); ok {
+		partials, panicked = i.parFoldSplitForInt(s.split(uint(workers)), init, reducer)
+	} else {
+		partials, panicked = i.parFoldChunksForInt(init, reducer, workers)
+	}
+
+	if panicked != nil {
+		panic(panicked)
+	}
+
+	if len(partials) == 0 {
+		return init
+	}
+
+	acc := partials[0]
+	for _, partial := range partials[1:] {
+		acc = combiner(acc, partial)
+	}
+
+	return acc
+}
+
+func (i IteratorForSliceOfInt) parFoldSplitForInt(parts []IterableForSliceOfInt, init int, reducer func(acc int, item SliceOfInt) int) ([]int, interface{}) {
+	partials := make([]int, len(parts))
+	panics := make([]interface{}, len(parts))
+
+	var wg sync.WaitGroup
+	wg.Add(len(parts))
+
+	for k, part := range parts {
+		go func(k int, part IterableForSliceOfInt) {
+			defer wg.Done()
+			partials[k], panics[k] = i.parFoldPartForInt(part, init, reducer)
+		}(k, part)
+	}
+
+	wg.Wait()
+
+	for _, panicked := range panics {
+		if panicked != nil {
+			return partials, panicked
+		}
+	}
+
+	return partials, nil
+}
+
+func (i IteratorForSliceOfInt) parFoldChunksForInt(init int, reducer func(acc int, item SliceOfInt) int, workers int) ([]int, interface{}) {
+	type chunk struct {
+		index uint
+		items []SliceOfInt
+	}
+
+	chunks := make(chan chunk, workers)
+	partials := []int{}
+
+	var panicked interface{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for k := 0; k < workers; k++ {
+		go func() {
+			defer wg.Done()
+
+			for c := range chunks {
+				partial, p := i.parFoldPartForInt(VectorOfSliceOfInt(c.items).iter, init, reducer)
+
+				mutex.Lock()
+				partials[c.index] = partial
+				if panicked == nil {
+					panicked = p
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for index := uint(0); ; index++ {
+		items := make([]SliceOfInt, 0, parFoldChunkSize)
+		for len(items) < parFoldChunkSize {
+			item := i.Next()
+			if item.IsNone() {
+				break
+			}
+
+			items = append(items, item.Unwrap())
+		}
+
+		if len(items) == 0 {
+			break
+		}
+
+		mutex.Lock()
+		stop := panicked != nil
+		if !stop {
+			partials = append(partials, init)
+		}
+		mutex.Unlock()
+
+		if stop {
+			break
+		}
+
+		chunks <- chunk{index: index, items: items}
+
+		if len(items) < parFoldChunkSize {
+			break
+		}
+	}
+
+	close(chunks)
+	wg.Wait()
+
+	return partials, panicked
+}
+
+// parFoldPartForInt folds a part of the Iterator, recovering a panic of the reducer so that ParFold can propagate it.
+func (i IteratorForSliceOfInt) parFoldPartForInt(part IterableForSliceOfInt, init int, reducer func(acc int, item SliceOfInt) int) (partial int, panicked interface{}) {
+	defer func() {
+		panicked = recover()
+	}()
+
+	return IteratorForSliceOfInt{iter: part}.FoldForInt(init, reducer), nil
+}
+
+// ParFoldForSliceOfInt applies a reducer to parts of the Iterator from workers goroutines
+// and merges their results in order with combiner.
+// Each part is folded from init, which must be neutral for combiner, and combiner must be associative.
+// Vector and Range Iterators are split in place, other Iterators are buffered in chunks.
+// A panic in the reducer is propagated to the caller once every worker has stopped.
+func (i IteratorForSliceOfInt) ParFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfInt) SliceOfInt, combiner func(left, right SliceOfInt) SliceOfInt, workers int) SliceOfInt {
+	if workers < 1 {
+		panic("Called `ParFold` with less than one worker.")
+	}
+
+	defer i.Close()
+
+	var partials []SliceOfInt
+	var panicked interface{}
+	if s, ok := i.iter.(splittableForSliceOfInt); ok {
+		partials, panicked = i.parFoldSplitForSliceOfInt(s.split(uint(workers)), init, reducer)
+	} else {
+		partials, panicked = i.parFoldChunksForSliceOfInt(init, reducer, workers)
+	}
+
+	if panicked != nil {
+		panic(panicked)
+	}
+
+	if len(partials) == 0 {
+		return init
+	}
+
+	acc := partials[0]
+	for _, partial := range partials[1:] {
+		acc = combiner(acc, partial)
+	}
+
+	return acc
+}
+
+func (i IteratorForSliceOfInt) parFoldSplitForSliceOfInt(parts []IterableForSliceOfInt, init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfInt) SliceOfInt) ([]SliceOfInt, interface{}) {
+	partials := make([]SliceOfInt, len(parts))
+	panics := make([]interface{}, len(parts))
+
+	var wg sync.WaitGroup
+	wg.Add(len(parts))
+
+	for k, part := range parts {
+		go func(k int, part IterableForSliceOfInt) {
+			defer wg.Done()
+			partials[k], panics[k] = i.parFoldPartForSliceOfInt(part, init, reducer)
+		}(k, part)
+	}
+
+	wg.Wait()
+
+	for _, panicked := range panics {
+		if panicked != nil {
+			return partials, panicked
+		}
+	}
+
+	return partials, nil
+}
+
+func (i IteratorForSliceOfInt) parFoldChunksForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfInt) SliceOfInt, workers int) ([]SliceOfInt, interface{}) {
+	type chunk struct {
+		index uint
+		items []SliceOfInt
+	}
+
+	chunks := make(chan chunk, workers)
+	partials := []SliceOfInt{}
+
+	var panicked interface{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for k := 0; k < workers; k++ {
+		go func() {
+			defer wg.Done()
+
+			for c := range chunks {
+				partial, p := i.parFoldPartForSliceOfInt(VectorOfSliceOfInt(c.items).iter, init, reducer)
+
+				mutex.Lock()
+				partials[c.index] = partial
+				if panicked == nil {
+					panicked = p
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for index := uint(0); ; index++ {
+		items := make([]SliceOfInt, 0, parFoldChunkSize)
+		for len(items) < parFoldChunkSize {
+			item := i.Next()
+			if item.IsNone() {
+				break
+			}
+
+			items = append(items, item.Unwrap())
+		}
+
+		if len(items) == 0 {
+			break
+		}
+
+		mutex.Lock()
+		stop := panicked != nil
+		if !stop {
+			partials = append(partials, init)
+		}
+		mutex.Unlock()
+
+		if stop {
+			break
+		}
+
+		chunks <- chunk{index: index, items: items}
+
+		if len(items) < parFoldChunkSize {
+			break
+		}
+	}
+
+	close(chunks)
+	wg.Wait()
+
+	return partials, panicked
+}
+
+// parFoldPartForSliceOfInt folds a part of the Iterator, recovering a panic of the reducer so that ParFold can propagate it.
+func (i IteratorForSliceOfInt) parFoldPartForSliceOfInt(part IterableForSliceOfInt, init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfInt) SliceOfInt) (partial SliceOfInt, panicked interface{}) {
+	defer func() {
+		panicked = recover()
+	}()
+
+	return IteratorForSliceOfInt{iter: part}.FoldForSliceOfInt(init, reducer), nil
+}
+
+// ParFoldForInt applies a reducer to parts of the Iterator from workers goroutines
+// and merges their results in order with combiner.
+// Each part is folded from init, which must be neutral for combiner, and combiner must be associative.
+// Vector and Range Iterators are split in place, other Iterators are buffered in chunks.
+// A panic in the reducer is propagated to the caller once every worker has stopped.
+func (i IteratorForSliceOfString) ParFoldForInt(init int, reducer func(acc int, item SliceOfString) int, combiner func(left, right int) int, workers int) int {
+	if workers < 1 {
+		panic("Called `ParFold` with less than one worker.")
+	}
+
+	defer i.Close()
+
+	var partials []int
+	var panicked interface{}
+	if s, ok := i.iter.(splittableForSliceOfString); ok {
+		partials, panicked = i.parFoldSplitForInt(s.split(uint(workers)), init, reducer)
+	} else {
+		partials, panicked = i.parFoldChunksForInt(init, reducer, workers)
+	}
+
+	if panicked != nil {
+		panic(panicked)
+	}
+
+	if len(partials) == 0 {
+		return init
+	}
+
+	acc := partials[0]
+	for _, partial := range partials[1:] {
+		acc = combiner(acc, partial)
+	}
+
+	return acc
+}
+
+func (i IteratorForSliceOfString) parFoldSplitForInt(parts []IterableForSliceOfString, init int, reducer func(acc int, item SliceOfString) int) ([]int, interface{}) {
+	partials := make([]int, len(parts))
+	panics := make([]interface{}, len(parts))
+
+	var wg sync.WaitGroup
+	wg.Add(len(parts))
+
+	for k, part := range parts {
+		go func(k int, part IterableForSliceOfString) {
+			defer wg.Done()
+			partials[k], panics[k] = i.parFoldPartForInt(part, init, reducer)
+		}(k, part)
+	}
+
+	wg.Wait()
+
+	for _, panicked := range panics {
+		if panicked != nil {
+			return partials, panicked
+		}
+	}
+
+	return partials, nil
+}
+
+func (i IteratorForSliceOfString) parFoldChunksForInt(init int, reducer func(acc int, item SliceOfString) int, workers int) ([]int, interface{}) {
+	type chunk struct {
+		index uint
+		items []SliceOfString
+	}
+
+	chunks := make(chan chunk, workers)
+	partials := []int{}
+
+	var panicked interface{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for k := 0; k < workers; k++ {
+		go func() {
+			defer wg.Done()
+
+			for c := range chunks {
+				partial, p := i.parFoldPartForInt(VectorOfSliceOfString(c.items).iter, init, reducer)
+
+				mutex.Lock()
+				partials[c.index] = partial
+				if panicked == nil {
+					panicked = p
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for index := uint(0); ; index++ {
+		items := make([]SliceOfString, 0, parFoldChunkSize)
+		for len(items) < parFoldChunkSize {
+			item := i.Next()
+			if item.IsNone() {
+				break
+			}
+
+			items = append(items, item.Unwrap())
+		}
+
+		if len(items) == 0 {
+			break
+		}
+
+		mutex.Lock()
+		stop := panicked != nil
+		if !stop {
+			partials = append(partials, init)
+		}
+		mutex.Unlock()
+
+		if stop {
+			break
+		}
+
+		chunks <- chunk{index: index, items: items}
+
+		if len(items) < parFoldChunkSize {
+			break
+		}
+	}
+
+	close(chunks)
+	wg.Wait()
+
+	return partials, panicked
+}
+
+// parFoldPartForInt folds a part of the Iterator, recovering a panic of the reducer so that ParFold can propagate it.
+func (i IteratorForSliceOfString) parFoldPartForInt(part IterableForSliceOfString, init int, reducer func(acc int, item SliceOfString) int) (partial int, panicked interface{}) {
+	defer func() {
+		panicked = recover()
+	}()
+
+	return IteratorForSliceOfString{iter: part}.FoldForInt(init, reducer), nil
+}
+
+// ParFoldForSliceOfInt applies a reducer to parts of the Iterator from workers goroutines
+// and merges their results in order with combiner.
+// Each part is folded from init, which must be neutral for combiner, and combiner must be associative.
+// Vector and Range Iterators are split in place, other Iterators are buffered in chunks.
+// A panic in the reducer is propagated to the caller once every worker has stopped.
+func (i IteratorForSliceOfString) ParFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfString) SliceOfInt, combiner func(left, right SliceOfInt) SliceOfInt, workers int) SliceOfInt {
+	if workers < 1 {
+		panic("Called `ParFold` with less than one worker.")
+	}
+
+	defer i.Close()
+
+	var partials []SliceOfInt
+	var panicked interface{}
+	if s, ok := i.iter.(splittableForSliceOfString); ok {
+		partials, panicked = i.parFoldSplitForSliceOfInt(s.split(uint(workers)), init, reducer)
+	} else {
+		partials, panicked = i.parFoldChunksForSliceOfInt(init, reducer, workers)
+	}
+
+	if panicked != nil {
+		panic(panicked)
+	}
+
+	if len(partials) == 0 {
+		return init
+	}
+
+	acc := partials[0]
+	for _, partial := range partials[1:] {
+		acc = combiner(acc, partial)
+	}
+
+	return acc
+}
+
+func (i IteratorForSliceOfString) parFoldSplitForSliceOfInt(parts []IterableForSliceOfString, init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfString) SliceOfInt) ([]SliceOfInt, interface{}) {
+	partials := make([]SliceOfInt, len(parts))
+	panics := make([]interface{}, len(parts))
+
+	var wg sync.WaitGroup
+	wg.Add(len(parts))
+
+	for k, part := range parts {
+		go func(k int, part IterableForSliceOfString) {
+			defer wg.Done()
+			partials[k], panics[k] = i.parFoldPartForSliceOfInt(part, init, reducer)
+		}(k, part)
+	}
+
+	wg.Wait()
+
+	for _, panicked := range panics {
+		if panicked != nil {
+			return partials, panicked
+		}
+	}
+
+	return partials, nil
+}
+
+func (i IteratorForSliceOfString) parFoldChunksForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfString) SliceOfInt, workers int) ([]SliceOfInt, interface{}) {
+	type chunk struct {
+		index uint
+		items []SliceOfString
+	}
+
+	chunks := make(chan chunk, workers)
+	partials := []SliceOfInt{}
+
+	var panicked interface{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for k := 0; k < workers; k++ {
+		go func() {
+			defer wg.Done()
+
+			for c := range chunks {
+				partial, p := i.parFoldPartForSliceOfInt(VectorOfSliceOfString(c.items).iter, init, reducer)
+
+				mutex.Lock()
+				partials[c.index] = partial
+				if panicked == nil {
+					panicked = p
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for index := uint(0); ; index++ {
+		items := make([]SliceOfString, 0, parFoldChunkSize)
+		for len(items) < parFoldChunkSize {
+			item := i.Next()
+			if item.IsNone() {
+				break
+			}
+
+			items = append(items, item.Unwrap())
+		}
+
+		if len(items) == 0 {
+			break
+		}
+
+		mutex.Lock()
+		stop := panicked != nil
+		if !stop {
+			partials = append(partials, init)
+		}
+		mutex.Unlock()
+
+		if stop {
+			break
+		}
+
+		chunks <- chunk{index: index, items: items}
+
+		if len(items) < parFoldChunkSize {
+			break
+		}
+	}
+
+	close(chunks)
+	wg.Wait()
+
+	return partials, panicked
+}
+
+// parFoldPartForSliceOfInt folds a part of the Iterator, recovering a panic of the reducer so that ParFold can propagate it.
+func (i IteratorForSliceOfString) parFoldPartForSliceOfInt(part IterableForSliceOfString, init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfString) SliceOfInt) (partial SliceOfInt, panicked interface{}) {
+	defer func() {
+		panicked = recover()
+	}()
+
+	return IteratorForSliceOfString{iter: part}.FoldForSliceOfInt(init, reducer), nil
+}
+
+// ParFoldForInt applies a reducer to parts of the Iterator from workers goroutines
+// and merges their results in order with combiner.
+// Each part is folded from init, which must be neutral for combiner, and combiner must be associative.
+// Vector and Range Iterators are split in place, other Iterators are buffered in chunks.
+// A panic in the reducer is propagated to the caller once every worker has stopped.
+func (i IteratorForSliceOfEntryForStringToInt) ParFoldForInt(init int, reducer func(acc int, item SliceOfEntryForStringToInt) int, combiner func(left, right int) int, workers int) int {
+	if workers < 1 {
+		panic("Called `ParFold` with less than one worker.")
+	}
+
+	defer i.Close()
+
+	var partials []int
+	var panicked interface{}
+	if s, ok := i.iter.(splittableForSliceOfEntryForStringToInt); ok {
+		partials, panicked = i.parFoldSplitForInt(s.split(uint(workers)), init, reducer)
+	} else {
+		partials, panicked = i.parFoldChunksForInt(init, reducer, workers)
+	}
+
+	if panicked != nil {
+		panic(panicked)
+	}
+
+	if len(partials) == 0 {
+		return init
+	}
+
+	acc := partials[0]
+	for _, partial := range partials[1:] {
+		acc = combiner(acc, partial)
+	}
+
+	return acc
+}
+
+func (i IteratorForSliceOfEntryForStringToInt) parFoldSplitForInt(parts []IterableForSliceOfEntryForStringToInt, init int, reducer func(acc int, item SliceOfEntryForStringToInt) int) ([]int, interface{}) {
+	partials := make([]int, len(parts))
+	panics := make([]interface{}, len(parts))
+
+	var wg sync.WaitGroup
+	wg.Add(len(parts))
+
+	for k, part := range parts {
+		go func(k int, part IterableForSliceOfEntryForStringToInt) {
+			defer wg.Done()
+			partials[k], panics[k] = i.parFoldPartForInt(part, init, reducer)
+		}(k, part)
+	}
+
+	wg.Wait()
+
+	for _, panicked := range panics {
+		if panicked != nil {
+			return partials, panicked
+		}
+	}
+
+	return partials, nil
+}
+
+func (i IteratorForSliceOfEntryForStringToInt) parFoldChunksForInt(init int, reducer func(acc int, item SliceOfEntryForStringToInt) int, workers int) ([]int, interface{}) {
+	type chunk struct {
+		index uint
+		items []SliceOfEntryForStringToInt
+	}
+
+	chunks := make(chan chunk, workers)
+	partials := []int{}
+
+	var panicked interface{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for k := 0; k < workers; k++ {
+		go func() {
+			defer wg.Done()
+
+			for c := range chunks {
+				partial, p := i.parFoldPartForInt(VectorOfSliceOfEntryForStringToInt(c.items).iter, init, reducer)
+
+				mutex.Lock()
+				partials[c.index] = partial
+				if panicked == nil {
+					panicked = p
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for index := uint(0); ; index++ {
+		items := make([]SliceOfEntryForStringToInt, 0, parFoldChunkSize)
+		for len(items) < parFoldChunkSize {
+			item := i.Next()
+			if item.IsNone() {
+				break
+			}
+
+			items = append(items, item.Unwrap())
+		}
+
+		if len(items) == 0 {
+			break
+		}
+
+		mutex.Lock()
+		stop := panicked != nil
+		if !stop {
+			partials = append(partials, init)
+		}
+		mutex.Unlock()
+
+		if stop {
+			break
+		}
+
+		chunks <- chunk{index: index, items: items}
+
+		if len(items) < parFoldChunkSize {
+			break
+		}
+	}
+
+	close(chunks)
+	wg.Wait()
+
+	return partials, panicked
+}
+
+// parFoldPartForInt folds a part of the Iterator, recovering a panic of the reducer so that ParFold can propagate it.
+func (i IteratorForSliceOfEntryForStringToInt) parFoldPartForInt(part IterableForSliceOfEntryForStringToInt, init int, reducer func(acc int, item SliceOfEntryForStringToInt) int) (partial int, panicked interface{}) {
+	defer func() {
+		panicked = recover()
+	}()
+
+	return IteratorForSliceOfEntryForStringToInt{iter: part}.FoldForInt(init, reducer), nil
+}
+
+// ParFoldForSliceOfInt applies a reducer to parts of the Iterator from workers goroutines
+// and merges their results in order with combiner.
+// Each part is folded from init, which must be neutral for combiner, and combiner must be associative.
+// Vector and Range Iterators are split in place, other Iterators are buffered in chunks.
+// A panic in the reducer is propagated to the caller once every worker has stopped.
+func (i IteratorForSliceOfEntryForStringToInt) ParFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfEntryForStringToInt) SliceOfInt, combiner func(left, right SliceOfInt) SliceOfInt, workers int) SliceOfInt {
+	if workers < 1 {
+		panic("Called `ParFold` with less than one worker.")
+	}
+
+	defer i.Close()
+
+	var partials []SliceOfInt
+	var panicked interface{}
+	if s, ok := i.iter.(splittableForSliceOfEntryForStringToInt); ok {
+		partials, panicked = i.parFoldSplitForSliceOfInt(s.split(uint(workers)), init, reducer)
+	} else {
+		partials, panicked = i.parFoldChunksForSliceOfInt(init, reducer, workers)
+	}
+
+	if panicked != nil {
+		panic(panicked)
+	}
+
+	if len(partials) == 0 {
+		return init
+	}
+
+	acc := partials[0]
+	for _, partial := range partials[1:] {
+		acc = combiner(acc, partial)
+	}
+
+	return acc
+}
+
+func (i IteratorForSliceOfEntryForStringToInt) parFoldSplitForSliceOfInt(parts []IterableForSliceOfEntryForStringToInt, init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfEntryForStringToInt) SliceOfInt) ([]SliceOfInt, interface{}) {
+	partials := make([]SliceOfInt, len(parts))
+	panics := make([]interface{}, len(parts))
+
+	var wg sync.WaitGroup
+	wg.Add(len(parts))
+
+	for k, part := range parts {
+		go func(k int, part IterableForSliceOfEntryForStringToInt) {
+			defer wg.Done()
+			partials[k], panics[k] = i.parFoldPartForSliceOfInt(part, init, reducer)
+		}(k, part)
+	}
+
+	wg.Wait()
+
+	for _, panicked := range panics {
+		if panicked != nil {
+			return partials, panicked
+		}
+	}
+
+	return partials, nil
+}
+
+func (i IteratorForSliceOfEntryForStringToInt) parFoldChunksForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfEntryForStringToInt) SliceOfInt, workers int) ([]SliceOfInt, interface{}) {
+	type chunk struct {
+		index uint
+		items []SliceOfEntryForStringToInt
+	}
+
+	chunks := make(chan chunk, workers)
+	partials := []SliceOfInt{}
+
+	var panicked interface{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for k := 0; k < workers; k++ {
+		go func() {
+			defer wg.Done()
+
+			for c := range chunks {
+				partial, p := i.parFoldPartForSliceOfInt(VectorOfSliceOfEntryForStringToInt(c.items).iter, init, reducer)
+
+				mutex.Lock()
+				partials[c.index] = partial
+				if panicked == nil {
+					panicked = p
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for index := uint(0); ; index++ {
+		items := make([]SliceOfEntryForStringToInt, 0, parFoldChunkSize)
+		for len(items) < parFoldChunkSize {
+			item := i.Next()
+			if item.IsNone() {
+				break
+			}
+
+			items = append(items, item.Unwrap())
+		}
+
+		if len(items) == 0 {
+			break
+		}
+
+		mutex.Lock()
+		stop := panicked != nil
+		if !stop {
+			partials = append(partials, init)
+		}
+		mutex.Unlock()
+
+		if stop {
+			break
+		}
+
+		chunks <- chunk{index: index, items: items}
+
+		if len(items) < parFoldChunkSize {
+			break
+		}
+	}
+
+	close(chunks)
+	wg.Wait()
+
+	return partials, panicked
+}
+
+// parFoldPartForSliceOfInt folds a part of the Iterator, recovering a panic of the reducer so that ParFold can propagate it.
+func (i IteratorForSliceOfEntryForStringToInt) parFoldPartForSliceOfInt(part IterableForSliceOfEntryForStringToInt, init SliceOfInt, reducer func(acc SliceOfInt, item SliceOfEntryForStringToInt) SliceOfInt) (partial SliceOfInt, panicked interface{}) {
+	defer func() {
+		panicked = recover()
+	}()
+
+	return IteratorForSliceOfEntryForStringToInt{iter: part}.FoldForSliceOfInt(init, reducer), nil
+}
+
+// ParFoldForInt applies a reducer to parts of the Iterator from workers goroutines
+// and merges their results in order with combiner.
+// Each part is folded from init, which must be neutral for combiner, and combiner must be associative.
+// Vector and Range Iterators are split in place, other Iterators are buffered in chunks.
+// A panic in the reducer is propagated to the caller once every worker has stopped.
+func (i IteratorForCSVRecord) ParFoldForInt(init int, reducer func(acc int, item CSVRecord) int, combiner func(left, right int) int, workers int) int {
+	if workers < 1 {
+		panic("Called `ParFold` with less than one worker.")
+	}
+
+	defer i.Close()
+
+	var partials []int
+	var panicked interface{}
+	if s, ok := i.iter.(splittableForCSVRecord); ok {
+		partials, panicked = i.parFoldSplitForInt(s.split(uint(workers)), init, reducer)
+	} else {
+		partials, panicked = i.parFoldChunksForInt(init, reducer, workers)
+	}
+
+	if panicked != nil {
+		panic(panicked)
+	}
+
+	if len(partials) == 0 {
+		return init
+	}
+
+	acc := partials[0]
+	for _, partial := range partials[1:] {
+		acc = combiner(acc, partial)
+	}
+
+	return acc
+}
+
+func (i IteratorForCSVRecord) parFoldSplitForInt(parts []IterableForCSVRecord, init int, reducer func(acc int, item CSVRecord) int) ([]int, interface{}) {
+	partials := make([]int, len(parts))
+	panics := make([]interface{}, len(parts))
+
+	var wg sync.WaitGroup
+	wg.Add(len(parts))
+
+	for k, part := range parts {
+		go func(k int, part IterableForCSVRecord) {
+			defer wg.Done()
+			partials[k], panics[k] = i.parFoldPartForInt(part, init, reducer)
+		}(k, part)
+	}
+
+	wg.Wait()
+
+	for _, panicked := range panics {
+		if panicked != nil {
+			return partials, panicked
+		}
+	}
+
+	return partials, nil
+}
+
+func (i IteratorForCSVRecord) parFoldChunksForInt(init int, reducer func(acc int, item CSVRecord) int, workers int) ([]int, interface{}) {
+	type chunk struct {
+		index uint
+		items []CSVRecord
+	}
+
+	chunks := make(chan chunk, workers)
+	partials := []int{}
+
+	var panicked interface{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for k := 0; k < workers; k++ {
+		go func() {
+			defer wg.Done()
+
+			for c := range chunks {
+				partial, p := i.parFoldPartForInt(VectorOfCSVRecord(c.items).iter, init, reducer)
+
+				mutex.Lock()
+				partials[c.index] = partial
+				if panicked == nil {
+					panicked = p
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for index := uint(0); ; index++ {
+		items := make([]CSVRecord, 0, parFoldChunkSize)
+		for len(items) < parFoldChunkSize {
+			item := i.Next()
+			if item.IsNone() {
+				break
+			}
+
+			items = append(items, item.Unwrap())
+		}
+
+		if len(items) == 0 {
+			break
+		}
+
+		mutex.Lock()
+		stop := panicked != nil
+		if !stop {
+			partials = append(partials, init)
+		}
+		mutex.Unlock()
+
+		if stop {
+			break
+		}
+
+		chunks <- chunk{index: index, items: items}
+
+		if len(items) < parFoldChunkSize {
+			break
+		}
+	}
+
+	close(chunks)
+	wg.Wait()
+
+	return partials, panicked
+}
+
+// parFoldPartForInt folds a part of the Iterator, recovering a panic of the reducer so that ParFold can propagate it.
+func (i IteratorForCSVRecord) parFoldPartForInt(part IterableForCSVRecord, init int, reducer func(acc int, item CSVRecord) int) (partial int, panicked interface{}) {
+	defer func() {
+		panicked = recover()
+	}()
+
+	return IteratorForCSVRecord{iter: part}.FoldForInt(init, reducer), nil
+}
+
+// ParFoldForSliceOfInt applies a reducer to parts of the Iterator from workers goroutines
+// and merges their results in order with combiner.
+// Each part is folded from init, which must be neutral for combiner, and combiner must be associative.
+// Vector and Range Iterators are split in place, other Iterators are buffered in chunks.
+// A panic in the reducer is propagated to the caller once every worker has stopped.
+func (i IteratorForCSVRecord) ParFoldForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item CSVRecord) SliceOfInt, combiner func(left, right SliceOfInt) SliceOfInt, workers int) SliceOfInt {
+	if workers < 1 {
+		panic("Called `ParFold` with less than one worker.")
+	}
+
+	defer i.Close()
+
+	var partials []SliceOfInt
+	var panicked interface{}
+	if s, ok := i.iter.(splittableForCSVRecord); ok {
+		partials, panicked = i.parFoldSplitForSliceOfInt(s.split(uint(workers)), init, reducer)
+	} else {
+		partials, panicked = i.parFoldChunksForSliceOfInt(init, reducer, workers)
+	}
+
+	if panicked != nil {
+		panic(panicked)
+	}
+
+	if len(partials) == 0 {
+		return init
+	}
+
+	acc := partials[0]
+	for _, partial := range partials[1:] {
+		acc = combiner(acc, partial)
+	}
+
+	return acc
+}
+
+func (i IteratorForCSVRecord) parFoldSplitForSliceOfInt(parts []IterableForCSVRecord, init SliceOfInt, reducer func(acc SliceOfInt, item CSVRecord) SliceOfInt) ([]SliceOfInt, interface{}) {
+	partials := make([]SliceOfInt, len(parts))
+	panics := make([]interface{}, len(parts))
+
+	var wg sync.WaitGroup
+	wg.Add(len(parts))
+
+	for k, part := range parts {
+		go func(k int, part IterableForCSVRecord) {
+			defer wg.Done()
+			partials[k], panics[k] = i.parFoldPartForSliceOfInt(part, init, reducer)
+		}(k, part)
+	}
+
+	wg.Wait()
+
+	for _, panicked := range panics {
+		if panicked != nil {
+			return partials, panicked
+		}
+	}
+
+	return partials, nil
+}
+
+func (i IteratorForCSVRecord) parFoldChunksForSliceOfInt(init SliceOfInt, reducer func(acc SliceOfInt, item CSVRecord) SliceOfInt, workers int) ([]SliceOfInt, interface{}) {
+	type chunk struct {
+		index uint
+		items []CSVRecord
+	}
+
+	chunks := make(chan chunk, workers)
+	partials := []SliceOfInt{}
+
+	var panicked interface{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for k := 0; k < workers; k++ {
+		go func() {
+			defer wg.Done()
+
+			for c := range chunks {
+				partial, p := i.parFoldPartForSliceOfInt(VectorOfCSVRecord(c.items).iter, init, reducer)
+
+				mutex.Lock()
+				partials[c.index] = partial
+				if panicked == nil {
+					panicked = p
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for index := uint(0); ; index++ {
+		items := make([]CSVRecord, 0, parFoldChunkSize)
+		for len(items) < parFoldChunkSize {
+			item := i.Next()
+			if item.IsNone() {
+				break
+			}
+
+			items = append(items, item.Unwrap())
+		}
+
+		if len(items) == 0 {
+			break
+		}
+
+		mutex.Lock()
+		stop := panicked != nil
+		if !stop {
+			partials = append(partials, init)
+		}
+		mutex.Unlock()
+
+		if stop {
+			break
+		}
+
+		chunks <- chunk{index: index, items: items}
+
+		if len(items) < parFoldChunkSize {
+			break
+		}
+	}
+
+	close(chunks)
+	wg.Wait()
+
+	return partials, panicked
+}
+
+// parFoldPartForSliceOfInt folds a part of the Iterator, recovering a panic of the reducer so that ParFold can propagate it.
+func (i IteratorForCSVRecord) parFoldPartForSliceOfInt(part IterableForCSVRecord, init SliceOfInt, reducer func(acc SliceOfInt, item CSVRecord) SliceOfInt) (partial SliceOfInt, panicked interface{}) {
+	defer func() {
+		panicked = recover()
+	}()
+
+	return IteratorForCSVRecord{iter: part}.FoldForSliceOfInt(init, reducer), nil
+}
@@ -350,6 +350,48 @@ func TestRangeChain(t *testing.T) {
 	}
 }
 
+func TestRangeSizeHint(t *testing.T) {
+	testCases := map[IteratorForInt]uint{
+		Range(0, 0, 0):                       0,
+		Range(0, 1, 1):                       1,
+		Range(0, 4, 1):                       4,
+		Range(0, 5, 2):                       3,
+		Range(0, -4, -1):                     4,
+		Range(0, -5, -2):                     3,
+		Range(0, 5, 2).Skip(1):               2,
+		Range(0, 4, 1).Take(2):               2,
+		Range(0, 4, 1).Take(6):               4,
+		Range(0, 5, 2).Chain(Range(0, 4, 1)): 7,
+		Range(0, -5, -2).Map(func(item int) int { return -item }): 3,
+	}
+
+	for iter, want := range testCases {
+		lower, upper := iter.SizeHint()
+
+		if lower != want || !reflect.DeepEqual(upper, SomeUint(want)) {
+			t.Errorf("case: %s;got: (%d, %v); expected: (%d, %v)", iter, lower, upper, want, SomeUint(want))
+		}
+	}
+}
+
+func TestRangeFilterSizeHint(t *testing.T) {
+	lower, upper := Range(0, 4, 1).Filter(func(item int) bool {
+		return item%2 == 0
+	}).SizeHint()
+
+	if lower != 0 || !reflect.DeepEqual(upper, SomeUint(4)) {
+		t.Errorf("got: (%d, %v); expected: (%d, %v)", lower, upper, 0, SomeUint(4))
+	}
+}
+
+func TestRangeCollectCapacity(t *testing.T) {
+	got := Range(0, 1_000, 3).Collect()
+
+	if len(got) != 334 || cap(got) != 334 {
+		t.Errorf("got: len %d, cap %d; expected: len %d, cap %d", len(got), cap(got), 334, 334)
+	}
+}
+
 func BenchmarkRangeDivisorsSearch(b *testing.B) {
 	b.Run("with a loop", func(b *testing.B) {
 		for k := 0; k < b.N; k++ {
@@ -0,0 +1,399 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package iter
+
+import "fmt"
+
+// ResultForInt holds either an int value or an error.
+type ResultForInt struct {
+	value int
+	err   error
+}
+
+// OkInt returns a Result holding an int value.
+func OkInt(value int) ResultForInt {
+	return ResultForInt{value: value, err: nil}
+}
+
+// ErrInt returns a Result holding an error.
+func ErrInt(err error) ResultForInt {
+	return ResultForInt{err: err}
+}
+
+func (r ResultForInt) IsOk() bool {
+	return r.err == nil
+}
+
+func (r ResultForInt) IsErr() bool {
+	return r.err != nil
+}
+
+func (r ResultForInt) Err() error {
+	return r.err
+}
+
+func (r ResultForInt) Expect(msg string) int {
+	if r.err != nil {
+		panic(fmt.Sprintf("%s: %v", msg, r.err))
+	}
+
+	return r.value
+}
+
+func (r ResultForInt) Unwrap() int {
+	return r.Expect("Called `Unwrap` on an `Err` Result")
+}
+
+func (r ResultForInt) UnwrapOr(defaultValue int) int {
+	if r.err != nil {
+		return defaultValue
+	}
+
+	return r.value
+}
+
+func (r ResultForInt) Map(mapper func(value int) int) ResultForInt {
+	if r.err != nil {
+		return r
+	}
+
+	return OkInt(mapper(r.value))
+}
+
+// ResultForString holds either an string value or an error.
+type ResultForString struct {
+	value string
+	err   error
+}
+
+// OkString returns a Result holding an string value.
+func OkString(value string) ResultForString {
+	return ResultForString{value: value, err: nil}
+}
+
+// ErrString returns a Result holding an error.
+func ErrString(err error) ResultForString {
+	return ResultForString{err: err}
+}
+
+func (r ResultForString) IsOk() bool {
+	return r.err == nil
+}
+
+func (r ResultForString) IsErr() bool {
+	return r.err != nil
+}
+
+func (r ResultForString) Err() error {
+	return r.err
+}
+
+func (r ResultForString) Expect(msg string) string {
+	if r.err != nil {
+		panic(fmt.Sprintf("%s: %v", msg, r.err))
+	}
+
+	return r.value
+}
+
+func (r ResultForString) Unwrap() string {
+	return r.Expect("Called `Unwrap` on an `Err` Result")
+}
+
+func (r ResultForString) UnwrapOr(defaultValue string) string {
+	if r.err != nil {
+		return defaultValue
+	}
+
+	return r.value
+}
+
+func (r ResultForString) Map(mapper func(value string) string) ResultForString {
+	if r.err != nil {
+		return r
+	}
+
+	return OkString(mapper(r.value))
+}
+
+// ResultForEntryForStringToInt holds either an EntryForStringToInt value or an error.
+type ResultForEntryForStringToInt struct {
+	value EntryForStringToInt
+	err   error
+}
+
+// OkEntryForStringToInt returns a Result holding an EntryForStringToInt value.
+func OkEntryForStringToInt(value EntryForStringToInt) ResultForEntryForStringToInt {
+	return ResultForEntryForStringToInt{value: value, err: nil}
+}
+
+// ErrEntryForStringToInt returns a Result holding an error.
+func ErrEntryForStringToInt(err error) ResultForEntryForStringToInt {
+	return ResultForEntryForStringToInt{err: err}
+}
+
+func (r ResultForEntryForStringToInt) IsOk() bool {
+	return r.err == nil
+}
+
+func (r ResultForEntryForStringToInt) IsErr() bool {
+	return r.err != nil
+}
+
+func (r ResultForEntryForStringToInt) Err() error {
+	return r.err
+}
+
+func (r ResultForEntryForStringToInt) Expect(msg string) EntryForStringToInt {
+	if r.err != nil {
+		panic(fmt.Sprintf("%s: %v", msg, r.err))
+	}
+
+	return r.value
+}
+
+func (r ResultForEntryForStringToInt) Unwrap() EntryForStringToInt {
+	return r.Expect("Called `Unwrap` on an `Err` Result")
+}
+
+func (r ResultForEntryForStringToInt) UnwrapOr(defaultValue EntryForStringToInt) EntryForStringToInt {
+	if r.err != nil {
+		return defaultValue
+	}
+
+	return r.value
+}
+
+func (r ResultForEntryForStringToInt) Map(mapper func(value EntryForStringToInt) EntryForStringToInt) ResultForEntryForStringToInt {
+	if r.err != nil {
+		return r
+	}
+
+	return OkEntryForStringToInt(mapper(r.value))
+}
+
+// ResultForSliceOfInt holds either an SliceOfInt value or an error.
+type ResultForSliceOfInt struct {
+	value SliceOfInt
+	err   error
+}
+
+// OkSliceOfInt returns a Result holding an SliceOfInt value.
+func OkSliceOfInt(value SliceOfInt) ResultForSliceOfInt {
+	return ResultForSliceOfInt{value: value, err: nil}
+}
+
+// ErrSliceOfInt returns a Result holding an error.
+func ErrSliceOfInt(err error) ResultForSliceOfInt {
+	return ResultForSliceOfInt{err: err}
+}
+
+func (r ResultForSliceOfInt) IsOk() bool {
+	return r.err == nil
+}
+
+func (r ResultForSliceOfInt) IsErr() bool {
+	return r.err != nil
+}
+
+func (r ResultForSliceOfInt) Err() error {
+	return r.err
+}
+
+func (r ResultForSliceOfInt) Expect(msg string) SliceOfInt {
+	if r.err != nil {
+		panic(fmt.Sprintf("%s: %v", msg, r.err))
+	}
+
+	return r.value
+}
+
+func (r ResultForSliceOfInt) Unwrap() SliceOfInt {
+	return r.Expect("Called `Unwrap` on an `Err` Result")
+}
+
+func (r ResultForSliceOfInt) UnwrapOr(defaultValue SliceOfInt) SliceOfInt {
+	if r.err != nil {
+		return defaultValue
+	}
+
+	return r.value
+}
+
+func (r ResultForSliceOfInt) Map(mapper func(value SliceOfInt) SliceOfInt) ResultForSliceOfInt {
+	if r.err != nil {
+		return r
+	}
+
+	return OkSliceOfInt(mapper(r.value))
+}
+
+// ResultForSliceOfString holds either an SliceOfString value or an error.
+type ResultForSliceOfString struct {
+	value SliceOfString
+	err   error
+}
+
+// OkSliceOfString returns a Result holding an SliceOfString value.
+func OkSliceOfString(value SliceOfString) ResultForSliceOfString {
+	return ResultForSliceOfString{value: value, err: nil}
+}
+
+// ErrSliceOfString returns a Result holding an error.
+func ErrSliceOfString(err error) ResultForSliceOfString {
+	return ResultForSliceOfString{err: err}
+}
+
+func (r ResultForSliceOfString) IsOk() bool {
+	return r.err == nil
+}
+
+func (r ResultForSliceOfString) IsErr() bool {
+	return r.err != nil
+}
+
+func (r ResultForSliceOfString) Err() error {
+	return r.err
+}
+
+func (r ResultForSliceOfString) Expect(msg string) SliceOfString {
+	if r.err != nil {
+		panic(fmt.Sprintf("%s: %v", msg, r.err))
+	}
+
+	return r.value
+}
+
+func (r ResultForSliceOfString) Unwrap() SliceOfString {
+	return r.Expect("Called `Unwrap` on an `Err` Result")
+}
+
+func (r ResultForSliceOfString) UnwrapOr(defaultValue SliceOfString) SliceOfString {
+	if r.err != nil {
+		return defaultValue
+	}
+
+	return r.value
+}
+
+func (r ResultForSliceOfString) Map(mapper func(value SliceOfString) SliceOfString) ResultForSliceOfString {
+	if r.err != nil {
+		return r
+	}
+
+	return OkSliceOfString(mapper(r.value))
+}
+
+// ResultForSliceOfEntryForStringToInt holds either an SliceOfEntryForStringToInt value or an error.
+type ResultForSliceOfEntryForStringToInt struct {
+	value SliceOfEntryForStringToInt
+	err   error
+}
+
+// OkSliceOfEntryForStringToInt returns a Result holding an SliceOfEntryForStringToInt value.
+func OkSliceOfEntryForStringToInt(value SliceOfEntryForStringToInt) ResultForSliceOfEntryForStringToInt {
+	return ResultForSliceOfEntryForStringToInt{value: value, err: nil}
+}
+
+// ErrSliceOfEntryForStringToInt returns a Result holding an error.
+func ErrSliceOfEntryForStringToInt(err error) ResultForSliceOfEntryForStringToInt {
+	return ResultForSliceOfEntryForStringToInt{err: err}
+}
+
+func (r ResultForSliceOfEntryForStringToInt) IsOk() bool {
+	return r.err == nil
+}
+
+func (r ResultForSliceOfEntryForStringToInt) IsErr() bool {
+	return r.err != nil
+}
+
+func (r ResultForSliceOfEntryForStringToInt) Err() error {
+	return r.err
+}
+
+func (r ResultForSliceOfEntryForStringToInt) Expect(msg string) SliceOfEntryForStringToInt {
+	if r.err != nil {
+		panic(fmt.Sprintf("%s: %v", msg, r.err))
+	}
+
+	return r.value
+}
+
+func (r ResultForSliceOfEntryForStringToInt) Unwrap() SliceOfEntryForStringToInt {
+	return r.Expect("Called `Unwrap` on an `Err` Result")
+}
+
+func (r ResultForSliceOfEntryForStringToInt) UnwrapOr(defaultValue SliceOfEntryForStringToInt) SliceOfEntryForStringToInt {
+	if r.err != nil {
+		return defaultValue
+	}
+
+	return r.value
+}
+
+func (r ResultForSliceOfEntryForStringToInt) Map(mapper func(value SliceOfEntryForStringToInt) SliceOfEntryForStringToInt) ResultForSliceOfEntryForStringToInt {
+	if r.err != nil {
+		return r
+	}
+
+	return OkSliceOfEntryForStringToInt(mapper(r.value))
+}
+
+// ResultForCSVRecord holds either an CSVRecord value or an error.
+type ResultForCSVRecord struct {
+	value CSVRecord
+	err   error
+}
+
+// OkCSVRecord returns a Result holding an CSVRecord value.
+func OkCSVRecord(value CSVRecord) ResultForCSVRecord {
+	return ResultForCSVRecord{value: value, err: nil}
+}
+
+// ErrCSVRecord returns a Result holding an error.
+func ErrCSVRecord(err error) ResultForCSVRecord {
+	return ResultForCSVRecord{err: err}
+}
+
+func (r ResultForCSVRecord) IsOk() bool {
+	return r.err == nil
+}
+
+func (r ResultForCSVRecord) IsErr() bool {
+	return r.err != nil
+}
+
+func (r ResultForCSVRecord) Err() error {
+	return r.err
+}
+
+func (r ResultForCSVRecord) Expect(msg string) CSVRecord {
+	if r.err != nil {
+		panic(fmt.Sprintf("%s: %v", msg, r.err))
+	}
+
+	return r.value
+}
+
+func (r ResultForCSVRecord) Unwrap() CSVRecord {
+	return r.Expect("Called `Unwrap` on an `Err` Result")
+}
+
+func (r ResultForCSVRecord) UnwrapOr(defaultValue CSVRecord) CSVRecord {
+	if r.err != nil {
+		return defaultValue
+	}
+
+	return r.value
+}
+
+func (r ResultForCSVRecord) Map(mapper func(value CSVRecord) CSVRecord) ResultForCSVRecord {
+	if r.err != nil {
+		return r
+	}
+
+	return OkCSVRecord(mapper(r.value))
+}
@@ -0,0 +1,644 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package iter
+
+import "iter"
+
+// Seq returns a standard library sequence yielding the elements of the Iterator.
+func (i IteratorForInt) Seq() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		defer i.Close()
+
+		item := i.Next()
+		for item.IsSome() {
+			if !yield(item.Unwrap()) {
+				return
+			}
+
+			item = i.Next()
+		}
+	}
+}
+
+// Seq2 returns a standard library sequence yielding the elements of the Iterator along with their position.
+func (i IteratorForInt) Seq2() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		defer i.Close()
+
+		position := 0
+
+		item := i.Next()
+		for item.IsSome() {
+			if !yield(position, item.Unwrap()) {
+				return
+			}
+
+			position++
+			item = i.Next()
+		}
+	}
+}
+
+// FromSeqInt builds an Iterator pulling its elements from a standard library sequence.
+// The returned stop function, like the Iterator Close method, must be called if it is not consumed until its end.
+func FromSeqInt(seq iter.Seq[int]) (IteratorForInt, func()) {
+	return FromPullInt(iter.Pull(seq))
+}
+
+// FromPullInt builds an Iterator from next and stop functions such as the ones returned by iter.Pull.
+// stop is called once the Iterator is exhausted, or when the returned function is called.
+func FromPullInt(next func() (int, bool), stop func()) (IteratorForInt, func()) {
+	p := &pullForInt{next: next, stop: stop, flag: false}
+
+	return IteratorForInt{iter: p}, p.release
+}
+
+type pullForInt struct {
+	next func() (int, bool)
+	stop func()
+	flag bool
+}
+
+func (p *pullForInt) Next() OptionForInt {
+	if p.flag {
+		return NoneInt()
+	}
+
+	item, ok := p.next()
+	if !ok {
+		p.release()
+		return NoneInt()
+	}
+
+	return SomeInt(item)
+}
+
+func (p *pullForInt) SizeHint() (uint, OptionForUint) {
+	if p.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (p *pullForInt) Close() error {
+	p.release()
+	return nil
+}
+
+func (p *pullForInt) release() {
+	if !p.flag {
+		p.flag = true
+		p.stop()
+	}
+}
+
+var _ IterableForInt = &pullForInt{}
+
+// Seq returns a standard library sequence yielding the elements of the Iterator.
+func (i IteratorForString) Seq() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		defer i.Close()
+
+		item := i.Next()
+		for item.IsSome() {
+			if !yield(item.Unwrap()) {
+				return
+			}
+
+			item = i.Next()
+		}
+	}
+}
+
+// Seq2 returns a standard library sequence yielding the elements of the Iterator along with their position.
+func (i IteratorForString) Seq2() iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		defer i.Close()
+
+		position := 0
+
+		item := i.Next()
+		for item.IsSome() {
+			if !yield(position, item.Unwrap()) {
+				return
+			}
+
+			position++
+			item = i.Next()
+		}
+	}
+}
+
+// FromSeqString builds an Iterator pulling its elements from a standard library sequence.
+// The returned stop function, like the Iterator Close method, must be called if it is not consumed until its end.
+func FromSeqString(seq iter.Seq[string]) (IteratorForString, func()) {
+	return FromPullString(iter.Pull(seq))
+}
+
+// FromPullString builds an Iterator from next and stop functions such as the ones returned by iter.Pull.
+// stop is called once the Iterator is exhausted, or when the returned function is called.
+func FromPullString(next func() (string, bool), stop func()) (IteratorForString, func()) {
+	p := &pullForString{next: next, stop: stop, flag: false}
+
+	return IteratorForString{iter: p}, p.release
+}
+
+type pullForString struct {
+	next func() (string, bool)
+	stop func()
+	flag bool
+}
+
+func (p *pullForString) Next() OptionForString {
+	if p.flag {
+		return NoneString()
+	}
+
+	item, ok := p.next()
+	if !ok {
+		p.release()
+		return NoneString()
+	}
+
+	return SomeString(item)
+}
+
+func (p *pullForString) SizeHint() (uint, OptionForUint) {
+	if p.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (p *pullForString) Close() error {
+	p.release()
+	return nil
+}
+
+func (p *pullForString) release() {
+	if !p.flag {
+		p.flag = true
+		p.stop()
+	}
+}
+
+var _ IterableForString = &pullForString{}
+
+// Seq returns a standard library sequence yielding the elements of the Iterator.
+func (i IteratorForEntryForStringToInt) Seq() iter.Seq[EntryForStringToInt] {
+	return func(yield func(EntryForStringToInt) bool) {
+		defer i.Close()
+
+		item := i.Next()
+		for item.IsSome() {
+			if !yield(item.Unwrap()) {
+				return
+			}
+
+			item = i.Next()
+		}
+	}
+}
+
+// Seq2 returns a standard library sequence yielding the elements of the Iterator along with their position.
+func (i IteratorForEntryForStringToInt) Seq2() iter.Seq2[int, EntryForStringToInt] {
+	return func(yield func(int, EntryForStringToInt) bool) {
+		defer i.Close()
+
+		position := 0
+
+		item := i.Next()
+		for item.IsSome() {
+			if !yield(position, item.Unwrap()) {
+				return
+			}
+
+			position++
+			item = i.Next()
+		}
+	}
+}
+
+// FromSeqEntryForStringToInt builds an Iterator pulling its elements from a standard library sequence.
+// The returned stop function, like the Iterator Close method, must be called if it is not consumed until its end.
+func FromSeqEntryForStringToInt(seq iter.Seq[EntryForStringToInt]) (IteratorForEntryForStringToInt, func()) {
+	return FromPullEntryForStringToInt(iter.Pull(seq))
+}
+
+// FromPullEntryForStringToInt builds an Iterator from next and stop functions such as the ones returned by iter.Pull.
+// stop is called once the Iterator is exhausted, or when the returned function is called.
+func FromPullEntryForStringToInt(next func() (EntryForStringToInt, bool), stop func()) (IteratorForEntryForStringToInt, func()) {
+	p := &pullForEntryForStringToInt{next: next, stop: stop, flag: false}
+
+	return IteratorForEntryForStringToInt{iter: p}, p.release
+}
+
+type pullForEntryForStringToInt struct {
+	next func() (EntryForStringToInt, bool)
+	stop func()
+	flag bool
+}
+
+func (p *pullForEntryForStringToInt) Next() OptionForEntryForStringToInt {
+	if p.flag {
+		return NoneEntryForStringToInt()
+	}
+
+	item, ok := p.next()
+	if !ok {
+		p.release()
+		return NoneEntryForStringToInt()
+	}
+
+	return SomeEntryForStringToInt(item)
+}
+
+func (p *pullForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if p.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (p *pullForEntryForStringToInt) Close() error {
+	p.release()
+	return nil
+}
+
+func (p *pullForEntryForStringToInt) release() {
+	if !p.flag {
+		p.flag = true
+		p.stop()
+	}
+}
+
+var _ IterableForEntryForStringToInt = &pullForEntryForStringToInt{}
+
+// Seq returns a standard library sequence yielding the elements of the Iterator.
+func (i IteratorForSliceOfInt) Seq() iter.Seq[SliceOfInt] {
+	return func(yield func(SliceOfInt) bool) {
+		defer i.Close()
+
+		item := i.Next()
+		for item.IsSome() {
+			if !yield(item.Unwrap()) {
+				return
+			}
+
+			item = i.Next()
+		}
+	}
+}
+
+// Seq2 returns a standard library sequence yielding the elements of the Iterator along with their position.
+func (i IteratorForSliceOfInt) Seq2() iter.Seq2[int, SliceOfInt] {
+	return func(yield func(int, SliceOfInt) bool) {
+		defer i.Close()
+
+		position := 0
+
+		item := i.Next()
+		for item.IsSome() {
+			if !yield(position, item.Unwrap()) {
+				return
+			}
+
+			position++
+			item = i.Next()
+		}
+	}
+}
+
+// FromSeqSliceOfInt builds an Iterator pulling its elements from a standard library sequence.
+// The returned stop function, like the Iterator Close method, must be called if it is not consumed until its end.
+func FromSeqSliceOfInt(seq iter.Seq[SliceOfInt]) (IteratorForSliceOfInt, func()) {
+	return FromPullSliceOfInt(iter.Pull(seq))
+}
+
+// FromPullSliceOfInt builds an Iterator from next and stop functions such as the ones returned by iter.Pull.
+// stop is called once the Iterator is exhausted, or when the returned function is called.
+func FromPullSliceOfInt(next func() (SliceOfInt, bool), stop func()) (IteratorForSliceOfInt, func()) {
+	p := &pullForSliceOfInt{next: next, stop: stop, flag: false}
+
+	return IteratorForSliceOfInt{iter: p}, p.release
+}
+
+type pullForSliceOfInt struct {
+	next func() (SliceOfInt, bool)
+	stop func()
+	flag bool
+}
+
+func (p *pullForSliceOfInt) Next() OptionForSliceOfInt {
+	if p.flag {
+		return NoneSliceOfInt()
+	}
+
+	item, ok := p.next()
+	if !ok {
+		p.release()
+		return NoneSliceOfInt()
+	}
+
+	return SomeSliceOfInt(item)
+}
+
+func (p *pullForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	if p.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (p *pullForSliceOfInt) Close() error {
+	p.release()
+	return nil
+}
+
+func (p *pullForSliceOfInt) release() {
+	if !p.flag {
+		p.flag = true
+		p.stop()
+	}
+}
+
+var _ IterableForSliceOfInt = &pullForSliceOfInt{}
+
+// Seq returns a standard library sequence yielding the elements of the Iterator.
+func (i IteratorForSliceOfString) Seq() iter.Seq[SliceOfString] {
+	return func(yield func(SliceOfString) bool) {
+		defer i.Close()
+
+		item := i.Next()
+		for item.IsSome() {
+			if !yield(item.Unwrap()) {
+				return
+			}
+
+			item = i.Next()
+		}
+	}
+}
+
+// Seq2 returns a standard library sequence yielding the elements of the Iterator along with their position.
+func (i IteratorForSliceOfString) Seq2() iter.Seq2[int, SliceOfString] {
+	return func(yield func(int, SliceOfString) bool) {
+		defer i.Close()
+
+		position := 0
+
+		item := i.Next()
+		for item.IsSome() {
+			if !yield(position, item.Unwrap()) {
+				return
+			}
+
+			position++
+			item = i.Next()
+		}
+	}
+}
+
+// FromSeqSliceOfString builds an Iterator pulling its elements from a standard library sequence.
+// The returned stop function, like the Iterator Close method, must be called if it is not consumed until its end.
+func FromSeqSliceOfString(seq iter.Seq[SliceOfString]) (IteratorForSliceOfString, func()) {
+	return FromPullSliceOfString(iter.Pull(seq))
+}
+
+// FromPullSliceOfString builds an Iterator from next and stop functions such as the ones returned by iter.Pull.
+// stop is called once the Iterator is exhausted, or when the returned function is called.
+func FromPullSliceOfString(next func() (SliceOfString, bool), stop func()) (IteratorForSliceOfString, func()) {
+	p := &pullForSliceOfString{next: next, stop: stop, flag: false}
+
+	return IteratorForSliceOfString{iter: p}, p.release
+}
+
+type pullForSliceOfString struct {
+	next func() (SliceOfString, bool)
+	stop func()
+	flag bool
+}
+
+func (p *pullForSliceOfString) Next() OptionForSliceOfString {
+	if p.flag {
+		return NoneSliceOfString()
+	}
+
+	item, ok := p.next()
+	if !ok {
+		p.release()
+		return NoneSliceOfString()
+	}
+
+	return SomeSliceOfString(item)
+}
+
+func (p *pullForSliceOfString) SizeHint() (uint, OptionForUint) {
+	if p.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (p *pullForSliceOfString) Close() error {
+	p.release()
+	return nil
+}
+
+func (p *pullForSliceOfString) release() {
+	if !p.flag {
+		p.flag = true
+		p.stop()
+	}
+}
+
+var _ IterableForSliceOfString = &pullForSliceOfString{}
+
+// Seq returns a standard library sequence yielding the elements of the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) Seq() iter.Seq[SliceOfEntryForStringToInt] {
+	return func(yield func(SliceOfEntryForStringToInt) bool) {
+		defer i.Close()
+
+		item := i.Next()
+		for item.IsSome() {
+			if !yield(item.Unwrap()) {
+				return
+			}
+
+			item = i.Next()
+		}
+	}
+}
+
+// Seq2 returns a standard library sequence yielding the elements of the Iterator along with their position.
+func (i IteratorForSliceOfEntryForStringToInt) Seq2() iter.Seq2[int, SliceOfEntryForStringToInt] {
+	return func(yield func(int, SliceOfEntryForStringToInt) bool) {
+		defer i.Close()
+
+		position := 0
+
+		item := i.Next()
+		for item.IsSome() {
+			if !yield(position, item.Unwrap()) {
+				return
+			}
+
+			position++
+			item = i.Next()
+		}
+	}
+}
+
+// FromSeqSliceOfEntryForStringToInt builds an Iterator pulling its elements from a standard library sequence.
+// The returned stop function, like the Iterator Close method, must be called if it is not consumed until its end.
+func FromSeqSliceOfEntryForStringToInt(seq iter.Seq[SliceOfEntryForStringToInt]) (IteratorForSliceOfEntryForStringToInt, func()) {
+	return FromPullSliceOfEntryForStringToInt(iter.Pull(seq))
+}
+
+// FromPullSliceOfEntryForStringToInt builds an Iterator from next and stop functions such as the ones returned by iter.Pull.
+// stop is called once the Iterator is exhausted, or when the returned function is called.
+func FromPullSliceOfEntryForStringToInt(next func() (SliceOfEntryForStringToInt, bool), stop func()) (IteratorForSliceOfEntryForStringToInt, func()) {
+	p := &pullForSliceOfEntryForStringToInt{next: next, stop: stop, flag: false}
+
+	return IteratorForSliceOfEntryForStringToInt{iter: p}, p.release
+}
+
+type pullForSliceOfEntryForStringToInt struct {
+	next func() (SliceOfEntryForStringToInt, bool)
+	stop func()
+	flag bool
+}
+
+func (p *pullForSliceOfEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	if p.flag {
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	item, ok := p.next()
+	if !ok {
+		p.release()
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	return SomeSliceOfEntryForStringToInt(item)
+}
+
+func (p *pullForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if p.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (p *pullForSliceOfEntryForStringToInt) Close() error {
+	p.release()
+	return nil
+}
+
+func (p *pullForSliceOfEntryForStringToInt) release() {
+	if !p.flag {
+		p.flag = true
+		p.stop()
+	}
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &pullForSliceOfEntryForStringToInt{}
+
+// Seq returns a standard library sequence yielding the elements of the Iterator.
+func (i IteratorForCSVRecord) Seq() iter.Seq[CSVRecord] {
+	return func(yield func(CSVRecord) bool) {
+		defer i.Close()
+
+		item := i.Next()
+		for item.IsSome() {
+			if !yield(item.Unwrap()) {
+				return
+			}
+
+			item = i.Next()
+		}
+	}
+}
+
+// Seq2 returns a standard library sequence yielding the elements of the Iterator along with their position.
+func (i IteratorForCSVRecord) Seq2() iter.Seq2[int, CSVRecord] {
+	return func(yield func(int, CSVRecord) bool) {
+		defer i.Close()
+
+		position := 0
+
+		item := i.Next()
+		for item.IsSome() {
+			if !yield(position, item.Unwrap()) {
+				return
+			}
+
+			position++
+			item = i.Next()
+		}
+	}
+}
+
+// FromSeqCSVRecord builds an Iterator pulling its elements from a standard library sequence.
+// The returned stop function, like the Iterator Close method, must be called if it is not consumed until its end.
+func FromSeqCSVRecord(seq iter.Seq[CSVRecord]) (IteratorForCSVRecord, func()) {
+	return FromPullCSVRecord(iter.Pull(seq))
+}
+
+// FromPullCSVRecord builds an Iterator from next and stop functions such as the ones returned by iter.Pull.
+// stop is called once the Iterator is exhausted, or when the returned function is called.
+func FromPullCSVRecord(next func() (CSVRecord, bool), stop func()) (IteratorForCSVRecord, func()) {
+	p := &pullForCSVRecord{next: next, stop: stop, flag: false}
+
+	return IteratorForCSVRecord{iter: p}, p.release
+}
+
+type pullForCSVRecord struct {
+	next func() (CSVRecord, bool)
+	stop func()
+	flag bool
+}
+
+func (p *pullForCSVRecord) Next() OptionForCSVRecord {
+	if p.flag {
+		return NoneCSVRecord()
+	}
+
+	item, ok := p.next()
+	if !ok {
+		p.release()
+		return NoneCSVRecord()
+	}
+
+	return SomeCSVRecord(item)
+}
+
+func (p *pullForCSVRecord) SizeHint() (uint, OptionForUint) {
+	if p.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (p *pullForCSVRecord) Close() error {
+	p.release()
+	return nil
+}
+
+func (p *pullForCSVRecord) release() {
+	if !p.flag {
+		p.flag = true
+		p.stop()
+	}
+}
+
+var _ IterableForCSVRecord = &pullForCSVRecord{}
@@ -0,0 +1,122 @@
+package iter
+
+import (
+	"iter"
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestSeq(t *testing.T) {
+	testCases := map[IteratorForInt][]int{
+		Range(0, 0, 1):                  {},
+		Range(0, 4, 1):                  {0, 1, 2, 3},
+		VectorOfInt([]int{0, 1, -2, 3}): {0, 1, -2, 3},
+	}
+
+	for iter, want := range testCases {
+		got := []int{}
+		for item := range iter.Seq() {
+			got = append(got, item)
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("case: %s; got: %v; expected: %v", iter, got, want)
+		}
+	}
+}
+
+func TestSeqBreak(t *testing.T) {
+	iter := Range(0, 10, 1)
+
+	for item := range iter.Seq() {
+		if item == 3 {
+			break
+		}
+	}
+
+	if got := iter.Next(); !reflect.DeepEqual(got, SomeInt(4)) {
+		t.Errorf("got: %v; expected: %v", got, SomeInt(4))
+	}
+}
+
+func TestSeq2(t *testing.T) {
+	got := map[int]string{}
+	for position, item := range VectorOfString([]string{"a", "b", "c"}).Seq2() {
+		got[position] = item
+	}
+
+	want := map[int]string{0: "a", 1: "b", 2: "c"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; expected: %v", got, want)
+	}
+}
+
+func TestSeqWithStandardLibrary(t *testing.T) {
+	got := slices.Collect(Range(0, 4, 1).Seq())
+
+	want := []int{0, 1, 2, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; expected: %v", got, want)
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	iter, stop := FromSeqString(maps.Keys(map[string]int{"a": 0, "b": 1, "c": 2}))
+	defer stop()
+
+	got := slices.Sorted(iter.Seq())
+
+	want := []string{"a", "b", "c"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; expected: %v", got, want)
+	}
+}
+
+func TestFromSeqStop(t *testing.T) {
+	stopped := false
+	seq := func(yield func(int) bool) {
+		defer func() {
+			stopped = true
+		}()
+
+		for k := 0; yield(k); k++ {
+		}
+	}
+
+	iter, stop := FromSeqInt(seq)
+
+	got := iter.Take(3).Collect()
+	want := []int{0, 1, 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; expected: %v", got, want)
+	}
+
+	stop()
+	stop()
+
+	if !stopped {
+		t.Errorf("the sequence should have been stopped")
+	}
+
+	if got := iter.Next(); !reflect.DeepEqual(got, NoneInt()) {
+		t.Errorf("got: %v; expected: %v", got, NoneInt())
+	}
+}
+
+func TestFromPull(t *testing.T) {
+	stops := 0
+	next, stop := iter.Pull(slices.Values([]int{0, 1, 2}))
+
+	pulled, _ := FromPullInt(next, func() {
+		stops++
+		stop()
+	})
+
+	got := pulled.Collect()
+	want := []int{0, 1, 2}
+	if !reflect.DeepEqual(got, want) || stops != 1 {
+		t.Errorf("got: %v, %d stops; expected: %v, 1 stop", got, stops, want)
+	}
+}
@@ -0,0 +1,100 @@
+package iter
+
+import (
+	"reflect"
+	"testing"
+)
+
+// ring keeps the last elements pushed to it.
+type ring struct {
+	items []int
+	next  int
+	full  bool
+}
+
+func (r *ring) Push(item int) {
+	r.items[r.next] = item
+	r.next = (r.next + 1) % len(r.items)
+	r.full = r.full || r.next == 0
+}
+
+func (r *ring) contents() []int {
+	if !r.full {
+		return append([]int{}, r.items[:r.next]...)
+	}
+
+	return append(append([]int{}, r.items[r.next:]...), r.items[:r.next]...)
+}
+
+func TestAppendTo(t *testing.T) {
+	testCases := map[IteratorForInt][]int{
+		VectorOfInt([]int{}):           {7},
+		VectorOfInt([]int{1, 2, 3}):    {7, 1, 2, 3},
+		Range(0, 10, 3):                {7, 0, 3, 6, 9},
+		Range(0, 10, 1).Filter(isEven): {7, 0, 2, 4, 6, 8},
+	}
+
+	for iter, want := range testCases {
+		got := iter.AppendTo([]int{7})
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("case: %v; got: %v; expected: %v", iter, got, want)
+		}
+	}
+}
+
+func TestAppendToReusesBuffer(t *testing.T) {
+	buffer := make([]int, 0, 8)
+	iter := VectorOfInt([]int{1, 2, 3, 4})
+
+	allocs := testing.AllocsPerRun(100, func() {
+		iter.Reset()
+		buffer = iter.AppendTo(buffer[:0])
+	})
+
+	want := []int{1, 2, 3, 4}
+	if !reflect.DeepEqual(buffer, want) || cap(buffer) != 8 || allocs != 0 {
+		t.Errorf("got: (%v, capacity %d, %v allocations); expected: (%v, capacity %d, %v)", buffer, cap(buffer), allocs, want, 8, 0)
+	}
+}
+
+func TestAppendToGrowsOnce(t *testing.T) {
+	buffer := make([]int, 1, 2)
+
+	got := VectorOfInt([]int{1, 2, 3, 4}).AppendTo(buffer)
+
+	want := []int{0, 1, 2, 3, 4}
+	if !reflect.DeepEqual(got, want) || cap(got) != 5 {
+		t.Errorf("got: (%v, capacity %d); expected: (%v, capacity %d)", got, cap(got), want, 5)
+	}
+}
+
+func TestExtend(t *testing.T) {
+	testCases := map[IteratorForInt][]int{
+		VectorOfInt([]int{}):        {},
+		VectorOfInt([]int{1, 2}):    {1, 2},
+		Range(0, 10, 1):             {7, 8, 9},
+		VectorOfInt([]int{4, 5, 6}): {4, 5, 6},
+	}
+
+	for iter, want := range testCases {
+		sink := &ring{items: make([]int, 3)}
+		iter.Extend(sink)
+
+		if got := sink.contents(); !reflect.DeepEqual(got, want) {
+			t.Errorf("case: %v; got: %v; expected: %v", iter, got, want)
+		}
+	}
+}
+
+func TestSinksClose(t *testing.T) {
+	appended := &closeableInts{items: []int{1, 2}}
+	FromIterableInt(appended).AppendTo(nil)
+
+	extended := &closeableInts{items: []int{1, 2}}
+	FromIterableInt(extended).Extend(&ring{items: make([]int, 1)})
+
+	if appended.closes != 1 || extended.closes != 1 {
+		t.Errorf("got: (%d, %d) closes; expected: (%d, %d)", appended.closes, extended.closes, 1, 1)
+	}
+}
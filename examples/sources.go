@@ -0,0 +1,831 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package iter
+
+// RepeatInt builds an Iterator endlessly yielding the same value.
+func RepeatInt(value int) IteratorForInt {
+	return IteratorForInt{
+		iter: &repeatForInt{value: value},
+	}
+}
+
+// RepeatNInt builds an Iterator yielding the same value n times.
+func RepeatNInt(value int, n uint) IteratorForInt {
+	return IteratorForInt{
+		iter: &repeatNForInt{value: value, remaining: n},
+	}
+}
+
+// OnceInt builds an Iterator yielding a single value.
+func OnceInt(value int) IteratorForInt {
+	return RepeatNInt(value, 1)
+}
+
+// EmptyInt builds an Iterator yielding nothing.
+func EmptyInt() IteratorForInt {
+	return VectorOfInt(nil)
+}
+
+// FromIterableInt builds an Iterator from any Iterable, which may also implement io.Closer.
+func FromIterableInt(iter IterableForInt) IteratorForInt {
+	return IteratorForInt{iter: iter}
+}
+
+// FromFuncInt builds an Iterator calling f to get each of its elements.
+// The Iterator ends when f returns None.
+func FromFuncInt(f func() OptionForInt) IteratorForInt {
+	return IteratorForInt{
+		iter: &fromFuncForInt{f: f},
+	}
+}
+
+// SuccessorsInt builds an Iterator starting from first and computing each next element from the previous one.
+// The Iterator ends when next returns None.
+func SuccessorsInt(first OptionForInt, next func(item int) OptionForInt) IteratorForInt {
+	return IteratorForInt{
+		iter: &successorsForInt{item: first, next: next},
+	}
+}
+
+type repeatForInt struct {
+	value int
+}
+
+func (r *repeatForInt) Next() OptionForInt {
+	return SomeInt(r.value)
+}
+
+func (r *repeatForInt) SizeHint() (uint, OptionForUint) {
+	return ^uint(0), NoneUint()
+}
+
+var _ IterableForInt = &repeatForInt{}
+
+type repeatNForInt struct {
+	value     int
+	remaining uint
+}
+
+func (r *repeatNForInt) Next() OptionForInt {
+	if r.remaining == 0 {
+		return NoneInt()
+	}
+
+	r.remaining--
+
+	return SomeInt(r.value)
+}
+
+func (r *repeatNForInt) SizeHint() (uint, OptionForUint) {
+	return r.remaining, SomeUint(r.remaining)
+}
+
+var _ IterableForInt = &repeatNForInt{}
+
+type fromFuncForInt struct {
+	f func() OptionForInt
+}
+
+func (f *fromFuncForInt) Next() OptionForInt {
+	return f.f()
+}
+
+func (f *fromFuncForInt) SizeHint() (uint, OptionForUint) {
+	return 0, NoneUint()
+}
+
+var _ IterableForInt = &fromFuncForInt{}
+
+type successorsForInt struct {
+	item OptionForInt
+	next func(item int) OptionForInt
+}
+
+func (s *successorsForInt) Next() OptionForInt {
+	item := s.item
+	if item.IsSome() {
+		s.item = s.next(item.Unwrap())
+	}
+
+	return item
+}
+
+func (s *successorsForInt) SizeHint() (uint, OptionForUint) {
+	if s.item.IsNone() {
+		return 0, SomeUint(0)
+	}
+
+	return 1, NoneUint()
+}
+
+var _ IterableForInt = &successorsForInt{}
+
+// RepeatString builds an Iterator endlessly yielding the same value.
+func RepeatString(value string) IteratorForString {
+	return IteratorForString{
+		iter: &repeatForString{value: value},
+	}
+}
+
+// RepeatNString builds an Iterator yielding the same value n times.
+func RepeatNString(value string, n uint) IteratorForString {
+	return IteratorForString{
+		iter: &repeatNForString{value: value, remaining: n},
+	}
+}
+
+// OnceString builds an Iterator yielding a single value.
+func OnceString(value string) IteratorForString {
+	return RepeatNString(value, 1)
+}
+
+// EmptyString builds an Iterator yielding nothing.
+func EmptyString() IteratorForString {
+	return VectorOfString(nil)
+}
+
+// FromIterableString builds an Iterator from any Iterable, which may also implement io.Closer.
+func FromIterableString(iter IterableForString) IteratorForString {
+	return IteratorForString{iter: iter}
+}
+
+// FromFuncString builds an Iterator calling f to get each of its elements.
+// The Iterator ends when f returns None.
+func FromFuncString(f func() OptionForString) IteratorForString {
+	return IteratorForString{
+		iter: &fromFuncForString{f: f},
+	}
+}
+
+// SuccessorsString builds an Iterator starting from first and computing each next element from the previous one.
+// The Iterator ends when next returns None.
+func SuccessorsString(first OptionForString, next func(item string) OptionForString) IteratorForString {
+	return IteratorForString{
+		iter: &successorsForString{item: first, next: next},
+	}
+}
+
+type repeatForString struct {
+	value string
+}
+
+func (r *repeatForString) Next() OptionForString {
+	return SomeString(r.value)
+}
+
+func (r *repeatForString) SizeHint() (uint, OptionForUint) {
+	return ^uint(0), NoneUint()
+}
+
+var _ IterableForString = &repeatForString{}
+
+type repeatNForString struct {
+	value     string
+	remaining uint
+}
+
+func (r *repeatNForString) Next() OptionForString {
+	if r.remaining == 0 {
+		return NoneString()
+	}
+
+	r.remaining--
+
+	return SomeString(r.value)
+}
+
+func (r *repeatNForString) SizeHint() (uint, OptionForUint) {
+	return r.remaining, SomeUint(r.remaining)
+}
+
+var _ IterableForString = &repeatNForString{}
+
+type fromFuncForString struct {
+	f func() OptionForString
+}
+
+func (f *fromFuncForString) Next() OptionForString {
+	return f.f()
+}
+
+func (f *fromFuncForString) SizeHint() (uint, OptionForUint) {
+	return 0, NoneUint()
+}
+
+var _ IterableForString = &fromFuncForString{}
+
+type successorsForString struct {
+	item OptionForString
+	next func(item string) OptionForString
+}
+
+func (s *successorsForString) Next() OptionForString {
+	item := s.item
+	if item.IsSome() {
+		s.item = s.next(item.Unwrap())
+	}
+
+	return item
+}
+
+func (s *successorsForString) SizeHint() (uint, OptionForUint) {
+	if s.item.IsNone() {
+		return 0, SomeUint(0)
+	}
+
+	return 1, NoneUint()
+}
+
+var _ IterableForString = &successorsForString{}
+
+// RepeatEntryForStringToInt builds an Iterator endlessly yielding the same value.
+func RepeatEntryForStringToInt(value EntryForStringToInt) IteratorForEntryForStringToInt {
+	return IteratorForEntryForStringToInt{
+		iter: &repeatForEntryForStringToInt{value: value},
+	}
+}
+
+// RepeatNEntryForStringToInt builds an Iterator yielding the same value n times.
+func RepeatNEntryForStringToInt(value EntryForStringToInt, n uint) IteratorForEntryForStringToInt {
+	return IteratorForEntryForStringToInt{
+		iter: &repeatNForEntryForStringToInt{value: value, remaining: n},
+	}
+}
+
+// OnceEntryForStringToInt builds an Iterator yielding a single value.
+func OnceEntryForStringToInt(value EntryForStringToInt) IteratorForEntryForStringToInt {
+	return RepeatNEntryForStringToInt(value, 1)
+}
+
+// EmptyEntryForStringToInt builds an Iterator yielding nothing.
+func EmptyEntryForStringToInt() IteratorForEntryForStringToInt {
+	return VectorOfEntryForStringToInt(nil)
+}
+
+// FromIterableEntryForStringToInt builds an Iterator from any Iterable, which may also implement io.Closer.
+func FromIterableEntryForStringToInt(iter IterableForEntryForStringToInt) IteratorForEntryForStringToInt {
+	return IteratorForEntryForStringToInt{iter: iter}
+}
+
+// FromFuncEntryForStringToInt builds an Iterator calling f to get each of its elements.
+// The Iterator ends when f returns None.
+func FromFuncEntryForStringToInt(f func() OptionForEntryForStringToInt) IteratorForEntryForStringToInt {
+	return IteratorForEntryForStringToInt{
+		iter: &fromFuncForEntryForStringToInt{f: f},
+	}
+}
+
+// SuccessorsEntryForStringToInt builds an Iterator starting from first and computing each next element from the previous one.
+// The Iterator ends when next returns None.
+func SuccessorsEntryForStringToInt(first OptionForEntryForStringToInt, next func(item EntryForStringToInt) OptionForEntryForStringToInt) IteratorForEntryForStringToInt {
+	return IteratorForEntryForStringToInt{
+		iter: &successorsForEntryForStringToInt{item: first, next: next},
+	}
+}
+
+type repeatForEntryForStringToInt struct {
+	value EntryForStringToInt
+}
+
+func (r *repeatForEntryForStringToInt) Next() OptionForEntryForStringToInt {
+	return SomeEntryForStringToInt(r.value)
+}
+
+func (r *repeatForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	return ^uint(0), NoneUint()
+}
+
+var _ IterableForEntryForStringToInt = &repeatForEntryForStringToInt{}
+
+type repeatNForEntryForStringToInt struct {
+	value     EntryForStringToInt
+	remaining uint
+}
+
+func (r *repeatNForEntryForStringToInt) Next() OptionForEntryForStringToInt {
+	if r.remaining == 0 {
+		return NoneEntryForStringToInt()
+	}
+
+	r.remaining--
+
+	return SomeEntryForStringToInt(r.value)
+}
+
+func (r *repeatNForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	return r.remaining, SomeUint(r.remaining)
+}
+
+var _ IterableForEntryForStringToInt = &repeatNForEntryForStringToInt{}
+
+type fromFuncForEntryForStringToInt struct {
+	f func() OptionForEntryForStringToInt
+}
+
+func (f *fromFuncForEntryForStringToInt) Next() OptionForEntryForStringToInt {
+	return f.f()
+}
+
+func (f *fromFuncForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	return 0, NoneUint()
+}
+
+var _ IterableForEntryForStringToInt = &fromFuncForEntryForStringToInt{}
+
+type successorsForEntryForStringToInt struct {
+	item OptionForEntryForStringToInt
+	next func(item EntryForStringToInt) OptionForEntryForStringToInt
+}
+
+func (s *successorsForEntryForStringToInt) Next() OptionForEntryForStringToInt {
+	item := s.item
+	if item.IsSome() {
+		s.item = s.next(item.Unwrap())
+	}
+
+	return item
+}
+
+func (s *successorsForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if s.item.IsNone() {
+		return 0, SomeUint(0)
+	}
+
+	return 1, NoneUint()
+}
+
+var _ IterableForEntryForStringToInt = &successorsForEntryForStringToInt{}
+
+// RepeatSliceOfInt builds an Iterator endlessly yielding the same value.
+func RepeatSliceOfInt(value SliceOfInt) IteratorForSliceOfInt {
+	return IteratorForSliceOfInt{
+		iter: &repeatForSliceOfInt{value: value},
+	}
+}
+
+// RepeatNSliceOfInt builds an Iterator yielding the same value n times.
+func RepeatNSliceOfInt(value SliceOfInt, n uint) IteratorForSliceOfInt {
+	return IteratorForSliceOfInt{
+		iter: &repeatNForSliceOfInt{value: value, remaining: n},
+	}
+}
+
+// OnceSliceOfInt builds an Iterator yielding a single value.
+func OnceSliceOfInt(value SliceOfInt) IteratorForSliceOfInt {
+	return RepeatNSliceOfInt(value, 1)
+}
+
+// EmptySliceOfInt builds an Iterator yielding nothing.
+func EmptySliceOfInt() IteratorForSliceOfInt {
+	return VectorOfSliceOfInt(nil)
+}
+
+// FromIterableSliceOfInt builds an Iterator from any Iterable, which may also implement io.Closer.
+func FromIterableSliceOfInt(iter IterableForSliceOfInt) IteratorForSliceOfInt {
+	return IteratorForSliceOfInt{iter: iter}
+}
+
+// FromFuncSliceOfInt builds an Iterator calling f to get each of its elements.
+// The Iterator ends when f returns None.
+func FromFuncSliceOfInt(f func() OptionForSliceOfInt) IteratorForSliceOfInt {
+	return IteratorForSliceOfInt{
+		iter: &fromFuncForSliceOfInt{f: f},
+	}
+}
+
+// SuccessorsSliceOfInt builds an Iterator starting from first and computing each next element from the previous one.
+// The Iterator ends when next returns None.
+func SuccessorsSliceOfInt(first OptionForSliceOfInt, next func(item SliceOfInt) OptionForSliceOfInt) IteratorForSliceOfInt {
+	return IteratorForSliceOfInt{
+		iter: &successorsForSliceOfInt{item: first, next: next},
+	}
+}
+
+type repeatForSliceOfInt struct {
+	value SliceOfInt
+}
+
+func (r *repeatForSliceOfInt) Next() OptionForSliceOfInt {
+	return SomeSliceOfInt(r.value)
+}
+
+func (r *repeatForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	return ^uint(0), NoneUint()
+}
+
+var _ IterableForSliceOfInt = &repeatForSliceOfInt{}
+
+type repeatNForSliceOfInt struct {
+	value     SliceOfInt
+	remaining uint
+}
+
+func (r *repeatNForSliceOfInt) Next() OptionForSliceOfInt {
+	if r.remaining == 0 {
+		return NoneSliceOfInt()
+	}
+
+	r.remaining--
+
+	return SomeSliceOfInt(r.value)
+}
+
+func (r *repeatNForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	return r.remaining, SomeUint(r.remaining)
+}
+
+var _ IterableForSliceOfInt = &repeatNForSliceOfInt{}
+
+type fromFuncForSliceOfInt struct {
+	f func() OptionForSliceOfInt
+}
+
+func (f *fromFuncForSliceOfInt) Next() OptionForSliceOfInt {
+	return f.f()
+}
+
+func (f *fromFuncForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	return 0, NoneUint()
+}
+
+var _ IterableForSliceOfInt = &fromFuncForSliceOfInt{}
+
+type successorsForSliceOfInt struct {
+	item OptionForSliceOfInt
+	next func(item SliceOfInt) OptionForSliceOfInt
+}
+
+func (s *successorsForSliceOfInt) Next() OptionForSliceOfInt {
+	item := s.item
+	if item.IsSome() {
+		s.item = s.next(item.Unwrap())
+	}
+
+	return item
+}
+
+func (s *successorsForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	if s.item.IsNone() {
+		return 0, SomeUint(0)
+	}
+
+	return 1, NoneUint()
+}
+
+var _ IterableForSliceOfInt = &successorsForSliceOfInt{}
+
+// RepeatSliceOfString builds an Iterator endlessly yielding the same value.
+func RepeatSliceOfString(value SliceOfString) IteratorForSliceOfString {
+	return IteratorForSliceOfString{
+		iter: &repeatForSliceOfString{value: value},
+	}
+}
+
+// RepeatNSliceOfString builds an Iterator yielding the same value n times.
+func RepeatNSliceOfString(value SliceOfString, n uint) IteratorForSliceOfString {
+	return IteratorForSliceOfString{
+		iter: &repeatNForSliceOfString{value: value, remaining: n},
+	}
+}
+
+// OnceSliceOfString builds an Iterator yielding a single value.
+func OnceSliceOfString(value SliceOfString) IteratorForSliceOfString {
+	return RepeatNSliceOfString(value, 1)
+}
+
+// EmptySliceOfString builds an Iterator yielding nothing.
+func EmptySliceOfString() IteratorForSliceOfString {
+	return VectorOfSliceOfString(nil)
+}
+
+// FromIterableSliceOfString builds an Iterator from any Iterable, which may also implement io.Closer.
+func FromIterableSliceOfString(iter IterableForSliceOfString) IteratorForSliceOfString {
+	return IteratorForSliceOfString{iter: iter}
+}
+
+// FromFuncSliceOfString builds an Iterator calling f to get each of its elements.
+// The Iterator ends when f returns None.
+func FromFuncSliceOfString(f func() OptionForSliceOfString) IteratorForSliceOfString {
+	return IteratorForSliceOfString{
+		iter: &fromFuncForSliceOfString{f: f},
+	}
+}
+
+// SuccessorsSliceOfString builds an Iterator starting from first and computing each next element from the previous one.
+// The Iterator ends when next returns None.
+func SuccessorsSliceOfString(first OptionForSliceOfString, next func(item SliceOfString) OptionForSliceOfString) IteratorForSliceOfString {
+	return IteratorForSliceOfString{
+		iter: &successorsForSliceOfString{item: first, next: next},
+	}
+}
+
+type repeatForSliceOfString struct {
+	value SliceOfString
+}
+
+func (r *repeatForSliceOfString) Next() OptionForSliceOfString {
+	return SomeSliceOfString(r.value)
+}
+
+func (r *repeatForSliceOfString) SizeHint() (uint, OptionForUint) {
+	return ^uint(0), NoneUint()
+}
+
+var _ IterableForSliceOfString = &repeatForSliceOfString{}
+
+type repeatNForSliceOfString struct {
+	value     SliceOfString
+	remaining uint
+}
+
+func (r *repeatNForSliceOfString) Next() OptionForSliceOfString {
+	if r.remaining == 0 {
+		return NoneSliceOfString()
+	}
+
+	r.remaining--
+
+	return SomeSliceOfString(r.value)
+}
+
+func (r *repeatNForSliceOfString) SizeHint() (uint, OptionForUint) {
+	return r.remaining, SomeUint(r.remaining)
+}
+
+var _ IterableForSliceOfString = &repeatNForSliceOfString{}
+
+type fromFuncForSliceOfString struct {
+	f func() OptionForSliceOfString
+}
+
+func (f *fromFuncForSliceOfString) Next() OptionForSliceOfString {
+	return f.f()
+}
+
+func (f *fromFuncForSliceOfString) SizeHint() (uint, OptionForUint) {
+	return 0, NoneUint()
+}
+
+var _ IterableForSliceOfString = &fromFuncForSliceOfString{}
+
+type successorsForSliceOfString struct {
+	item OptionForSliceOfString
+	next func(item SliceOfString) OptionForSliceOfString
+}
+
+func (s *successorsForSliceOfString) Next() OptionForSliceOfString {
+	item := s.item
+	if item.IsSome() {
+		s.item = s.next(item.Unwrap())
+	}
+
+	return item
+}
+
+func (s *successorsForSliceOfString) SizeHint() (uint, OptionForUint) {
+	if s.item.IsNone() {
+		return 0, SomeUint(0)
+	}
+
+	return 1, NoneUint()
+}
+
+var _ IterableForSliceOfString = &successorsForSliceOfString{}
+
+// RepeatSliceOfEntryForStringToInt builds an Iterator endlessly yielding the same value.
+func RepeatSliceOfEntryForStringToInt(value SliceOfEntryForStringToInt) IteratorForSliceOfEntryForStringToInt {
+	return IteratorForSliceOfEntryForStringToInt{
+		iter: &repeatForSliceOfEntryForStringToInt{value: value},
+	}
+}
+
+// RepeatNSliceOfEntryForStringToInt builds an Iterator yielding the same value n times.
+func RepeatNSliceOfEntryForStringToInt(value SliceOfEntryForStringToInt, n uint) IteratorForSliceOfEntryForStringToInt {
+	return IteratorForSliceOfEntryForStringToInt{
+		iter: &repeatNForSliceOfEntryForStringToInt{value: value, remaining: n},
+	}
+}
+
+// OnceSliceOfEntryForStringToInt builds an Iterator yielding a single value.
+func OnceSliceOfEntryForStringToInt(value SliceOfEntryForStringToInt) IteratorForSliceOfEntryForStringToInt {
+	return RepeatNSliceOfEntryForStringToInt(value, 1)
+}
+
+// EmptySliceOfEntryForStringToInt builds an Iterator yielding nothing.
+func EmptySliceOfEntryForStringToInt() IteratorForSliceOfEntryForStringToInt {
+	return VectorOfSliceOfEntryForStringToInt(nil)
+}
+
+// FromIterableSliceOfEntryForStringToInt builds an Iterator from any Iterable, which may also implement io.Closer.
+func FromIterableSliceOfEntryForStringToInt(iter IterableForSliceOfEntryForStringToInt) IteratorForSliceOfEntryForStringToInt {
+	return IteratorForSliceOfEntryForStringToInt{iter: iter}
+}
+
+// FromFuncSliceOfEntryForStringToInt builds an Iterator calling f to get each of its elements.
+// The Iterator ends when f returns None.
+func FromFuncSliceOfEntryForStringToInt(f func() OptionForSliceOfEntryForStringToInt) IteratorForSliceOfEntryForStringToInt {
+	return IteratorForSliceOfEntryForStringToInt{
+		iter: &fromFuncForSliceOfEntryForStringToInt{f: f},
+	}
+}
+
+// SuccessorsSliceOfEntryForStringToInt builds an Iterator starting from first and computing each next element from the previous one.
+// The Iterator ends when next returns None.
+func SuccessorsSliceOfEntryForStringToInt(first OptionForSliceOfEntryForStringToInt, next func(item SliceOfEntryForStringToInt) OptionForSliceOfEntryForStringToInt) IteratorForSliceOfEntryForStringToInt {
+	return IteratorForSliceOfEntryForStringToInt{
+		iter: &successorsForSliceOfEntryForStringToInt{item: first, next: next},
+	}
+}
+
+type repeatForSliceOfEntryForStringToInt struct {
+	value SliceOfEntryForStringToInt
+}
+
+func (r *repeatForSliceOfEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	return SomeSliceOfEntryForStringToInt(r.value)
+}
+
+func (r *repeatForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	return ^uint(0), NoneUint()
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &repeatForSliceOfEntryForStringToInt{}
+
+type repeatNForSliceOfEntryForStringToInt struct {
+	value     SliceOfEntryForStringToInt
+	remaining uint
+}
+
+func (r *repeatNForSliceOfEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	if r.remaining == 0 {
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	r.remaining--
+
+	return SomeSliceOfEntryForStringToInt(r.value)
+}
+
+func (r *repeatNForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	return r.remaining, SomeUint(r.remaining)
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &repeatNForSliceOfEntryForStringToInt{}
+
+type fromFuncForSliceOfEntryForStringToInt struct {
+	f func() OptionForSliceOfEntryForStringToInt
+}
+
+func (f *fromFuncForSliceOfEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	return f.f()
+}
+
+func (f *fromFuncForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	return 0, NoneUint()
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &fromFuncForSliceOfEntryForStringToInt{}
+
+type successorsForSliceOfEntryForStringToInt struct {
+	item OptionForSliceOfEntryForStringToInt
+	next func(item SliceOfEntryForStringToInt) OptionForSliceOfEntryForStringToInt
+}
+
+func (s *successorsForSliceOfEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	item := s.item
+	if item.IsSome() {
+		s.item = s.next(item.Unwrap())
+	}
+
+	return item
+}
+
+func (s *successorsForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if s.item.IsNone() {
+		return 0, SomeUint(0)
+	}
+
+	return 1, NoneUint()
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &successorsForSliceOfEntryForStringToInt{}
+
+// RepeatCSVRecord builds an Iterator endlessly yielding the same value.
+func RepeatCSVRecord(value CSVRecord) IteratorForCSVRecord {
+	return IteratorForCSVRecord{
+		iter: &repeatForCSVRecord{value: value},
+	}
+}
+
+// RepeatNCSVRecord builds an Iterator yielding the same value n times.
+func RepeatNCSVRecord(value CSVRecord, n uint) IteratorForCSVRecord {
+	return IteratorForCSVRecord{
+		iter: &repeatNForCSVRecord{value: value, remaining: n},
+	}
+}
+
+// OnceCSVRecord builds an Iterator yielding a single value.
+func OnceCSVRecord(value CSVRecord) IteratorForCSVRecord {
+	return RepeatNCSVRecord(value, 1)
+}
+
+// EmptyCSVRecord builds an Iterator yielding nothing.
+func EmptyCSVRecord() IteratorForCSVRecord {
+	return VectorOfCSVRecord(nil)
+}
+
+// FromIterableCSVRecord builds an Iterator from any Iterable, which may also implement io.Closer.
+func FromIterableCSVRecord(iter IterableForCSVRecord) IteratorForCSVRecord {
+	return IteratorForCSVRecord{iter: iter}
+}
+
+// FromFuncCSVRecord builds an Iterator calling f to get each of its elements.
+// The Iterator ends when f returns None.
+func FromFuncCSVRecord(f func() OptionForCSVRecord) IteratorForCSVRecord {
+	return IteratorForCSVRecord{
+		iter: &fromFuncForCSVRecord{f: f},
+	}
+}
+
+// SuccessorsCSVRecord builds an Iterator starting from first and computing each next element from the previous one.
+// The Iterator ends when next returns None.
+func SuccessorsCSVRecord(first OptionForCSVRecord, next func(item CSVRecord) OptionForCSVRecord) IteratorForCSVRecord {
+	return IteratorForCSVRecord{
+		iter: &successorsForCSVRecord{item: first, next: next},
+	}
+}
+
+type repeatForCSVRecord struct {
+	value CSVRecord
+}
+
+func (r *repeatForCSVRecord) Next() OptionForCSVRecord {
+	return SomeCSVRecord(r.value)
+}
+
+func (r *repeatForCSVRecord) SizeHint() (uint, OptionForUint) {
+	return ^uint(0), NoneUint()
+}
+
+var _ IterableForCSVRecord = &repeatForCSVRecord{}
+
+type repeatNForCSVRecord struct {
+	value     CSVRecord
+	remaining uint
+}
+
+func (r *repeatNForCSVRecord) Next() OptionForCSVRecord {
+	if r.remaining == 0 {
+		return NoneCSVRecord()
+	}
+
+	r.remaining--
+
+	return SomeCSVRecord(r.value)
+}
+
+func (r *repeatNForCSVRecord) SizeHint() (uint, OptionForUint) {
+	return r.remaining, SomeUint(r.remaining)
+}
+
+var _ IterableForCSVRecord = &repeatNForCSVRecord{}
+
+type fromFuncForCSVRecord struct {
+	f func() OptionForCSVRecord
+}
+
+func (f *fromFuncForCSVRecord) Next() OptionForCSVRecord {
+	return f.f()
+}
+
+func (f *fromFuncForCSVRecord) SizeHint() (uint, OptionForUint) {
+	return 0, NoneUint()
+}
+
+var _ IterableForCSVRecord = &fromFuncForCSVRecord{}
+
+type successorsForCSVRecord struct {
+	item OptionForCSVRecord
+	next func(item CSVRecord) OptionForCSVRecord
+}
+
+func (s *successorsForCSVRecord) Next() OptionForCSVRecord {
+	item := s.item
+	if item.IsSome() {
+		s.item = s.next(item.Unwrap())
+	}
+
+	return item
+}
+
+func (s *successorsForCSVRecord) SizeHint() (uint, OptionForUint) {
+	if s.item.IsNone() {
+		return 0, SomeUint(0)
+	}
+
+	return 1, NoneUint()
+}
+
+var _ IterableForCSVRecord = &successorsForCSVRecord{}
@@ -0,0 +1,132 @@
+package iter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSources(t *testing.T) {
+	testCases := map[IteratorForString][]string{
+		RepeatString("a").Take(3):              {"a", "a", "a"},
+		RepeatNString("b", 0):                  {},
+		RepeatNString("b", 2):                  {"b", "b"},
+		OnceString("c"):                        {"c"},
+		EmptyString():                          {},
+		OnceString("d").Chain(OnceString("e")): {"d", "e"},
+	}
+
+	for iter, want := range testCases {
+		got := iter.Collect()
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("case: %s; got: %v; expected: %v", iter, got, want)
+		}
+	}
+}
+
+func TestSourcesSizeHint(t *testing.T) {
+	testCases := map[IteratorForString]uint{
+		RepeatString("a").Take(3): 3,
+		RepeatNString("b", 0):     0,
+		RepeatNString("b", 2):     2,
+		OnceString("c"):           1,
+		EmptyString():             0,
+	}
+
+	for iter, want := range testCases {
+		lower, upper := iter.SizeHint()
+
+		if lower != want || !reflect.DeepEqual(upper, SomeUint(want)) {
+			t.Errorf("case: %s; got: (%d, %v); expected: (%d, %v)", iter, lower, upper, want, SomeUint(want))
+		}
+	}
+
+	if lower, upper := RepeatString("a").SizeHint(); lower != ^uint(0) || upper.IsSome() {
+		t.Errorf("got: (%d, %v); expected an infinite SizeHint", lower, upper)
+	}
+}
+
+func TestCycle(t *testing.T) {
+	testCases := map[IteratorForInt][]int{
+		VectorOfInt([]int{}):                   {},
+		VectorOfInt([]int{0}):                  {0, 0, 0, 0, 0},
+		VectorOfInt([]int{0, 1, 2, 3}):         {0, 1, 2, 3, 0},
+		VectorOfInt([]int{0, 1, 2, 3}).Skip(2): {2, 3, 2, 3, 2},
+		Range(0, 0, 1):                         {},
+		Range(0, 3, 1):                         {0, 1, 2, 0, 1},
+		Range(0, 6, 1).Filter(isEven):          {0, 2, 4, 0, 2},
+	}
+
+	for iter, want := range testCases {
+		got := iter.Cycle().Take(5).Collect()
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("case: %s; got: %v; expected: %v", iter, got, want)
+		}
+	}
+}
+
+func TestCycleSizeHint(t *testing.T) {
+	if lower, upper := EmptyInt().Cycle().SizeHint(); lower != 0 || !reflect.DeepEqual(upper, SomeUint(0)) {
+		t.Errorf("got: (%d, %v); expected: (%d, %v)", lower, upper, 0, SomeUint(0))
+	}
+
+	if lower, upper := Range(0, 3, 1).Cycle().SizeHint(); lower != ^uint(0) || upper.IsSome() {
+		t.Errorf("got: (%d, %v); expected an infinite SizeHint", lower, upper)
+	}
+}
+
+func TestFromFunc(t *testing.T) {
+	pages := [][]string{{"a", "b"}, {"c"}, {}}
+
+	page := 0
+	got := FromFuncSliceOfString(func() OptionForSliceOfString {
+		if page >= len(pages) || len(pages[page]) == 0 {
+			return NoneSliceOfString()
+		}
+
+		page++
+
+		return SomeSliceOfString(pages[page-1])
+	}).Collect()
+
+	want := []SliceOfString{{"a", "b"}, {"c"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; expected: %v", got, want)
+	}
+}
+
+func TestSuccessors(t *testing.T) {
+	testCases := map[IteratorForInt][]int{
+		SuccessorsInt(NoneInt(), func(item int) OptionForInt { return SomeInt(item) }): {},
+		SuccessorsInt(SomeInt(1), func(item int) OptionForInt {
+			if item >= 100 {
+				return NoneInt()
+			}
+
+			return SomeInt(item * 10)
+		}): {1, 10, 100},
+	}
+
+	for iter, want := range testCases {
+		got := iter.Collect()
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("case: %s; got: %v; expected: %v", iter, got, want)
+		}
+	}
+}
+
+func TestUnfold(t *testing.T) {
+	got := UnfoldIntWithSliceOfInt(SliceOfInt{0, 1}, func(state *SliceOfInt) OptionForInt {
+		item := (*state)[0]
+		*state = SliceOfInt{(*state)[1], (*state)[0] + (*state)[1]}
+
+		return SomeInt(item)
+	}).Take(8).Collect()
+
+	want := []int{0, 1, 1, 2, 3, 5, 8, 13}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v; expected: %v", got, want)
+	}
+}
@@ -0,0 +1,430 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package iter
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// FromRowsInt builds a fallible Iterator yielding the rows of a query result, scanned one at a time into an Int.
+// The rows are closed once the Iterator is exhausted, fails or is closed. It stops at the first scan error, or else
+// yields the error of the rows, if any, as its last result.
+func FromRowsInt(rows *sql.Rows, scan func(rows *sql.Rows) (int, error)) TryIteratorForInt {
+	return TryIteratorForInt{
+		iter: &rowsForInt{rows: rows, scan: scan, index: 0, flag: false},
+	}
+}
+
+type rowsForInt struct {
+	rows  *sql.Rows
+	scan  func(rows *sql.Rows) (int, error)
+	index uint
+	flag  bool
+}
+
+func (r *rowsForInt) Next() OptionForResultForInt {
+	if r.flag {
+		return NoneResultForInt()
+	}
+
+	if !r.rows.Next() {
+		err := r.rows.Err()
+		if closeErr := r.Close(); err == nil {
+			err = closeErr
+		}
+
+		if err != nil {
+			return SomeResultForInt(ErrInt(fmt.Errorf("reading row %d: %w", r.index, err)))
+		}
+
+		return NoneResultForInt()
+	}
+
+	value, err := r.scan(r.rows)
+	if err != nil {
+		r.Close()
+		return SomeResultForInt(ErrInt(fmt.Errorf("scanning row %d: %w", r.index, err)))
+	}
+
+	r.index++
+
+	return SomeResultForInt(OkInt(value))
+}
+
+func (r *rowsForInt) SizeHint() (uint, OptionForUint) {
+	if r.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (r *rowsForInt) Close() error {
+	r.flag = true
+	return r.rows.Close()
+}
+
+var _ TryIterableForInt = &rowsForInt{}
+
+// FromRowsString builds a fallible Iterator yielding the rows of a query result, scanned one at a time into an String.
+// The rows are closed once the Iterator is exhausted, fails or is closed. It stops at the first scan error, or else
+// yields the error of the rows, if any, as its last result.
+func FromRowsString(rows *sql.Rows, scan func(rows *sql.Rows) (string, error)) TryIteratorForString {
+	return TryIteratorForString{
+		iter: &rowsForString{rows: rows, scan: scan, index: 0, flag: false},
+	}
+}
+
+type rowsForString struct {
+	rows  *sql.Rows
+	scan  func(rows *sql.Rows) (string, error)
+	index uint
+	flag  bool
+}
+
+func (r *rowsForString) Next() OptionForResultForString {
+	if r.flag {
+		return NoneResultForString()
+	}
+
+	if !r.rows.Next() {
+		err := r.rows.Err()
+		if closeErr := r.Close(); err == nil {
+			err = closeErr
+		}
+
+		if err != nil {
+			return SomeResultForString(ErrString(fmt.Errorf("reading row %d: %w", r.index, err)))
+		}
+
+		return NoneResultForString()
+	}
+
+	value, err := r.scan(r.rows)
+	if err != nil {
+		r.Close()
+		return SomeResultForString(ErrString(fmt.Errorf("scanning row %d: %w", r.index, err)))
+	}
+
+	r.index++
+
+	return SomeResultForString(OkString(value))
+}
+
+func (r *rowsForString) SizeHint() (uint, OptionForUint) {
+	if r.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (r *rowsForString) Close() error {
+	r.flag = true
+	return r.rows.Close()
+}
+
+var _ TryIterableForString = &rowsForString{}
+
+// FromRowsEntryForStringToInt builds a fallible Iterator yielding the rows of a query result, scanned one at a time into an EntryForStringToInt.
+// The rows are closed once the Iterator is exhausted, fails or is closed. It stops at the first scan error, or else
+// yields the error of the rows, if any, as its last result.
+func FromRowsEntryForStringToInt(rows *sql.Rows, scan func(rows *sql.Rows) (EntryForStringToInt, error)) TryIteratorForEntryForStringToInt {
+	return TryIteratorForEntryForStringToInt{
+		iter: &rowsForEntryForStringToInt{rows: rows, scan: scan, index: 0, flag: false},
+	}
+}
+
+type rowsForEntryForStringToInt struct {
+	rows  *sql.Rows
+	scan  func(rows *sql.Rows) (EntryForStringToInt, error)
+	index uint
+	flag  bool
+}
+
+func (r *rowsForEntryForStringToInt) Next() OptionForResultForEntryForStringToInt {
+	if r.flag {
+		return NoneResultForEntryForStringToInt()
+	}
+
+	if !r.rows.Next() {
+		err := r.rows.Err()
+		if closeErr := r.Close(); err == nil {
+			err = closeErr
+		}
+
+		if err != nil {
+			return SomeResultForEntryForStringToInt(ErrEntryForStringToInt(fmt.Errorf("reading row %d: %w", r.index, err)))
+		}
+
+		return NoneResultForEntryForStringToInt()
+	}
+
+	value, err := r.scan(r.rows)
+	if err != nil {
+		r.Close()
+		return SomeResultForEntryForStringToInt(ErrEntryForStringToInt(fmt.Errorf("scanning row %d: %w", r.index, err)))
+	}
+
+	r.index++
+
+	return SomeResultForEntryForStringToInt(OkEntryForStringToInt(value))
+}
+
+func (r *rowsForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if r.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (r *rowsForEntryForStringToInt) Close() error {
+	r.flag = true
+	return r.rows.Close()
+}
+
+var _ TryIterableForEntryForStringToInt = &rowsForEntryForStringToInt{}
+
+// FromRowsSliceOfInt builds a fallible Iterator yielding the rows of a query result, scanned one at a time into an SliceOfInt.
+// The rows are closed once the Iterator is exhausted, fails or is closed. It stops at the first scan error, or else
+// yields the error of the rows, if any, as its last result.
+func FromRowsSliceOfInt(rows *sql.Rows, scan func(rows *sql.Rows) (SliceOfInt, error)) TryIteratorForSliceOfInt {
+	return TryIteratorForSliceOfInt{
+		iter: &rowsForSliceOfInt{rows: rows, scan: scan, index: 0, flag: false},
+	}
+}
+
+type rowsForSliceOfInt struct {
+	rows  *sql.Rows
+	scan  func(rows *sql.Rows) (SliceOfInt, error)
+	index uint
+	flag  bool
+}
+
+func (r *rowsForSliceOfInt) Next() OptionForResultForSliceOfInt {
+	if r.flag {
+		return NoneResultForSliceOfInt()
+	}
+
+	if !r.rows.Next() {
+		err := r.rows.Err()
+		if closeErr := r.Close(); err == nil {
+			err = closeErr
+		}
+
+		if err != nil {
+			return SomeResultForSliceOfInt(ErrSliceOfInt(fmt.Errorf("reading row %d: %w", r.index, err)))
+		}
+
+		return NoneResultForSliceOfInt()
+	}
+
+	value, err := r.scan(r.rows)
+	if err != nil {
+		r.Close()
+		return SomeResultForSliceOfInt(ErrSliceOfInt(fmt.Errorf("scanning row %d: %w", r.index, err)))
+	}
+
+	r.index++
+
+	return SomeResultForSliceOfInt(OkSliceOfInt(value))
+}
+
+func (r *rowsForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	if r.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (r *rowsForSliceOfInt) Close() error {
+	r.flag = true
+	return r.rows.Close()
+}
+
+var _ TryIterableForSliceOfInt = &rowsForSliceOfInt{}
+
+// FromRowsSliceOfString builds a fallible Iterator yielding the rows of a query result, scanned one at a time into an SliceOfString.
+// The rows are closed once the Iterator is exhausted, fails or is closed. It stops at the first scan error, or else
+// yields the error of the rows, if any, as its last result.
+func FromRowsSliceOfString(rows *sql.Rows, scan func(rows *sql.Rows) (SliceOfString, error)) TryIteratorForSliceOfString {
+	return TryIteratorForSliceOfString{
+		iter: &rowsForSliceOfString{rows: rows, scan: scan, index: 0, flag: false},
+	}
+}
+
+type rowsForSliceOfString struct {
+	rows  *sql.Rows
+	scan  func(rows *sql.Rows) (SliceOfString, error)
+	index uint
+	flag  bool
+}
+
+func (r *rowsForSliceOfString) Next() OptionForResultForSliceOfString {
+	if r.flag {
+		return NoneResultForSliceOfString()
+	}
+
+	if !r.rows.Next() {
+		err := r.rows.Err()
+		if closeErr := r.Close(); err == nil {
+			err = closeErr
+		}
+
+		if err != nil {
+			return SomeResultForSliceOfString(ErrSliceOfString(fmt.Errorf("reading row %d: %w", r.index, err)))
+		}
+
+		return NoneResultForSliceOfString()
+	}
+
+	value, err := r.scan(r.rows)
+	if err != nil {
+		r.Close()
+		return SomeResultForSliceOfString(ErrSliceOfString(fmt.Errorf("scanning row %d: %w", r.index, err)))
+	}
+
+	r.index++
+
+	return SomeResultForSliceOfString(OkSliceOfString(value))
+}
+
+func (r *rowsForSliceOfString) SizeHint() (uint, OptionForUint) {
+	if r.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (r *rowsForSliceOfString) Close() error {
+	r.flag = true
+	return r.rows.Close()
+}
+
+var _ TryIterableForSliceOfString = &rowsForSliceOfString{}
+
+// FromRowsSliceOfEntryForStringToInt builds a fallible Iterator yielding the rows of a query result, scanned one at a time into an SliceOfEntryForStringToInt.
+// The rows are closed once the Iterator is exhausted, fails or is closed. It stops at the first scan error, or else
+// yields the error of the rows, if any, as its last result.
+func FromRowsSliceOfEntryForStringToInt(rows *sql.Rows, scan func(rows *sql.Rows) (SliceOfEntryForStringToInt, error)) TryIteratorForSliceOfEntryForStringToInt {
+	return TryIteratorForSliceOfEntryForStringToInt{
+		iter: &rowsForSliceOfEntryForStringToInt{rows: rows, scan: scan, index: 0, flag: false},
+	}
+}
+
+type rowsForSliceOfEntryForStringToInt struct {
+	rows  *sql.Rows
+	scan  func(rows *sql.Rows) (SliceOfEntryForStringToInt, error)
+	index uint
+	flag  bool
+}
+
+func (r *rowsForSliceOfEntryForStringToInt) Next() OptionForResultForSliceOfEntryForStringToInt {
+	if r.flag {
+		return NoneResultForSliceOfEntryForStringToInt()
+	}
+
+	if !r.rows.Next() {
+		err := r.rows.Err()
+		if closeErr := r.Close(); err == nil {
+			err = closeErr
+		}
+
+		if err != nil {
+			return SomeResultForSliceOfEntryForStringToInt(ErrSliceOfEntryForStringToInt(fmt.Errorf("reading row %d: %w", r.index, err)))
+		}
+
+		return NoneResultForSliceOfEntryForStringToInt()
+	}
+
+	value, err := r.scan(r.rows)
+	if err != nil {
+		r.Close()
+		return SomeResultForSliceOfEntryForStringToInt(ErrSliceOfEntryForStringToInt(fmt.Errorf("scanning row %d: %w", r.index, err)))
+	}
+
+	r.index++
+
+	return SomeResultForSliceOfEntryForStringToInt(OkSliceOfEntryForStringToInt(value))
+}
+
+func (r *rowsForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if r.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (r *rowsForSliceOfEntryForStringToInt) Close() error {
+	r.flag = true
+	return r.rows.Close()
+}
+
+var _ TryIterableForSliceOfEntryForStringToInt = &rowsForSliceOfEntryForStringToInt{}
+
+// FromRowsCSVRecord builds a fallible Iterator yielding the rows of a query result, scanned one at a time into an CSVRecord.
+// The rows are closed once the Iterator is exhausted, fails or is closed. It stops at the first scan error, or else
+// yields the error of the rows, if any, as its last result.
+func FromRowsCSVRecord(rows *sql.Rows, scan func(rows *sql.Rows) (CSVRecord, error)) TryIteratorForCSVRecord {
+	return TryIteratorForCSVRecord{
+		iter: &rowsForCSVRecord{rows: rows, scan: scan, index: 0, flag: false},
+	}
+}
+
+type rowsForCSVRecord struct {
+	rows  *sql.Rows
+	scan  func(rows *sql.Rows) (CSVRecord, error)
+	index uint
+	flag  bool
+}
+
+func (r *rowsForCSVRecord) Next() OptionForResultForCSVRecord {
+	if r.flag {
+		return NoneResultForCSVRecord()
+	}
+
+	if !r.rows.Next() {
+		err := r.rows.Err()
+		if closeErr := r.Close(); err == nil {
+			err = closeErr
+		}
+
+		if err != nil {
+			return SomeResultForCSVRecord(ErrCSVRecord(fmt.Errorf("reading row %d: %w", r.index, err)))
+		}
+
+		return NoneResultForCSVRecord()
+	}
+
+	value, err := r.scan(r.rows)
+	if err != nil {
+		r.Close()
+		return SomeResultForCSVRecord(ErrCSVRecord(fmt.Errorf("scanning row %d: %w", r.index, err)))
+	}
+
+	r.index++
+
+	return SomeResultForCSVRecord(OkCSVRecord(value))
+}
+
+func (r *rowsForCSVRecord) SizeHint() (uint, OptionForUint) {
+	if r.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (r *rowsForCSVRecord) Close() error {
+	r.flag = true
+	return r.rows.Close()
+}
+
+var _ TryIterableForCSVRecord = &rowsForCSVRecord{}
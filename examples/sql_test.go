@@ -0,0 +1,203 @@
+package iter
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var errBroken = errors.New("connection lost")
+
+// memoryTables are the results of the queries run on the memory driver, indexed by query.
+var memoryTables = map[string]memoryTable{
+	"numbers": {columns: []string{"n"}, rows: [][]driver.Value{{int64(1)}, {int64(2)}, {int64(3)}}, err: nil},
+	"words":   {columns: []string{"w"}, rows: [][]driver.Value{{"one"}, {"two"}}, err: nil},
+	"broken":  {columns: []string{"n"}, rows: [][]driver.Value{{int64(1)}}, err: errBroken},
+}
+
+type memoryTable struct {
+	columns []string
+	rows    [][]driver.Value
+	err     error
+}
+
+type memoryDriver struct{}
+
+func (memoryDriver) Open(name string) (driver.Conn, error) {
+	return memoryConn{}, nil
+}
+
+type memoryConn struct{}
+
+func (memoryConn) Prepare(query string) (driver.Stmt, error) {
+	table, ok := memoryTables[query]
+	if !ok {
+		return nil, fmt.Errorf("unknown table %q", query)
+	}
+
+	return memoryStmt{table: table}, nil
+}
+
+func (memoryConn) Close() error {
+	return nil
+}
+
+func (memoryConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type memoryStmt struct {
+	table memoryTable
+}
+
+func (memoryStmt) Close() error {
+	return nil
+}
+
+func (memoryStmt) NumInput() int {
+	return 0
+}
+
+func (memoryStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("statements are not supported")
+}
+
+func (s memoryStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &memoryRows{table: s.table, cursor: 0}, nil
+}
+
+type memoryRows struct {
+	table  memoryTable
+	cursor int
+}
+
+func (r *memoryRows) Columns() []string {
+	return r.table.columns
+}
+
+func (r *memoryRows) Close() error {
+	return nil
+}
+
+func (r *memoryRows) Next(dest []driver.Value) error {
+	if r.cursor >= len(r.table.rows) {
+		if r.table.err != nil {
+			return r.table.err
+		}
+
+		return io.EOF
+	}
+
+	copy(dest, r.table.rows[r.cursor])
+	r.cursor++
+
+	return nil
+}
+
+func init() {
+	sql.Register("memory", memoryDriver{})
+}
+
+func scanInt(rows *sql.Rows) (int, error) {
+	var n int
+	err := rows.Scan(&n)
+
+	return n, err
+}
+
+func query(t *testing.T, table string) (*sql.DB, *sql.Rows) {
+	t.Helper()
+
+	db, err := sql.Open("memory", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { db.Close() })
+
+	rows, err := db.Query(table)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db, rows
+}
+
+func TestFromRows(t *testing.T) {
+	db, rows := query(t, "numbers")
+
+	got, err := FromRowsInt(rows, scanInt).TryCollect()
+
+	want := []int{1, 2, 3}
+	if !reflect.DeepEqual(got, want) || err != nil {
+		t.Errorf("got: (%v, %v); expected: (%v, %v)", got, err, want, nil)
+	}
+
+	if inUse := db.Stats().InUse; inUse != 0 {
+		t.Errorf("got: %d connections in use; expected: %d", inUse, 0)
+	}
+}
+
+func TestFromRowsStopsEarly(t *testing.T) {
+	db, rows := query(t, "numbers")
+
+	got := FromRowsInt(rows, scanInt).Unwrapped().Find(func(n int) bool { return n == 2 })
+
+	if got != SomeInt(2) || db.Stats().InUse != 0 {
+		t.Errorf("got: (%v, %d connections in use); expected: (%v, %d)", got, db.Stats().InUse, SomeInt(2), 0)
+	}
+}
+
+func TestFromRowsClose(t *testing.T) {
+	db, rows := query(t, "numbers")
+	iter := FromRowsInt(rows, scanInt)
+
+	got := iter.Next()
+
+	if got != SomeResultForInt(OkInt(1)) || db.Stats().InUse != 1 {
+		t.Errorf("got: (%v, %d connections in use); expected: (%v, %d)", got, db.Stats().InUse, SomeResultForInt(OkInt(1)), 1)
+	}
+
+	if err := iter.Close(); err != nil || db.Stats().InUse != 0 {
+		t.Errorf("got: (%v, %d connections in use); expected: (%v, %d)", err, db.Stats().InUse, nil, 0)
+	}
+
+	if next := iter.Next(); next.IsSome() {
+		t.Errorf("got: %v; expected: %v", next, NoneResultForInt())
+	}
+}
+
+func TestFromRowsError(t *testing.T) {
+	testCases := map[string]struct {
+		values []int
+		err    string
+	}{
+		"words":  {values: []int{}, err: "scanning row 0"},
+		"broken": {values: []int{1}, err: "reading row 1: connection lost"},
+	}
+
+	for table, want := range testCases {
+		db, rows := query(t, table)
+		iter := FromRowsInt(rows, scanInt)
+
+		got, err := iter.TryCollect()
+
+		if !reflect.DeepEqual(got, want.values) || err == nil || !strings.Contains(err.Error(), want.err) {
+			t.Errorf("case: %s; got: (%v, %v); expected: (%v, %s)", table, got, err, want.values, want.err)
+		}
+
+		if next := iter.Next(); next.IsSome() || db.Stats().InUse != 0 {
+			t.Errorf("case: %s; got: (%v, %d connections in use); expected: (%v, %d)", table, next, db.Stats().InUse, NoneResultForInt(), 0)
+		}
+	}
+
+	_, rows := query(t, "broken")
+	if _, err := FromRowsInt(rows, scanInt).TryCollect(); !errors.Is(err, errBroken) {
+		t.Errorf("got: %v; expected: %v", err, errBroken)
+	}
+}
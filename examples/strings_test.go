@@ -0,0 +1,55 @@
+package iter
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestJoin(t *testing.T) {
+	testCases := map[IteratorForString]string{
+		VectorOfString([]string{}):                 "",
+		VectorOfString([]string{"a"}):              "a",
+		VectorOfString([]string{"a", "", "c"}):     "a, , c",
+		WordsOf(strings.NewReader("to be or")):     "to, be, or",
+		VectorOfString([]string{"x", "y"}).Skip(1): "y",
+	}
+
+	for iter, want := range testCases {
+		if got := iter.Join(", "); got != want {
+			t.Errorf("case: %v; got: %q; expected: %q", iter, got, want)
+		}
+	}
+}
+
+func TestJoinWith(t *testing.T) {
+	testCases := map[IteratorForInt]string{
+		VectorOfInt([]int{}):        "",
+		VectorOfInt([]int{1, 2, 3}): "1-2-3",
+		Range(0, 12, 5):             "0-5-10",
+	}
+
+	for iter, want := range testCases {
+		if got := iter.JoinWith("-", nil); got != want {
+			t.Errorf("case: %v; got: %q; expected: %q", iter, got, want)
+		}
+	}
+
+	got := VectorOfInt([]int{10, 11}).JoinWith(" ", func(item int) string { return strconv.FormatInt(int64(item), 16) })
+	if want := "a b"; got != want {
+		t.Errorf("got: %q; expected: %q", got, want)
+	}
+}
+
+func TestJoinAllocations(t *testing.T) {
+	iter := VectorOfString([]string{"Lorem", "ipsum", "dolor", "sit", "amet"})
+
+	allocs := testing.AllocsPerRun(100, func() {
+		iter.Reset()
+		iter.Join(" ")
+	})
+
+	if allocs != 1 {
+		t.Errorf("got: %v allocations; expected: %v", allocs, 1)
+	}
+}
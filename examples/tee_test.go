@@ -0,0 +1,101 @@
+package iter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTee(t *testing.T) {
+	testCases := map[IteratorForInt][]int{
+		Range(0, 0, 1):                  {},
+		Range(0, 4, 1):                  {0, 1, 2, 3},
+		VectorOfInt([]int{0, 1, -2, 3}): {0, 1, -2, 3},
+		Range(0, 10, 1).Filter(isEven):  {0, 2, 4, 6, 8},
+	}
+
+	for iter, want := range testCases {
+		branches := iter.Tee(3)
+
+		for k, branch := range branches {
+			got := branch.Collect()
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("case: %s; branch: %d; got: %v; expected: %v", iter, k, got, want)
+			}
+		}
+	}
+}
+
+func TestTeeCountAndSum(t *testing.T) {
+	branches := Range(0, 10, 1).Filter(isEven).Tee(2)
+
+	count := branches[0].Count()
+	sum := branches[1].FoldForInt(0, func(acc, item int) int {
+		return acc + item
+	})
+
+	if count != 5 || sum != 20 {
+		t.Errorf("got: (%d, %d); expected: (%d, %d)", count, sum, 5, 20)
+	}
+}
+
+func TestTeeBuffersOnlyPendingElements(t *testing.T) {
+	branches := Range(0, 100, 1).Filter(isEven).Tee(2)
+	buffer := branches[0].iter.(*teeForInt).buffer
+
+	for k := 0; k < 10; k++ {
+		branches[0].Next()
+	}
+
+	if len(buffer.items) != 10 {
+		t.Errorf("got: %d buffered elements; expected: %d", len(buffer.items), 10)
+	}
+
+	for k := 0; k < 4; k++ {
+		branches[1].Next()
+	}
+
+	if len(buffer.items) != 6 {
+		t.Errorf("got: %d buffered elements; expected: %d", len(buffer.items), 6)
+	}
+
+	got := []int{}
+	for k := 0; k < 6; k++ {
+		got = append(got, branches[1].Next().Unwrap())
+	}
+
+	want := []int{8, 10, 12, 14, 16, 18}
+	if !reflect.DeepEqual(got, want) || len(buffer.items) != 0 {
+		t.Errorf("got: (%v, %d buffered); expected: (%v, %d buffered)", got, len(buffer.items), want, 0)
+	}
+}
+
+func TestTeeSizeHint(t *testing.T) {
+	branches := Range(0, 10, 1).Tee(2)
+
+	for k := 0; k < 5; k++ {
+		branches[0].Next()
+	}
+
+	testCases := map[IteratorForInt]uint{
+		branches[0]: 5,
+		branches[1]: 10,
+	}
+
+	for iter, want := range testCases {
+		lower, upper := iter.SizeHint()
+
+		if lower != want || !reflect.DeepEqual(upper, SomeUint(want)) {
+			t.Errorf("case: %s; got: (%d, %v); expected: (%d, %v)", iter, lower, upper, want, SomeUint(want))
+		}
+	}
+}
+
+func TestTeeSizeHintSaturates(t *testing.T) {
+	branches := RepeatInt(1).Tee(2)
+	branches[0].Next()
+
+	if lower, upper := branches[1].SizeHint(); lower != ^uint(0) || upper.IsSome() {
+		t.Errorf("got: (%d, %v); expected: (%d, %v)", lower, upper, ^uint(0), NoneUint())
+	}
+}
@@ -0,0 +1,978 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package iter
+
+// TryIterableForInt describes a struct that can be iterated over and may fail.
+type TryIterableForInt interface {
+	Next() OptionForResultForInt
+	SizeHint() (lower uint, upper OptionForUint)
+}
+
+// TryIteratorForInt embeds a TryIterable and provides util functions for it.
+type TryIteratorForInt struct {
+	iter TryIterableForInt
+}
+
+// TryIterator implements TryIterable.
+var _ TryIterableForInt = TryIteratorForInt{}
+
+// FromTryIterableInt builds a fallible Iterator from any TryIterable, which may also implement io.Closer.
+func FromTryIterableInt(iter TryIterableForInt) TryIteratorForInt {
+	return TryIteratorForInt{iter: iter}
+}
+
+// FromTryFuncInt builds a fallible Iterator calling f to get each of its results.
+// The Iterator ends when f returns None.
+func FromTryFuncInt(f func() OptionForResultForInt) TryIteratorForInt {
+	return TryIteratorForInt{
+		iter: &tryFromFuncForInt{f: f},
+	}
+}
+
+// Next returns the next result of the TryIterator.
+func (t TryIteratorForInt) Next() OptionForResultForInt {
+	return t.iter.Next()
+}
+
+// SizeHint returns the bounds on the number of results left in the TryIterator.
+func (t TryIteratorForInt) SizeHint() (lower uint, upper OptionForUint) {
+	return t.iter.SizeHint()
+}
+
+// Close releases the resources held by the TryIterator sources, if any.
+func (t TryIteratorForInt) Close() error {
+	return closeOf(t.iter)
+}
+
+// TryCollect returns a slice containing the elements of the TryIterator.
+// It stops at the first error and returns it along with the elements collected so far.
+func (t TryIteratorForInt) TryCollect() ([]int, error) {
+	defer t.Close()
+
+	lower, _ := t.SizeHint()
+	collected := make([]int, 0, lower)
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return collected, result.Err()
+		}
+
+		collected = append(collected, result.Unwrap())
+
+		item = t.Next()
+	}
+
+	return collected, nil
+}
+
+// TryForEach runs a callback for every element of the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the callback.
+func (t TryIteratorForInt) TryForEach(callback func(item int) error) error {
+	_, err := t.TryFoldForEmpty(Empty{}, func(acc Empty, item int) (Empty, error) {
+		return acc, callback(item)
+	})
+
+	return err
+}
+
+// Unwrapped returns an Iterator yielding the elements of the TryIterator until its first error.
+// The error is then available through the Iterator Err method.
+func (t TryIteratorForInt) Unwrapped() IteratorForInt {
+	return IteratorForInt{iter: &unwrapForInt{iter: t.iter, err: nil}}
+}
+
+type tryFromFuncForInt struct {
+	f func() OptionForResultForInt
+}
+
+func (f *tryFromFuncForInt) Next() OptionForResultForInt {
+	return f.f()
+}
+
+func (f *tryFromFuncForInt) SizeHint() (uint, OptionForUint) {
+	return 0, NoneUint()
+}
+
+var _ TryIterableForInt = &tryFromFuncForInt{}
+
+type unwrapForInt struct {
+	iter TryIterableForInt
+	err  error
+}
+
+func (u *unwrapForInt) Next() OptionForInt {
+	if u.err != nil {
+		return NoneInt()
+	}
+
+	item := u.iter.Next()
+	if item.IsNone() {
+		return NoneInt()
+	}
+
+	result := item.Unwrap()
+	if result.IsErr() {
+		u.err = result.Err()
+		return NoneInt()
+	}
+
+	return SomeInt(result.Unwrap())
+}
+
+func (u *unwrapForInt) SizeHint() (uint, OptionForUint) {
+	if u.err != nil {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := u.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (u *unwrapForInt) Err() error {
+	return u.err
+}
+
+func (u *unwrapForInt) Close() error {
+	return closeOf(u.iter)
+}
+
+var _ IterableForInt = &unwrapForInt{}
+
+// TryIterableForString describes a struct that can be iterated over and may fail.
+type TryIterableForString interface {
+	Next() OptionForResultForString
+	SizeHint() (lower uint, upper OptionForUint)
+}
+
+// TryIteratorForString embeds a TryIterable and provides util functions for it.
+type TryIteratorForString struct {
+	iter TryIterableForString
+}
+
+// TryIterator implements TryIterable.
+var _ TryIterableForString = TryIteratorForString{}
+
+// FromTryIterableString builds a fallible Iterator from any TryIterable, which may also implement io.Closer.
+func FromTryIterableString(iter TryIterableForString) TryIteratorForString {
+	return TryIteratorForString{iter: iter}
+}
+
+// FromTryFuncString builds a fallible Iterator calling f to get each of its results.
+// The Iterator ends when f returns None.
+func FromTryFuncString(f func() OptionForResultForString) TryIteratorForString {
+	return TryIteratorForString{
+		iter: &tryFromFuncForString{f: f},
+	}
+}
+
+// Next returns the next result of the TryIterator.
+func (t TryIteratorForString) Next() OptionForResultForString {
+	return t.iter.Next()
+}
+
+// SizeHint returns the bounds on the number of results left in the TryIterator.
+func (t TryIteratorForString) SizeHint() (lower uint, upper OptionForUint) {
+	return t.iter.SizeHint()
+}
+
+// Close releases the resources held by the TryIterator sources, if any.
+func (t TryIteratorForString) Close() error {
+	return closeOf(t.iter)
+}
+
+// TryCollect returns a slice containing the elements of the TryIterator.
+// It stops at the first error and returns it along with the elements collected so far.
+func (t TryIteratorForString) TryCollect() ([]string, error) {
+	defer t.Close()
+
+	lower, _ := t.SizeHint()
+	collected := make([]string, 0, lower)
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return collected, result.Err()
+		}
+
+		collected = append(collected, result.Unwrap())
+
+		item = t.Next()
+	}
+
+	return collected, nil
+}
+
+// TryForEach runs a callback for every element of the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the callback.
+func (t TryIteratorForString) TryForEach(callback func(item string) error) error {
+	_, err := t.TryFoldForEmpty(Empty{}, func(acc Empty, item string) (Empty, error) {
+		return acc, callback(item)
+	})
+
+	return err
+}
+
+// Unwrapped returns an Iterator yielding the elements of the TryIterator until its first error.
+// The error is then available through the Iterator Err method.
+func (t TryIteratorForString) Unwrapped() IteratorForString {
+	return IteratorForString{iter: &unwrapForString{iter: t.iter, err: nil}}
+}
+
+type tryFromFuncForString struct {
+	f func() OptionForResultForString
+}
+
+func (f *tryFromFuncForString) Next() OptionForResultForString {
+	return f.f()
+}
+
+func (f *tryFromFuncForString) SizeHint() (uint, OptionForUint) {
+	return 0, NoneUint()
+}
+
+var _ TryIterableForString = &tryFromFuncForString{}
+
+type unwrapForString struct {
+	iter TryIterableForString
+	err  error
+}
+
+func (u *unwrapForString) Next() OptionForString {
+	if u.err != nil {
+		return NoneString()
+	}
+
+	item := u.iter.Next()
+	if item.IsNone() {
+		return NoneString()
+	}
+
+	result := item.Unwrap()
+	if result.IsErr() {
+		u.err = result.Err()
+		return NoneString()
+	}
+
+	return SomeString(result.Unwrap())
+}
+
+func (u *unwrapForString) SizeHint() (uint, OptionForUint) {
+	if u.err != nil {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := u.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (u *unwrapForString) Err() error {
+	return u.err
+}
+
+func (u *unwrapForString) Close() error {
+	return closeOf(u.iter)
+}
+
+var _ IterableForString = &unwrapForString{}
+
+// TryIterableForEntryForStringToInt describes a struct that can be iterated over and may fail.
+type TryIterableForEntryForStringToInt interface {
+	Next() OptionForResultForEntryForStringToInt
+	SizeHint() (lower uint, upper OptionForUint)
+}
+
+// TryIteratorForEntryForStringToInt embeds a TryIterable and provides util functions for it.
+type TryIteratorForEntryForStringToInt struct {
+	iter TryIterableForEntryForStringToInt
+}
+
+// TryIterator implements TryIterable.
+var _ TryIterableForEntryForStringToInt = TryIteratorForEntryForStringToInt{}
+
+// FromTryIterableEntryForStringToInt builds a fallible Iterator from any TryIterable, which may also implement io.Closer.
+func FromTryIterableEntryForStringToInt(iter TryIterableForEntryForStringToInt) TryIteratorForEntryForStringToInt {
+	return TryIteratorForEntryForStringToInt{iter: iter}
+}
+
+// FromTryFuncEntryForStringToInt builds a fallible Iterator calling f to get each of its results.
+// The Iterator ends when f returns None.
+func FromTryFuncEntryForStringToInt(f func() OptionForResultForEntryForStringToInt) TryIteratorForEntryForStringToInt {
+	return TryIteratorForEntryForStringToInt{
+		iter: &tryFromFuncForEntryForStringToInt{f: f},
+	}
+}
+
+// Next returns the next result of the TryIterator.
+func (t TryIteratorForEntryForStringToInt) Next() OptionForResultForEntryForStringToInt {
+	return t.iter.Next()
+}
+
+// SizeHint returns the bounds on the number of results left in the TryIterator.
+func (t TryIteratorForEntryForStringToInt) SizeHint() (lower uint, upper OptionForUint) {
+	return t.iter.SizeHint()
+}
+
+// Close releases the resources held by the TryIterator sources, if any.
+func (t TryIteratorForEntryForStringToInt) Close() error {
+	return closeOf(t.iter)
+}
+
+// TryCollect returns a slice containing the elements of the TryIterator.
+// It stops at the first error and returns it along with the elements collected so far.
+func (t TryIteratorForEntryForStringToInt) TryCollect() ([]EntryForStringToInt, error) {
+	defer t.Close()
+
+	lower, _ := t.SizeHint()
+	collected := make([]EntryForStringToInt, 0, lower)
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return collected, result.Err()
+		}
+
+		collected = append(collected, result.Unwrap())
+
+		item = t.Next()
+	}
+
+	return collected, nil
+}
+
+// TryForEach runs a callback for every element of the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the callback.
+func (t TryIteratorForEntryForStringToInt) TryForEach(callback func(item EntryForStringToInt) error) error {
+	_, err := t.TryFoldForEmpty(Empty{}, func(acc Empty, item EntryForStringToInt) (Empty, error) {
+		return acc, callback(item)
+	})
+
+	return err
+}
+
+// Unwrapped returns an Iterator yielding the elements of the TryIterator until its first error.
+// The error is then available through the Iterator Err method.
+func (t TryIteratorForEntryForStringToInt) Unwrapped() IteratorForEntryForStringToInt {
+	return IteratorForEntryForStringToInt{iter: &unwrapForEntryForStringToInt{iter: t.iter, err: nil}}
+}
+
+type tryFromFuncForEntryForStringToInt struct {
+	f func() OptionForResultForEntryForStringToInt
+}
+
+func (f *tryFromFuncForEntryForStringToInt) Next() OptionForResultForEntryForStringToInt {
+	return f.f()
+}
+
+func (f *tryFromFuncForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	return 0, NoneUint()
+}
+
+var _ TryIterableForEntryForStringToInt = &tryFromFuncForEntryForStringToInt{}
+
+type unwrapForEntryForStringToInt struct {
+	iter TryIterableForEntryForStringToInt
+	err  error
+}
+
+func (u *unwrapForEntryForStringToInt) Next() OptionForEntryForStringToInt {
+	if u.err != nil {
+		return NoneEntryForStringToInt()
+	}
+
+	item := u.iter.Next()
+	if item.IsNone() {
+		return NoneEntryForStringToInt()
+	}
+
+	result := item.Unwrap()
+	if result.IsErr() {
+		u.err = result.Err()
+		return NoneEntryForStringToInt()
+	}
+
+	return SomeEntryForStringToInt(result.Unwrap())
+}
+
+func (u *unwrapForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if u.err != nil {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := u.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (u *unwrapForEntryForStringToInt) Err() error {
+	return u.err
+}
+
+func (u *unwrapForEntryForStringToInt) Close() error {
+	return closeOf(u.iter)
+}
+
+var _ IterableForEntryForStringToInt = &unwrapForEntryForStringToInt{}
+
+// TryIterableForSliceOfInt describes a struct that can be iterated over and may fail.
+type TryIterableForSliceOfInt interface {
+	Next() OptionForResultForSliceOfInt
+	SizeHint() (lower uint, upper OptionForUint)
+}
+
+// TryIteratorForSliceOfInt embeds a TryIterable and provides util functions for it.
+type TryIteratorForSliceOfInt struct {
+	iter TryIterableForSliceOfInt
+}
+
+// TryIterator implements TryIterable.
+var _ TryIterableForSliceOfInt = TryIteratorForSliceOfInt{}
+
+// FromTryIterableSliceOfInt builds a fallible Iterator from any TryIterable, which may also implement io.Closer.
+func FromTryIterableSliceOfInt(iter TryIterableForSliceOfInt) TryIteratorForSliceOfInt {
+	return TryIteratorForSliceOfInt{iter: iter}
+}
+
+// FromTryFuncSliceOfInt builds a fallible Iterator calling f to get each of its results.
+// The Iterator ends when f returns None.
+func FromTryFuncSliceOfInt(f func() OptionForResultForSliceOfInt) TryIteratorForSliceOfInt {
+	return TryIteratorForSliceOfInt{
+		iter: &tryFromFuncForSliceOfInt{f: f},
+	}
+}
+
+// Next returns the next result of the TryIterator.
+func (t TryIteratorForSliceOfInt) Next() OptionForResultForSliceOfInt {
+	return t.iter.Next()
+}
+
+// SizeHint returns the bounds on the number of results left in the TryIterator.
+func (t TryIteratorForSliceOfInt) SizeHint() (lower uint, upper OptionForUint) {
+	return t.iter.SizeHint()
+}
+
+// Close releases the resources held by the TryIterator sources, if any.
+func (t TryIteratorForSliceOfInt) Close() error {
+	return closeOf(t.iter)
+}
+
+// TryCollect returns a slice containing the elements of the TryIterator.
+// It stops at the first error and returns it along with the elements collected so far.
+func (t TryIteratorForSliceOfInt) TryCollect() ([]SliceOfInt, error) {
+	defer t.Close()
+
+	lower, _ := t.SizeHint()
+	collected := make([]SliceOfInt, 0, lower)
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return collected, result.Err()
+		}
+
+		collected = append(collected, result.Unwrap())
+
+		item = t.Next()
+	}
+
+	return collected, nil
+}
+
+// TryForEach runs a callback for every element of the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the callback.
+func (t TryIteratorForSliceOfInt) TryForEach(callback func(item SliceOfInt) error) error {
+	_, err := t.TryFoldForEmpty(Empty{}, func(acc Empty, item SliceOfInt) (Empty, error) {
+		return acc, callback(item)
+	})
+
+	return err
+}
+
+// Unwrapped returns an Iterator yielding the elements of the TryIterator until its first error.
+// The error is then available through the Iterator Err method.
+func (t TryIteratorForSliceOfInt) Unwrapped() IteratorForSliceOfInt {
+	return IteratorForSliceOfInt{iter: &unwrapForSliceOfInt{iter: t.iter, err: nil}}
+}
+
+type tryFromFuncForSliceOfInt struct {
+	f func() OptionForResultForSliceOfInt
+}
+
+func (f *tryFromFuncForSliceOfInt) Next() OptionForResultForSliceOfInt {
+	return f.f()
+}
+
+func (f *tryFromFuncForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	return 0, NoneUint()
+}
+
+var _ TryIterableForSliceOfInt = &tryFromFuncForSliceOfInt{}
+
+type unwrapForSliceOfInt struct {
+	iter TryIterableForSliceOfInt
+	err  error
+}
+
+func (u *unwrapForSliceOfInt) Next() OptionForSliceOfInt {
+	if u.err != nil {
+		return NoneSliceOfInt()
+	}
+
+	item := u.iter.Next()
+	if item.IsNone() {
+		return NoneSliceOfInt()
+	}
+
+	result := item.Unwrap()
+	if result.IsErr() {
+		u.err = result.Err()
+		return NoneSliceOfInt()
+	}
+
+	return SomeSliceOfInt(result.Unwrap())
+}
+
+func (u *unwrapForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	if u.err != nil {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := u.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (u *unwrapForSliceOfInt) Err() error {
+	return u.err
+}
+
+func (u *unwrapForSliceOfInt) Close() error {
+	return closeOf(u.iter)
+}
+
+var _ IterableForSliceOfInt = &unwrapForSliceOfInt{}
+
+// TryIterableForSliceOfString describes a struct that can be iterated over and may fail.
+type TryIterableForSliceOfString interface {
+	Next() OptionForResultForSliceOfString
+	SizeHint() (lower uint, upper OptionForUint)
+}
+
+// TryIteratorForSliceOfString embeds a TryIterable and provides util functions for it.
+type TryIteratorForSliceOfString struct {
+	iter TryIterableForSliceOfString
+}
+
+// TryIterator implements TryIterable.
+var _ TryIterableForSliceOfString = TryIteratorForSliceOfString{}
+
+// FromTryIterableSliceOfString builds a fallible Iterator from any TryIterable, which may also implement io.Closer.
+func FromTryIterableSliceOfString(iter TryIterableForSliceOfString) TryIteratorForSliceOfString {
+	return TryIteratorForSliceOfString{iter: iter}
+}
+
+// FromTryFuncSliceOfString builds a fallible Iterator calling f to get each of its results.
+// The Iterator ends when f returns None.
+func FromTryFuncSliceOfString(f func() OptionForResultForSliceOfString) TryIteratorForSliceOfString {
+	return TryIteratorForSliceOfString{
+		iter: &tryFromFuncForSliceOfString{f: f},
+	}
+}
+
+// Next returns the next result of the TryIterator.
+func (t TryIteratorForSliceOfString) Next() OptionForResultForSliceOfString {
+	return t.iter.Next()
+}
+
+// SizeHint returns the bounds on the number of results left in the TryIterator.
+func (t TryIteratorForSliceOfString) SizeHint() (lower uint, upper OptionForUint) {
+	return t.iter.SizeHint()
+}
+
+// Close releases the resources held by the TryIterator sources, if any.
+func (t TryIteratorForSliceOfString) Close() error {
+	return closeOf(t.iter)
+}
+
+// TryCollect returns a slice containing the elements of the TryIterator.
+// It stops at the first error and returns it along with the elements collected so far.
+func (t TryIteratorForSliceOfString) TryCollect() ([]SliceOfString, error) {
+	defer t.Close()
+
+	lower, _ := t.SizeHint()
+	collected := make([]SliceOfString, 0, lower)
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return collected, result.Err()
+		}
+
+		collected = append(collected, result.Unwrap())
+
+		item = t.Next()
+	}
+
+	return collected, nil
+}
+
+// TryForEach runs a callback for every element of the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the callback.
+func (t TryIteratorForSliceOfString) TryForEach(callback func(item SliceOfString) error) error {
+	_, err := t.TryFoldForEmpty(Empty{}, func(acc Empty, item SliceOfString) (Empty, error) {
+		return acc, callback(item)
+	})
+
+	return err
+}
+
+// Unwrapped returns an Iterator yielding the elements of the TryIterator until its first error.
+// The error is then available through the Iterator Err method.
+func (t TryIteratorForSliceOfString) Unwrapped() IteratorForSliceOfString {
+	return IteratorForSliceOfString{iter: &unwrapForSliceOfString{iter: t.iter, err: nil}}
+}
+
+type tryFromFuncForSliceOfString struct {
+	f func() OptionForResultForSliceOfString
+}
+
+func (f *tryFromFuncForSliceOfString) Next() OptionForResultForSliceOfString {
+	return f.f()
+}
+
+func (f *tryFromFuncForSliceOfString) SizeHint() (uint, OptionForUint) {
+	return 0, NoneUint()
+}
+
+var _ TryIterableForSliceOfString = &tryFromFuncForSliceOfString{}
+
+type unwrapForSliceOfString struct {
+	iter TryIterableForSliceOfString
+	err  error
+}
+
+func (u *unwrapForSliceOfString) Next() OptionForSliceOfString {
+	if u.err != nil {
+		return NoneSliceOfString()
+	}
+
+	item := u.iter.Next()
+	if item.IsNone() {
+		return NoneSliceOfString()
+	}
+
+	result := item.Unwrap()
+	if result.IsErr() {
+		u.err = result.Err()
+		return NoneSliceOfString()
+	}
+
+	return SomeSliceOfString(result.Unwrap())
+}
+
+func (u *unwrapForSliceOfString) SizeHint() (uint, OptionForUint) {
+	if u.err != nil {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := u.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (u *unwrapForSliceOfString) Err() error {
+	return u.err
+}
+
+func (u *unwrapForSliceOfString) Close() error {
+	return closeOf(u.iter)
+}
+
+var _ IterableForSliceOfString = &unwrapForSliceOfString{}
+
+// TryIterableForSliceOfEntryForStringToInt describes a struct that can be iterated over and may fail.
+type TryIterableForSliceOfEntryForStringToInt interface {
+	Next() OptionForResultForSliceOfEntryForStringToInt
+	SizeHint() (lower uint, upper OptionForUint)
+}
+
+// TryIteratorForSliceOfEntryForStringToInt embeds a TryIterable and provides util functions for it.
+type TryIteratorForSliceOfEntryForStringToInt struct {
+	iter TryIterableForSliceOfEntryForStringToInt
+}
+
+// TryIterator implements TryIterable.
+var _ TryIterableForSliceOfEntryForStringToInt = TryIteratorForSliceOfEntryForStringToInt{}
+
+// FromTryIterableSliceOfEntryForStringToInt builds a fallible Iterator from any TryIterable, which may also implement io.Closer.
+func FromTryIterableSliceOfEntryForStringToInt(iter TryIterableForSliceOfEntryForStringToInt) TryIteratorForSliceOfEntryForStringToInt {
+	return TryIteratorForSliceOfEntryForStringToInt{iter: iter}
+}
+
+// FromTryFuncSliceOfEntryForStringToInt builds a fallible Iterator calling f to get each of its results.
+// The Iterator ends when f returns None.
+func FromTryFuncSliceOfEntryForStringToInt(f func() OptionForResultForSliceOfEntryForStringToInt) TryIteratorForSliceOfEntryForStringToInt {
+	return TryIteratorForSliceOfEntryForStringToInt{
+		iter: &tryFromFuncForSliceOfEntryForStringToInt{f: f},
+	}
+}
+
+// Next returns the next result of the TryIterator.
+func (t TryIteratorForSliceOfEntryForStringToInt) Next() OptionForResultForSliceOfEntryForStringToInt {
+	return t.iter.Next()
+}
+
+// SizeHint returns the bounds on the number of results left in the TryIterator.
+func (t TryIteratorForSliceOfEntryForStringToInt) SizeHint() (lower uint, upper OptionForUint) {
+	return t.iter.SizeHint()
+}
+
+// Close releases the resources held by the TryIterator sources, if any.
+func (t TryIteratorForSliceOfEntryForStringToInt) Close() error {
+	return closeOf(t.iter)
+}
+
+// TryCollect returns a slice containing the elements of the TryIterator.
+// It stops at the first error and returns it along with the elements collected so far.
+func (t TryIteratorForSliceOfEntryForStringToInt) TryCollect() ([]SliceOfEntryForStringToInt, error) {
+	defer t.Close()
+
+	lower, _ := t.SizeHint()
+	collected := make([]SliceOfEntryForStringToInt, 0, lower)
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return collected, result.Err()
+		}
+
+		collected = append(collected, result.Unwrap())
+
+		item = t.Next()
+	}
+
+	return collected, nil
+}
+
+// TryForEach runs a callback for every element of the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the callback.
+func (t TryIteratorForSliceOfEntryForStringToInt) TryForEach(callback func(item SliceOfEntryForStringToInt) error) error {
+	_, err := t.TryFoldForEmpty(Empty{}, func(acc Empty, item SliceOfEntryForStringToInt) (Empty, error) {
+		return acc, callback(item)
+	})
+
+	return err
+}
+
+// Unwrapped returns an Iterator yielding the elements of the TryIterator until its first error.
+// The error is then available through the Iterator Err method.
+func (t TryIteratorForSliceOfEntryForStringToInt) Unwrapped() IteratorForSliceOfEntryForStringToInt {
+	return IteratorForSliceOfEntryForStringToInt{iter: &unwrapForSliceOfEntryForStringToInt{iter: t.iter, err: nil}}
+}
+
+type tryFromFuncForSliceOfEntryForStringToInt struct {
+	f func() OptionForResultForSliceOfEntryForStringToInt
+}
+
+func (f *tryFromFuncForSliceOfEntryForStringToInt) Next() OptionForResultForSliceOfEntryForStringToInt {
+	return f.f()
+}
+
+func (f *tryFromFuncForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	return 0, NoneUint()
+}
+
+var _ TryIterableForSliceOfEntryForStringToInt = &tryFromFuncForSliceOfEntryForStringToInt{}
+
+type unwrapForSliceOfEntryForStringToInt struct {
+	iter TryIterableForSliceOfEntryForStringToInt
+	err  error
+}
+
+func (u *unwrapForSliceOfEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	if u.err != nil {
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	item := u.iter.Next()
+	if item.IsNone() {
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	result := item.Unwrap()
+	if result.IsErr() {
+		u.err = result.Err()
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	return SomeSliceOfEntryForStringToInt(result.Unwrap())
+}
+
+func (u *unwrapForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	if u.err != nil {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := u.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (u *unwrapForSliceOfEntryForStringToInt) Err() error {
+	return u.err
+}
+
+func (u *unwrapForSliceOfEntryForStringToInt) Close() error {
+	return closeOf(u.iter)
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &unwrapForSliceOfEntryForStringToInt{}
+
+// TryIterableForCSVRecord describes a struct that can be iterated over and may fail.
+type TryIterableForCSVRecord interface {
+	Next() OptionForResultForCSVRecord
+	SizeHint() (lower uint, upper OptionForUint)
+}
+
+// TryIteratorForCSVRecord embeds a TryIterable and provides util functions for it.
+type TryIteratorForCSVRecord struct {
+	iter TryIterableForCSVRecord
+}
+
+// TryIterator implements TryIterable.
+var _ TryIterableForCSVRecord = TryIteratorForCSVRecord{}
+
+// FromTryIterableCSVRecord builds a fallible Iterator from any TryIterable, which may also implement io.Closer.
+func FromTryIterableCSVRecord(iter TryIterableForCSVRecord) TryIteratorForCSVRecord {
+	return TryIteratorForCSVRecord{iter: iter}
+}
+
+// FromTryFuncCSVRecord builds a fallible Iterator calling f to get each of its results.
+// The Iterator ends when f returns None.
+func FromTryFuncCSVRecord(f func() OptionForResultForCSVRecord) TryIteratorForCSVRecord {
+	return TryIteratorForCSVRecord{
+		iter: &tryFromFuncForCSVRecord{f: f},
+	}
+}
+
+// Next returns the next result of the TryIterator.
+func (t TryIteratorForCSVRecord) Next() OptionForResultForCSVRecord {
+	return t.iter.Next()
+}
+
+// SizeHint returns the bounds on the number of results left in the TryIterator.
+func (t TryIteratorForCSVRecord) SizeHint() (lower uint, upper OptionForUint) {
+	return t.iter.SizeHint()
+}
+
+// Close releases the resources held by the TryIterator sources, if any.
+func (t TryIteratorForCSVRecord) Close() error {
+	return closeOf(t.iter)
+}
+
+// TryCollect returns a slice containing the elements of the TryIterator.
+// It stops at the first error and returns it along with the elements collected so far.
+func (t TryIteratorForCSVRecord) TryCollect() ([]CSVRecord, error) {
+	defer t.Close()
+
+	lower, _ := t.SizeHint()
+	collected := make([]CSVRecord, 0, lower)
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return collected, result.Err()
+		}
+
+		collected = append(collected, result.Unwrap())
+
+		item = t.Next()
+	}
+
+	return collected, nil
+}
+
+// TryForEach runs a callback for every element of the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the callback.
+func (t TryIteratorForCSVRecord) TryForEach(callback func(item CSVRecord) error) error {
+	_, err := t.TryFoldForEmpty(Empty{}, func(acc Empty, item CSVRecord) (Empty, error) {
+		return acc, callback(item)
+	})
+
+	return err
+}
+
+// Unwrapped returns an Iterator yielding the elements of the TryIterator until its first error.
+// The error is then available through the Iterator Err method.
+func (t TryIteratorForCSVRecord) Unwrapped() IteratorForCSVRecord {
+	return IteratorForCSVRecord{iter: &unwrapForCSVRecord{iter: t.iter, err: nil}}
+}
+
+type tryFromFuncForCSVRecord struct {
+	f func() OptionForResultForCSVRecord
+}
+
+func (f *tryFromFuncForCSVRecord) Next() OptionForResultForCSVRecord {
+	return f.f()
+}
+
+func (f *tryFromFuncForCSVRecord) SizeHint() (uint, OptionForUint) {
+	return 0, NoneUint()
+}
+
+var _ TryIterableForCSVRecord = &tryFromFuncForCSVRecord{}
+
+type unwrapForCSVRecord struct {
+	iter TryIterableForCSVRecord
+	err  error
+}
+
+func (u *unwrapForCSVRecord) Next() OptionForCSVRecord {
+	if u.err != nil {
+		return NoneCSVRecord()
+	}
+
+	item := u.iter.Next()
+	if item.IsNone() {
+		return NoneCSVRecord()
+	}
+
+	result := item.Unwrap()
+	if result.IsErr() {
+		u.err = result.Err()
+		return NoneCSVRecord()
+	}
+
+	return SomeCSVRecord(result.Unwrap())
+}
+
+func (u *unwrapForCSVRecord) SizeHint() (uint, OptionForUint) {
+	if u.err != nil {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := u.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (u *unwrapForCSVRecord) Err() error {
+	return u.err
+}
+
+func (u *unwrapForCSVRecord) Close() error {
+	return closeOf(u.iter)
+}
+
+var _ IterableForCSVRecord = &unwrapForCSVRecord{}
@@ -0,0 +1,144 @@
+package iter
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+var errPage = errors.New("page unavailable")
+
+func pagesOf(results ...ResultForString) TryIteratorForString {
+	cursor := 0
+
+	return FromTryFuncString(func() OptionForResultForString {
+		if cursor >= len(results) {
+			return NoneResultForString()
+		}
+
+		cursor++
+
+		return SomeResultForString(results[cursor-1])
+	})
+}
+
+func TestResult(t *testing.T) {
+	ok := OkInt(2).Map(func(value int) int {
+		return value * 10
+	})
+	if !ok.IsOk() || ok.Unwrap() != 20 || ok.UnwrapOr(0) != 20 {
+		t.Errorf("got: %v; expected: %v", ok, OkInt(20))
+	}
+
+	err := ErrInt(errPage).Map(func(value int) int {
+		return value * 10
+	})
+	if !err.IsErr() || err.Err() != errPage || err.UnwrapOr(-1) != -1 {
+		t.Errorf("got: %v; expected: %v", err, ErrInt(errPage))
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Unwrap should panic on an Err Result")
+		}
+	}()
+
+	err.Unwrap()
+}
+
+func TestTryCollect(t *testing.T) {
+	type result struct {
+		items []string
+		err   error
+	}
+
+	testCases := map[TryIteratorForString]result{
+		pagesOf():                             {items: []string{}, err: nil},
+		pagesOf(OkString("a"), OkString("b")): {items: []string{"a", "b"}, err: nil},
+		pagesOf(OkString("a"), ErrString(errPage), OkString("c")): {items: []string{"a"}, err: errPage},
+	}
+
+	for iter, want := range testCases {
+		items, err := iter.TryCollect()
+		got := result{items: items, err: err}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("case: %v; got: %v; expected: %v", iter, got, want)
+		}
+	}
+}
+
+func TestTryFold(t *testing.T) {
+	got, err := pagesOf(OkString("a"), OkString("b"), ErrString(errPage)).TryFoldForInt(0, func(acc int, item string) (int, error) {
+		return acc + len(item), nil
+	})
+
+	if got != 2 || err != errPage {
+		t.Errorf("got: (%d, %v); expected: (%d, %v)", got, err, 2, errPage)
+	}
+
+	errTooLong := errors.New("too long")
+	got, err = pagesOf(OkString("a"), OkString("bcd"), OkString("e")).TryFoldForInt(0, func(acc int, item string) (int, error) {
+		if len(item) > 2 {
+			return acc, errTooLong
+		}
+
+		return acc + len(item), nil
+	})
+
+	if got != 1 || err != errTooLong {
+		t.Errorf("got: (%d, %v); expected: (%d, %v)", got, err, 1, errTooLong)
+	}
+}
+
+func TestTryForEach(t *testing.T) {
+	got := []string{}
+	err := pagesOf(OkString("a"), ErrString(errPage), OkString("c")).TryForEach(func(item string) error {
+		got = append(got, item)
+		return nil
+	})
+
+	want := []string{"a"}
+	if !reflect.DeepEqual(got, want) || err != errPage {
+		t.Errorf("got: (%v, %v); expected: (%v, %v)", got, err, want, errPage)
+	}
+}
+
+func TestUnwrapped(t *testing.T) {
+	iter := pagesOf(OkString("a"), OkString("b"), ErrString(errPage), OkString("d")).Unwrapped()
+
+	got := iter.Map(func(item string) string {
+		return item + item
+	}).Collect()
+
+	want := []string{"aa", "bb"}
+	if !reflect.DeepEqual(got, want) || iter.Err() != errPage {
+		t.Errorf("got: (%v, %v); expected: (%v, %v)", got, iter.Err(), want, errPage)
+	}
+
+	if got := iter.Next(); !reflect.DeepEqual(got, NoneString()) {
+		t.Errorf("got: %v; expected: %v", got, NoneString())
+	}
+}
+
+func TestErrThroughAdapters(t *testing.T) {
+	testCases := map[IteratorForString]error{
+		VectorOfString([]string{"a"}):                                             nil,
+		pagesOf(OkString("a"), ErrString(errPage)).Unwrapped().Take(3):            errPage,
+		pagesOf(OkString("a"), ErrString(errPage)).Unwrapped().Filter(isNotEmpty): errPage,
+		OnceString("a").Chain(pagesOf(ErrString(errPage)).Unwrapped()):            errPage,
+		pagesOf(OkString("a"), ErrString(errPage)).Unwrapped().Cycle():            errPage,
+	}
+
+	for iter, want := range testCases {
+		iter.Take(5).Count()
+
+		if got := iter.Err(); got != want {
+			t.Errorf("case: %v; got: %v; expected: %v", iter, got, want)
+		}
+	}
+}
+
+func isNotEmpty(item string) bool {
+	return item != ""
+}
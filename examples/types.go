@@ -1,10 +1,66 @@
 package iter
 
+import "io"
+
 // Empty struct.
 type Empty struct{}
 
+// parFoldChunkSize is the number of elements folded at once by ParFold workers when the Iterator cannot be split.
+const parFoldChunkSize = 1024
+
 type errAdvanceBy struct{}
 
 func (e *errAdvanceBy) Error() string {
 	return "`AdvanceBy` reached the end of the iterator"
 }
+
+// closeOf closes an Iterable if it implements io.Closer.
+func closeOf(iter interface{}) error {
+	if c, ok := iter.(io.Closer); ok {
+		return c.Close()
+	}
+
+	return nil
+}
+
+// drainable describes a source which can skip to its end in constant time, such as a Vector or a Range.
+type drainable interface {
+	// drain moves the source to its end and returns the number of elements it skipped.
+	drain() uint
+}
+
+type errorer interface {
+	Err() error
+}
+
+// errOf returns the error reported by an Iterable, if it reports any.
+func errOf(iter interface{}) error {
+	if e, ok := iter.(errorer); ok {
+		return e.Err()
+	}
+
+	return nil
+}
+
+// ParMapOption configures ParMap.
+type ParMapOption func(config *parMapConfig)
+
+type parMapConfig struct {
+	ordered bool
+}
+
+// Unordered makes ParMap yield results as soon as they are ready instead of in input order.
+func Unordered() ParMapOption {
+	return func(config *parMapConfig) {
+		config.ordered = false
+	}
+}
+
+func newParMapConfig(options []ParMapOption) parMapConfig {
+	config := parMapConfig{ordered: true}
+	for _, option := range options {
+		option(&config)
+	}
+
+	return config
+}
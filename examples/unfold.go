@@ -0,0 +1,117 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package iter
+
+// UnfoldIntWithInt builds an Iterator from a state and a function computing each element from it.
+// The function can update the state through its pointer. The Iterator ends when it returns None.
+func UnfoldIntWithInt(init int, f func(state *int) OptionForInt) IteratorForInt {
+	return FromFuncInt(func() OptionForInt {
+		return f(&init)
+	})
+}
+
+// UnfoldIntWithSliceOfInt builds an Iterator from a state and a function computing each element from it.
+// The function can update the state through its pointer. The Iterator ends when it returns None.
+func UnfoldIntWithSliceOfInt(init SliceOfInt, f func(state *SliceOfInt) OptionForInt) IteratorForInt {
+	return FromFuncInt(func() OptionForInt {
+		return f(&init)
+	})
+}
+
+// UnfoldStringWithInt builds an Iterator from a state and a function computing each element from it.
+// The function can update the state through its pointer. The Iterator ends when it returns None.
+func UnfoldStringWithInt(init int, f func(state *int) OptionForString) IteratorForString {
+	return FromFuncString(func() OptionForString {
+		return f(&init)
+	})
+}
+
+// UnfoldStringWithSliceOfInt builds an Iterator from a state and a function computing each element from it.
+// The function can update the state through its pointer. The Iterator ends when it returns None.
+func UnfoldStringWithSliceOfInt(init SliceOfInt, f func(state *SliceOfInt) OptionForString) IteratorForString {
+	return FromFuncString(func() OptionForString {
+		return f(&init)
+	})
+}
+
+// UnfoldEntryForStringToIntWithInt builds an Iterator from a state and a function computing each element from it.
+// The function can update the state through its pointer. The Iterator ends when it returns None.
+func UnfoldEntryForStringToIntWithInt(init int, f func(state *int) OptionForEntryForStringToInt) IteratorForEntryForStringToInt {
+	return FromFuncEntryForStringToInt(func() OptionForEntryForStringToInt {
+		return f(&init)
+	})
+}
+
+// UnfoldEntryForStringToIntWithSliceOfInt builds an Iterator from a state and a function computing each element from it.
+// The function can update the state through its pointer. The Iterator ends when it returns None.
+func UnfoldEntryForStringToIntWithSliceOfInt(init SliceOfInt, f func(state *SliceOfInt) OptionForEntryForStringToInt) IteratorForEntryForStringToInt {
+	return FromFuncEntryForStringToInt(func() OptionForEntryForStringToInt {
+		return f(&init)
+	})
+}
+
+// UnfoldSliceOfIntWithInt builds an Iterator from a state and a function computing each element from it.
+// The function can update the state through its pointer. The Iterator ends when it returns None.
+func UnfoldSliceOfIntWithInt(init int, f func(state *int) OptionForSliceOfInt) IteratorForSliceOfInt {
+	return FromFuncSliceOfInt(func() OptionForSliceOfInt {
+		return f(&init)
+	})
+}
+
+// UnfoldSliceOfIntWithSliceOfInt builds an Iterator from a state and a function computing each element from it.
+// The function can update the state through its pointer. The Iterator ends when it returns None.
+func UnfoldSliceOfIntWithSliceOfInt(init SliceOfInt, f func(state *SliceOfInt) OptionForSliceOfInt) IteratorForSliceOfInt {
+	return FromFuncSliceOfInt(func() OptionForSliceOfInt {
+		return f(&init)
+	})
+}
+
+// UnfoldSliceOfStringWithInt builds an Iterator from a state and a function computing each element from it.
+// The function can update the state through its pointer. The Iterator ends when it returns None.
+func UnfoldSliceOfStringWithInt(init int, f func(state *int) OptionForSliceOfString) IteratorForSliceOfString {
+	return FromFuncSliceOfString(func() OptionForSliceOfString {
+		return f(&init)
+	})
+}
+
+// UnfoldSliceOfStringWithSliceOfInt builds an Iterator from a state and a function computing each element from it.
+// The function can update the state through its pointer. The Iterator ends when it returns None.
+func UnfoldSliceOfStringWithSliceOfInt(init SliceOfInt, f func(state *SliceOfInt) OptionForSliceOfString) IteratorForSliceOfString {
+	return FromFuncSliceOfString(func() OptionForSliceOfString {
+		return f(&init)
+	})
+}
+
+// UnfoldSliceOfEntryForStringToIntWithInt builds an Iterator from a state and a function computing each element from it.
+// The function can update the state through its pointer. The Iterator ends when it returns None.
+func UnfoldSliceOfEntryForStringToIntWithInt(init int, f func(state *int) OptionForSliceOfEntryForStringToInt) IteratorForSliceOfEntryForStringToInt {
+	return FromFuncSliceOfEntryForStringToInt(func() OptionForSliceOfEntryForStringToInt {
+		return f(&init)
+	})
+}
+
+// UnfoldSliceOfEntryForStringToIntWithSliceOfInt builds an Iterator from a state and a function computing each element from it.
+// The function can update the state through its pointer. The Iterator ends when it returns None.
+func UnfoldSliceOfEntryForStringToIntWithSliceOfInt(init SliceOfInt, f func(state *SliceOfInt) OptionForSliceOfEntryForStringToInt) IteratorForSliceOfEntryForStringToInt {
+	return FromFuncSliceOfEntryForStringToInt(func() OptionForSliceOfEntryForStringToInt {
+		return f(&init)
+	})
+}
+
+// UnfoldCSVRecordWithInt builds an Iterator from a state and a function computing each element from it.
+// The function can update the state through its pointer. The Iterator ends when it returns None.
+func UnfoldCSVRecordWithInt(init int, f func(state *int) OptionForCSVRecord) IteratorForCSVRecord {
+	return FromFuncCSVRecord(func() OptionForCSVRecord {
+		return f(&init)
+	})
+}
+
+// UnfoldCSVRecordWithSliceOfInt builds an Iterator from a state and a function computing each element from it.
+// The function can update the state through its pointer. The Iterator ends when it returns None.
+func UnfoldCSVRecordWithSliceOfInt(init SliceOfInt, f func(state *SliceOfInt) OptionForCSVRecord) IteratorForCSVRecord {
+	return FromFuncCSVRecord(func() OptionForCSVRecord {
+		return f(&init)
+	})
+}
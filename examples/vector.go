@@ -27,8 +27,56 @@ func (v *vectorForInt) Next() OptionForInt {
 	return SomeInt(item)
 }
 
+func (v *vectorForInt) SizeHint() (uint, OptionForUint) {
+	remaining := uint(len(v.slice)) - v.cursor
+
+	return remaining, SomeUint(remaining)
+}
+
+func (v *vectorForInt) drain() uint {
+	remaining := uint(len(v.slice)) - v.cursor
+	v.cursor = uint(len(v.slice))
+
+	return remaining
+}
+
+func (v *vectorForInt) Clone() (IterableForInt, bool) {
+	return &vectorForInt{slice: v.slice, cursor: v.cursor}, true
+}
+
+func (v *vectorForInt) Reset() {
+	v.cursor = 0
+}
+
+// split consumes the Vector and returns at most parts Vectors sharing its slice.
+func (v *vectorForInt) split(parts uint) []IterableForInt {
+	slice := v.slice[v.cursor:]
+	v.cursor = uint(len(v.slice))
+
+	length := uint(len(slice))
+	size := (length + parts - 1) / parts
+
+	split := make([]IterableForInt, 0, parts)
+	for start := uint(0); start < length; start += size {
+		end := start + size
+		if end > length {
+			end = length
+		}
+
+		split = append(split, &vectorForInt{slice: slice[start:end], cursor: 0})
+	}
+
+	return split
+}
+
 var _ IterableForInt = &vectorForInt{}
 
+var _ CloneableForInt = &vectorForInt{}
+
+var _ ResettableForInt = &vectorForInt{}
+
+var _ splittableForInt = &vectorForInt{}
+
 // VectorOfString builds an Iterator from a slice.
 func VectorOfString(slice []string) IteratorForString {
 	return IteratorForString{
@@ -52,4 +100,417 @@ func (v *vectorForString) Next() OptionForString {
 	return SomeString(item)
 }
 
+func (v *vectorForString) SizeHint() (uint, OptionForUint) {
+	remaining := uint(len(v.slice)) - v.cursor
+
+	return remaining, SomeUint(remaining)
+}
+
+func (v *vectorForString) drain() uint {
+	remaining := uint(len(v.slice)) - v.cursor
+	v.cursor = uint(len(v.slice))
+
+	return remaining
+}
+
+func (v *vectorForString) Clone() (IterableForString, bool) {
+	return &vectorForString{slice: v.slice, cursor: v.cursor}, true
+}
+
+func (v *vectorForString) Reset() {
+	v.cursor = 0
+}
+
+// split consumes the Vector and returns at most parts Vectors sharing its slice.
+func (v *vectorForString) split(parts uint) []IterableForString {
+	slice := v.slice[v.cursor:]
+	v.cursor = uint(len(v.slice))
+
+	length := uint(len(slice))
+	size := (length + parts - 1) / parts
+
+	split := make([]IterableForString, 0, parts)
+	for start := uint(0); start < length; start += size {
+		end := start + size
+		if end > length {
+			end = length
+		}
+
+		split = append(split, &vectorForString{slice: slice[start:end], cursor: 0})
+	}
+
+	return split
+}
+
 var _ IterableForString = &vectorForString{}
+
+var _ CloneableForString = &vectorForString{}
+
+var _ ResettableForString = &vectorForString{}
+
+var _ splittableForString = &vectorForString{}
+
+// VectorOfEntryForStringToInt builds an Iterator from a slice.
+func VectorOfEntryForStringToInt(slice []EntryForStringToInt) IteratorForEntryForStringToInt {
+	return IteratorForEntryForStringToInt{
+		iter: &vectorForEntryForStringToInt{slice: slice, cursor: 0},
+	}
+}
+
+type vectorForEntryForStringToInt struct {
+	slice  []EntryForStringToInt
+	cursor uint
+}
+
+func (v *vectorForEntryForStringToInt) Next() OptionForEntryForStringToInt {
+	if v.cursor >= uint(len(v.slice)) {
+		return NoneEntryForStringToInt()
+	}
+
+	item := v.slice[v.cursor]
+	v.cursor++
+
+	return SomeEntryForStringToInt(item)
+}
+
+func (v *vectorForEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	remaining := uint(len(v.slice)) - v.cursor
+
+	return remaining, SomeUint(remaining)
+}
+
+func (v *vectorForEntryForStringToInt) drain() uint {
+	remaining := uint(len(v.slice)) - v.cursor
+	v.cursor = uint(len(v.slice))
+
+	return remaining
+}
+
+func (v *vectorForEntryForStringToInt) Clone() (IterableForEntryForStringToInt, bool) {
+	return &vectorForEntryForStringToInt{slice: v.slice, cursor: v.cursor}, true
+}
+
+func (v *vectorForEntryForStringToInt) Reset() {
+	v.cursor = 0
+}
+
+// split consumes the Vector and returns at most parts Vectors sharing its slice.
+func (v *vectorForEntryForStringToInt) split(parts uint) []IterableForEntryForStringToInt {
+	slice := v.slice[v.cursor:]
+	v.cursor = uint(len(v.slice))
+
+	length := uint(len(slice))
+	size := (length + parts - 1) / parts
+
+	split := make([]IterableForEntryForStringToInt, 0, parts)
+	for start := uint(0); start < length; start += size {
+		end := start + size
+		if end > length {
+			end = length
+		}
+
+		split = append(split, &vectorForEntryForStringToInt{slice: slice[start:end], cursor: 0})
+	}
+
+	return split
+}
+
+var _ IterableForEntryForStringToInt = &vectorForEntryForStringToInt{}
+
+var _ CloneableForEntryForStringToInt = &vectorForEntryForStringToInt{}
+
+var _ ResettableForEntryForStringToInt = &vectorForEntryForStringToInt{}
+
+var _ splittableForEntryForStringToInt = &vectorForEntryForStringToInt{}
+
+// VectorOfSliceOfInt builds an Iterator from a slice.
+func VectorOfSliceOfInt(slice []SliceOfInt) IteratorForSliceOfInt {
+	return IteratorForSliceOfInt{
+		iter: &vectorForSliceOfInt{slice: slice, cursor: 0},
+	}
+}
+
+type vectorForSliceOfInt struct {
+	slice  []SliceOfInt
+	cursor uint
+}
+
+func (v *vectorForSliceOfInt) Next() OptionForSliceOfInt {
+	if v.cursor >= uint(len(v.slice)) {
+		return NoneSliceOfInt()
+	}
+
+	item := v.slice[v.cursor]
+	v.cursor++
+
+	return SomeSliceOfInt(item)
+}
+
+func (v *vectorForSliceOfInt) SizeHint() (uint, OptionForUint) {
+	remaining := uint(len(v.slice)) - v.cursor
+
+	return remaining, SomeUint(remaining)
+}
+
+func (v *vectorForSliceOfInt) drain() uint {
+	remaining := uint(len(v.slice)) - v.cursor
+	v.cursor = uint(len(v.slice))
+
+	return remaining
+}
+
+func (v *vectorForSliceOfInt) Clone() (IterableForSliceOfInt, bool) {
+	return &vectorForSliceOfInt{slice: v.slice, cursor: v.cursor}, true
+}
+
+func (v *vectorForSliceOfInt) Reset() {
+	v.cursor = 0
+}
+
+// split consumes the Vector and returns at most parts Vectors sharing its slice.
+func (v *vectorForSliceOfInt) split(parts uint) []IterableForSliceOfInt {
+	slice := v.slice[v.cursor:]
+	v.cursor = uint(len(v.slice))
+
+	length := uint(len(slice))
+	size := (length + parts - 1) / parts
+
+	split := make([]IterableForSliceOfInt, 0, parts)
+	for start := uint(0); start < length; start += size {
+		end := start + size
+		if end > length {
+			end = length
+		}
+
+		split = append(split, &vectorForSliceOfInt{slice: slice[start:end], cursor: 0})
+	}
+
+	return split
+}
+
+var _ IterableForSliceOfInt = &vectorForSliceOfInt{}
+
+var _ CloneableForSliceOfInt = &vectorForSliceOfInt{}
+
+var _ ResettableForSliceOfInt = &vectorForSliceOfInt{}
+
+var _ splittableForSliceOfInt = &vectorForSliceOfInt{}
+
+// VectorOfSliceOfString builds an Iterator from a slice.
+func VectorOfSliceOfString(slice []SliceOfString) IteratorForSliceOfString {
+	return IteratorForSliceOfString{
+		iter: &vectorForSliceOfString{slice: slice, cursor: 0},
+	}
+}
+
+type vectorForSliceOfString struct {
+	slice  []SliceOfString
+	cursor uint
+}
+
+func (v *vectorForSliceOfString) Next() OptionForSliceOfString {
+	if v.cursor >= uint(len(v.slice)) {
+		return NoneSliceOfString()
+	}
+
+	item := v.slice[v.cursor]
+	v.cursor++
+
+	return SomeSliceOfString(item)
+}
+
+func (v *vectorForSliceOfString) SizeHint() (uint, OptionForUint) {
+	remaining := uint(len(v.slice)) - v.cursor
+
+	return remaining, SomeUint(remaining)
+}
+
+func (v *vectorForSliceOfString) drain() uint {
+	remaining := uint(len(v.slice)) - v.cursor
+	v.cursor = uint(len(v.slice))
+
+	return remaining
+}
+
+func (v *vectorForSliceOfString) Clone() (IterableForSliceOfString, bool) {
+	return &vectorForSliceOfString{slice: v.slice, cursor: v.cursor}, true
+}
+
+func (v *vectorForSliceOfString) Reset() {
+	v.cursor = 0
+}
+
+// split consumes the Vector and returns at most parts Vectors sharing its slice.
+func (v *vectorForSliceOfString) split(parts uint) []IterableForSliceOfString {
+	slice := v.slice[v.cursor:]
+	v.cursor = uint(len(v.slice))
+
+	length := uint(len(slice))
+	size := (length + parts - 1) / parts
+
+	split := make([]IterableForSliceOfString, 0, parts)
+	for start := uint(0); start < length; start += size {
+		end := start + size
+		if end > length {
+			end = length
+		}
+
+		split = append(split, &vectorForSliceOfString{slice: slice[start:end], cursor: 0})
+	}
+
+	return split
+}
+
+var _ IterableForSliceOfString = &vectorForSliceOfString{}
+
+var _ CloneableForSliceOfString = &vectorForSliceOfString{}
+
+var _ ResettableForSliceOfString = &vectorForSliceOfString{}
+
+var _ splittableForSliceOfString = &vectorForSliceOfString{}
+
+// VectorOfSliceOfEntryForStringToInt builds an Iterator from a slice.
+func VectorOfSliceOfEntryForStringToInt(slice []SliceOfEntryForStringToInt) IteratorForSliceOfEntryForStringToInt {
+	return IteratorForSliceOfEntryForStringToInt{
+		iter: &vectorForSliceOfEntryForStringToInt{slice: slice, cursor: 0},
+	}
+}
+
+type vectorForSliceOfEntryForStringToInt struct {
+	slice  []SliceOfEntryForStringToInt
+	cursor uint
+}
+
+func (v *vectorForSliceOfEntryForStringToInt) Next() OptionForSliceOfEntryForStringToInt {
+	if v.cursor >= uint(len(v.slice)) {
+		return NoneSliceOfEntryForStringToInt()
+	}
+
+	item := v.slice[v.cursor]
+	v.cursor++
+
+	return SomeSliceOfEntryForStringToInt(item)
+}
+
+func (v *vectorForSliceOfEntryForStringToInt) SizeHint() (uint, OptionForUint) {
+	remaining := uint(len(v.slice)) - v.cursor
+
+	return remaining, SomeUint(remaining)
+}
+
+func (v *vectorForSliceOfEntryForStringToInt) drain() uint {
+	remaining := uint(len(v.slice)) - v.cursor
+	v.cursor = uint(len(v.slice))
+
+	return remaining
+}
+
+func (v *vectorForSliceOfEntryForStringToInt) Clone() (IterableForSliceOfEntryForStringToInt, bool) {
+	return &vectorForSliceOfEntryForStringToInt{slice: v.slice, cursor: v.cursor}, true
+}
+
+func (v *vectorForSliceOfEntryForStringToInt) Reset() {
+	v.cursor = 0
+}
+
+// split consumes the Vector and returns at most parts Vectors sharing its slice.
+func (v *vectorForSliceOfEntryForStringToInt) split(parts uint) []IterableForSliceOfEntryForStringToInt {
+	slice := v.slice[v.cursor:]
+	v.cursor = uint(len(v.slice))
+
+	length := uint(len(slice))
+	size := (length + parts - 1) / parts
+
+	split := make([]IterableForSliceOfEntryForStringToInt, 0, parts)
+	for start := uint(0); start < length; start += size {
+		end := start + size
+		if end > length {
+			end = length
+		}
+
+		split = append(split, &vectorForSliceOfEntryForStringToInt{slice: slice[start:end], cursor: 0})
+	}
+
+	return split
+}
+
+var _ IterableForSliceOfEntryForStringToInt = &vectorForSliceOfEntryForStringToInt{}
+
+var _ CloneableForSliceOfEntryForStringToInt = &vectorForSliceOfEntryForStringToInt{}
+
+var _ ResettableForSliceOfEntryForStringToInt = &vectorForSliceOfEntryForStringToInt{}
+
+var _ splittableForSliceOfEntryForStringToInt = &vectorForSliceOfEntryForStringToInt{}
+
+// VectorOfCSVRecord builds an Iterator from a slice.
+func VectorOfCSVRecord(slice []CSVRecord) IteratorForCSVRecord {
+	return IteratorForCSVRecord{
+		iter: &vectorForCSVRecord{slice: slice, cursor: 0},
+	}
+}
+
+type vectorForCSVRecord struct {
+	slice  []CSVRecord
+	cursor uint
+}
+
+func (v *vectorForCSVRecord) Next() OptionForCSVRecord {
+	if v.cursor >= uint(len(v.slice)) {
+		return NoneCSVRecord()
+	}
+
+	item := v.slice[v.cursor]
+	v.cursor++
+
+	return SomeCSVRecord(item)
+}
+
+func (v *vectorForCSVRecord) SizeHint() (uint, OptionForUint) {
+	remaining := uint(len(v.slice)) - v.cursor
+
+	return remaining, SomeUint(remaining)
+}
+
+func (v *vectorForCSVRecord) drain() uint {
+	remaining := uint(len(v.slice)) - v.cursor
+	v.cursor = uint(len(v.slice))
+
+	return remaining
+}
+
+func (v *vectorForCSVRecord) Clone() (IterableForCSVRecord, bool) {
+	return &vectorForCSVRecord{slice: v.slice, cursor: v.cursor}, true
+}
+
+func (v *vectorForCSVRecord) Reset() {
+	v.cursor = 0
+}
+
+// split consumes the Vector and returns at most parts Vectors sharing its slice.
+func (v *vectorForCSVRecord) split(parts uint) []IterableForCSVRecord {
+	slice := v.slice[v.cursor:]
+	v.cursor = uint(len(v.slice))
+
+	length := uint(len(slice))
+	size := (length + parts - 1) / parts
+
+	split := make([]IterableForCSVRecord, 0, parts)
+	for start := uint(0); start < length; start += size {
+		end := start + size
+		if end > length {
+			end = length
+		}
+
+		split = append(split, &vectorForCSVRecord{slice: slice[start:end], cursor: 0})
+	}
+
+	return split
+}
+
+var _ IterableForCSVRecord = &vectorForCSVRecord{}
+
+var _ CloneableForCSVRecord = &vectorForCSVRecord{}
+
+var _ ResettableForCSVRecord = &vectorForCSVRecord{}
+
+var _ splittableForCSVRecord = &vectorForCSVRecord{}
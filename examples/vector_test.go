@@ -99,6 +99,33 @@ func TestVectorCount(t *testing.T) {
 	}
 }
 
+func TestCountConsumes(t *testing.T) {
+	calls := 0
+	mapped := VectorOfInt([]int{0, 1, 2}).Map(func(item int) int {
+		calls++
+		return item
+	})
+
+	testCases := map[IteratorForInt]uint{
+		VectorOfInt([]int{0, 1, 2}).Skip(1): 2,
+		Range(0, 10, 3):                     4,
+		Range(0, -5, -2):                    3,
+		mapped:                              3,
+	}
+
+	for iter, want := range testCases {
+		got := iter.Count()
+
+		if next := iter.Next(); got != want || next.IsSome() {
+			t.Errorf("case: %s; got: (%d, %v); expected: (%d, %v)", iter, got, next, want, NoneInt())
+		}
+	}
+
+	if calls != 3 {
+		t.Errorf("got: %d mapper calls; expected: %d", calls, 3)
+	}
+}
+
 func TestVectorLast(t *testing.T) {
 	testCases := map[IteratorForInt]OptionForInt{
 		VectorOfInt([]int{}):            NoneInt(),
@@ -319,6 +346,44 @@ func TestVectorChain(t *testing.T) {
 	}
 }
 
+func TestVectorSizeHint(t *testing.T) {
+	testCases := map[IteratorForInt]uint{
+		VectorOfInt([]int{}):                                         0,
+		VectorOfInt([]int{0}):                                        1,
+		VectorOfInt([]int{0, 1, 2, 3}):                               4,
+		VectorOfInt([]int{0, 1, 2, 3}).Skip(3):                       1,
+		VectorOfInt([]int{0, 1, 2, 3}).Take(3):                       3,
+		VectorOfInt([]int{0, 1, -2, 3}).Chain(VectorOfInt([]int{0})): 5,
+		VectorOfInt([]int{0, 1, -2, 3}).Map(func(item int) int { return item * item }): 4,
+	}
+
+	for iter, want := range testCases {
+		lower, upper := iter.SizeHint()
+
+		if lower != want || !reflect.DeepEqual(upper, SomeUint(want)) {
+			t.Errorf("case: %s; got: (%d, %v); expected: (%d, %v)", iter, lower, upper, want, SomeUint(want))
+		}
+	}
+}
+
+func TestVectorTakeWhileSizeHint(t *testing.T) {
+	lower, upper := VectorOfInt([]int{0, 1, -2, 3}).TakeWhile(func(item int) bool {
+		return item >= 0
+	}).SizeHint()
+
+	if lower != 0 || !reflect.DeepEqual(upper, SomeUint(4)) {
+		t.Errorf("got: (%d, %v); expected: (%d, %v)", lower, upper, 0, SomeUint(4))
+	}
+}
+
+func TestVectorCollectCapacity(t *testing.T) {
+	got := VectorOfString([]string{"a", "b", "c"}).Collect()
+
+	if len(got) != 3 || cap(got) != 3 {
+		t.Errorf("got: len %d, cap %d; expected: len %d, cap %d", len(got), cap(got), 3, 3)
+	}
+}
+
 func BenchmarkVectorStringSearch(b *testing.B) {
 	text := []string{
 		"Lorem", "ipsum", "dolor", "sit", "amet,", "consectetur", "adipiscing", "elit.", "Ut", "tincidunt", "felis", "at", "purus", "congue,", "eu", "sollicitudin", "elit", "condimentum.", "Morbi", "efficitur", "egestas", "porta.", "Suspendisse", "quis", "tellus", "facilisis,", "ultricies", "dolor", "a,", "eleifend", "nisi.", "Suspendisse", "euismod", "metus", "mi,", "quis", "porttitor", "turpis", "auctor", "blandit.", "Cras", "ut", "lobortis", "massa.", "Donec", "dignissim", "pretium", "nisi,", "sed", "tincidunt", "urna", "porttitor", "nec.", "Phasellus", "vulputate", "tincidunt", "fermentum.", "Pellentesque", "at", "lobortis", "ante.", "Donec", "arcu", "ligula,", "pharetra", "a", "congue", "sed,", "ultricies", "vitae", "felis.", "Phasellus", "interdum", "quam", "sit", "amet", "libero", "elementum", "molestie.", "Integer", "porta", "felis", "vitae", "risus", "laoreet", "cursus.", "Mauris", "libero", "odio,", "eleifend", "eu", "mauris", "sit", "amet,", "laoreet", "volutpat", "quam.", "Etiam", "dictum", "diam", "vel", "laoreet", "feugiat.", "Vestibulum", "ante", "ipsum", "primis", "in", "faucibus", "orci", "luctus", "et", "ultrices", "posuere", "cubilia", "curae;", "Fusce", "suscipit", "posuere", "nunc", "id", "consequat.", "Etiam", "nulla", "nunc,", "tincidunt", "nec", "rhoncus", "vel,", "ultrices", "vel", "massa.", "In", "hac", "habitasse", "platea", "dictumst.", "Mauris", "quis", "dui", "a", "lacus", "varius", "molestie.", "Cras", "sollicitudin", "a", "orci", "eu", "feugiat.", "Integer", "cursus", "justo", "quis", "felis", "tincidunt", "iaculis.", "Phasellus", "feugiat", "vitae", "justo", "eu", "dignissim.", "Duis", "ut", "euismod", "metus.", "Fusce", "id", "justo", "ante.", "Mauris", "sit", "amet", "efficitur", "mauris.", "Mauris", "et", "enim", "at", "turpis", "volutpat", "semper.", "Donec", "fringilla", "nibh", "ante,", "lacinia", "condimentum", "velit", "viverra", "ut.", "Proin", "quis", "dolor", "vel", "tellus", "facilisis", "cursus", "non", "eu", "ligula.", "Maecenas", "malesuada", "lacus", "sit", "amet", "magna", "facilisis", "efficitur.", "Pellentesque", "a", "interdum", "purus.", "Pellentesque", "vulputate", "consequat", "enim,", "viverra", "fermentum", "augue", "pharetra", "vitae.", "Sed", "vitae", "nulla", "nec", "tortor", "molestie", "iaculis.", "Nunc", "pharetra", "feugiat", "odio,", "vitae", "tempus", "neque", "faucibus", "eget.", "Nulla", "rutrum", "suscipit", "tincidunt.", "Sed", "semper", "tellus", "at", "diam", "sodales", "tincidunt", "eget", "sed", "libero.", "In", "egestas", "mi", "in", "odio", "blandit", "laoreet.", "Praesent", "accumsan", "metus", "vitae", "facilisis", "lacinia.", "Vivamus", "sed", "enim", "a", "nisi", "varius", "venenatis", "id", "consectetur", "risus.", "Vestibulum", "non", "dolor", "feugiat,", "pellentesque", "est", "ac,", "maximus", "neque.", "Pellentesque", "consequat", "tellus", "a", "consectetur", "porta.", "Nunc", "iaculis", "et", "arcu", "nec", "dapibus.", "Maecenas", "id", "lacinia", "nisi,", "non", "tincidunt", "orci.", "Maecenas", "et", "dui", "in", "libero", "fermentum", "egestas.", "Etiam", "rutrum", "ligula", "ipsum,", "vel", "gravida", "lorem", "pellentesque", "sed.", "Quisque", "sit", "amet", "facilisis", "libero.", "Curabitur", "semper", "quam", "a", "leo", "fringilla", "maximus.", "Nullam", "eros", "leo,", "pretium", "non", "volutpat", "volutpat,", "feugiat", "porta", "diam.", "Quisque", "odio", "metus,", "varius", "et", "iaculis", "vitae,", "gravida", "eu", "diam.", "Etiam", "pellentesque", "faucibus", "lorem,", "quis", "iaculis", "metus.", "Nullam", "vehicula", "consectetur", "lacus,", "id", "sodales", "diam", "auctor", "luctus.", "Proin", "sit", "amet", "ante", "nisi.", "Donec", "varius", "egestas", "consectetur.", "Ut", "a", "lacus", "eros.", "Phasellus", "vestibulum", "enim", "sit", "amet", "purus", "scelerisque", "bibendum.", "Integer", "lacus", "sapien,", "tempus", "id", "commodo", "in,", "blandit", "vitae", "arcu.", "Aenean", "at", "ornare", "nunc.",
@@ -0,0 +1,128 @@
+package iter
+
+import (
+	"io/fs"
+	"path"
+)
+
+// WalkDir builds an Iterator lazily yielding the paths of the file tree rooted at root in fsys, in lexical order.
+// Directories are read only once the Iterator reaches them.
+// If visit is not nil, it is called on each entry before it is yielded: returning fs.SkipDir skips the entry
+// (and its contents for a directory), returning fs.SkipAll stops the walk and any other error stops it too.
+// Walk and visit errors are available through the Iterator Err method.
+func WalkDir(fsys fs.FS, root string, visit func(path string, entry fs.DirEntry) error) IteratorForString {
+	return IteratorForString{
+		iter: &walkIterable{fsys: fsys, root: root, visit: visit, stack: nil, started: false, flag: false, err: nil},
+	}
+}
+
+type walkIterable struct {
+	fsys    fs.FS
+	root    string
+	visit   func(path string, entry fs.DirEntry) error
+	stack   []walkDirectory
+	started bool
+	flag    bool
+	err     error
+}
+
+// walkDirectory is a directory whose entries are being walked.
+type walkDirectory struct {
+	path    string
+	entries []fs.DirEntry
+	read    bool
+}
+
+func (w *walkIterable) Next() OptionForString {
+	if w.flag {
+		return NoneString()
+	}
+
+	if !w.started {
+		w.started = true
+
+		info, err := fs.Stat(w.fsys, w.root)
+		if err != nil {
+			w.stop(err)
+			return NoneString()
+		}
+
+		if w.enter(w.root, fs.FileInfoToDirEntry(info)) {
+			return SomeString(w.root)
+		}
+	}
+
+	for !w.flag && len(w.stack) > 0 {
+		top := &w.stack[len(w.stack)-1]
+
+		if !top.read {
+			entries, err := fs.ReadDir(w.fsys, top.path)
+			if err != nil {
+				w.stop(err)
+				return NoneString()
+			}
+
+			top.entries = entries
+			top.read = true
+		}
+
+		if len(top.entries) == 0 {
+			w.stack = w.stack[:len(w.stack)-1]
+			continue
+		}
+
+		entry := top.entries[0]
+		top.entries = top.entries[1:]
+
+		name := path.Join(top.path, entry.Name())
+		if w.enter(name, entry) {
+			return SomeString(name)
+		}
+	}
+
+	w.flag = true
+
+	return NoneString()
+}
+
+// enter visits an entry and reports whether it should be yielded, pushing it on the stack if it is a directory.
+func (w *walkIterable) enter(name string, entry fs.DirEntry) bool {
+	if w.visit != nil {
+		if err := w.visit(name, entry); err != nil {
+			if err != fs.SkipDir {
+				w.stop(err)
+			}
+
+			return false
+		}
+	}
+
+	if entry.IsDir() {
+		w.stack = append(w.stack, walkDirectory{path: name, entries: nil, read: false})
+	}
+
+	return true
+}
+
+func (w *walkIterable) stop(err error) {
+	w.flag = true
+	w.stack = nil
+
+	if err != fs.SkipAll {
+		w.err = err
+	}
+}
+
+func (w *walkIterable) SizeHint() (uint, OptionForUint) {
+	if w.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (w *walkIterable) Err() error {
+	return w.err
+}
+
+var _ IterableForString = &walkIterable{}
@@ -0,0 +1,110 @@
+package iter
+
+import (
+	"errors"
+	"io/fs"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+var walkTree = fstest.MapFS{
+	"go.mod":               {Data: []byte("module example")},
+	"cmd/main.go":          {Data: []byte("package main")},
+	"pkg/iter.go":          {Data: []byte("package pkg")},
+	"pkg/iter_test.go":     {Data: []byte("package pkg")},
+	"pkg/vendor/dep/a.go":  {Data: []byte("package dep")},
+	"pkg/vendor/dep/b.txt": {Data: []byte("b")},
+}
+
+func skipVendor(path string, entry fs.DirEntry) error {
+	if entry.IsDir() && entry.Name() == "vendor" {
+		return fs.SkipDir
+	}
+
+	return nil
+}
+
+func TestWalkDir(t *testing.T) {
+	testCases := map[IteratorForString][]string{
+		WalkDir(walkTree, ".", nil): {
+			".", "cmd", "cmd/main.go", "go.mod", "pkg", "pkg/iter.go", "pkg/iter_test.go",
+			"pkg/vendor", "pkg/vendor/dep", "pkg/vendor/dep/a.go", "pkg/vendor/dep/b.txt",
+		},
+		WalkDir(walkTree, "pkg", skipVendor):        {"pkg", "pkg/iter.go", "pkg/iter_test.go"},
+		WalkDir(walkTree, "go.mod", nil):            {"go.mod"},
+		WalkDir(walkTree, "pkg/vendor", skipVendor): {},
+	}
+
+	for iter, want := range testCases {
+		got := iter.Collect()
+
+		if !reflect.DeepEqual(got, want) || iter.Err() != nil {
+			t.Errorf("case: %v; got: (%q, %v); expected: (%q, %v)", iter, got, iter.Err(), want, nil)
+		}
+	}
+}
+
+func TestWalkDirWithFilterAndMap(t *testing.T) {
+	got := WalkDir(walkTree, ".", skipVendor).Filter(func(path string) bool {
+		return strings.HasSuffix(path, ".go") && !strings.HasSuffix(path, "_test.go")
+	}).Map(func(path string) string {
+		return strings.TrimSuffix(path, ".go")
+	}).Collect()
+
+	want := []string{"cmd/main", "pkg/iter"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %q; expected: %q", got, want)
+	}
+}
+
+func TestWalkDirIsLazy(t *testing.T) {
+	visited := []string{}
+	iter := WalkDir(walkTree, ".", func(path string, entry fs.DirEntry) error {
+		visited = append(visited, path)
+		return nil
+	})
+
+	got := []string{iter.Next().Unwrap(), iter.Next().Unwrap()}
+
+	want := []string{".", "cmd"}
+	if !reflect.DeepEqual(got, want) || !reflect.DeepEqual(visited, want) {
+		t.Errorf("got: (%q, %q); expected: (%q, %q)", got, visited, want, want)
+	}
+}
+
+func TestWalkDirStop(t *testing.T) {
+	errVisit := errors.New("visit failed")
+
+	testCases := map[error]error{
+		fs.SkipAll: nil,
+		errVisit:   errVisit,
+	}
+
+	for stop, want := range testCases {
+		iter := WalkDir(walkTree, ".", func(path string, entry fs.DirEntry) error {
+			if path == "go.mod" {
+				return stop
+			}
+
+			return nil
+		})
+
+		got := iter.Collect()
+
+		if !reflect.DeepEqual(got, []string{".", "cmd", "cmd/main.go"}) || iter.Err() != want {
+			t.Errorf("case: %v; got: (%q, %v); expected: (%q, %v)", stop, got, iter.Err(), []string{".", "cmd", "cmd/main.go"}, want)
+		}
+	}
+}
+
+func TestWalkDirError(t *testing.T) {
+	iter := WalkDir(walkTree, "missing", nil)
+
+	got := iter.Collect()
+
+	if len(got) != 0 || !errors.Is(iter.Err(), fs.ErrNotExist) {
+		t.Errorf("got: (%q, %v); expected: (%q, %v)", got, iter.Err(), []string{}, fs.ErrNotExist)
+	}
+}
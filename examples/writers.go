@@ -0,0 +1,719 @@
+// This file was automatically generated by genny.
+// Any changes will be lost if this file is regenerated.
+// see https://github.com/cheekybits/genny
+
+package iter
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// WriteLines writes every element of the Iterator to w on its own line, formatted by format or by fmt if format is nil.
+// Each line is written as soon as its element is yielded. It returns the first write error, or else the Iterator error.
+func (i IteratorForInt) WriteLines(w io.Writer, format func(item int) string) error {
+	if format == nil {
+		format = func(item int) string { return fmt.Sprint(item) }
+	}
+
+	return i.writeForInt(func(index uint, item int) error {
+		if _, err := io.WriteString(w, format(item)+"\n"); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteCSV writes every element of the Iterator to w as a CSV row built by format.
+// Each row is flushed as soon as its element is yielded. It returns the first write error, or else the Iterator error.
+func (i IteratorForInt) WriteCSV(w io.Writer, format func(item int) []string) error {
+	writer := csv.NewWriter(w)
+
+	return i.writeForInt(func(index uint, item int) error {
+		if err := writer.Write(format(item)); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteNDJSON writes every element of the Iterator to w as one JSON value per line.
+// Each line is written as soon as its element is yielded. It returns the first encoding or write error, or else the Iterator error.
+func (i IteratorForInt) WriteNDJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+
+	return i.writeForInt(func(index uint, item int) error {
+		if err := encoder.Encode(item); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteJSONArray writes the elements of the Iterator to w as a single JSON array.
+// Each value is written as soon as its element is yielded, so the array is only valid once WriteJSONArray returned nil.
+// It returns the first encoding or write error, or else the Iterator error.
+func (i IteratorForInt) WriteJSONArray(w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		i.Close()
+		return fmt.Errorf("opening array: %w", err)
+	}
+
+	if err := i.writeForInt(func(index uint, item int) error {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return fmt.Errorf("encoding element %d: %w", index, err)
+		}
+
+		if index > 0 {
+			data = append([]byte(","), data...)
+		}
+
+		if _, err := w.Write(data); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, "]\n"); err != nil {
+		return fmt.Errorf("closing array: %w", err)
+	}
+
+	return nil
+}
+
+// writeForInt calls write for every element of the Iterator until it fails, then closes the Iterator.
+func (i IteratorForInt) writeForInt(write func(index uint, item int) error) error {
+	defer i.Close()
+
+	var index uint
+
+	for item := i.Next(); item.IsSome(); item = i.Next() {
+		if err := write(index, item.Unwrap()); err != nil {
+			return err
+		}
+
+		index++
+	}
+
+	return i.Err()
+}
+
+// WriteLines writes every element of the Iterator to w on its own line, formatted by format or by fmt if format is nil.
+// Each line is written as soon as its element is yielded. It returns the first write error, or else the Iterator error.
+func (i IteratorForString) WriteLines(w io.Writer, format func(item string) string) error {
+	if format == nil {
+		format = func(item string) string { return fmt.Sprint(item) }
+	}
+
+	return i.writeForString(func(index uint, item string) error {
+		if _, err := io.WriteString(w, format(item)+"\n"); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteCSV writes every element of the Iterator to w as a CSV row built by format.
+// Each row is flushed as soon as its element is yielded. It returns the first write error, or else the Iterator error.
+func (i IteratorForString) WriteCSV(w io.Writer, format func(item string) []string) error {
+	writer := csv.NewWriter(w)
+
+	return i.writeForString(func(index uint, item string) error {
+		if err := writer.Write(format(item)); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteNDJSON writes every element of the Iterator to w as one JSON value per line.
+// Each line is written as soon as its element is yielded. It returns the first encoding or write error, or else the Iterator error.
+func (i IteratorForString) WriteNDJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+
+	return i.writeForString(func(index uint, item string) error {
+		if err := encoder.Encode(item); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteJSONArray writes the elements of the Iterator to w as a single JSON array.
+// Each value is written as soon as its element is yielded, so the array is only valid once WriteJSONArray returned nil.
+// It returns the first encoding or write error, or else the Iterator error.
+func (i IteratorForString) WriteJSONArray(w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		i.Close()
+		return fmt.Errorf("opening array: %w", err)
+	}
+
+	if err := i.writeForString(func(index uint, item string) error {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return fmt.Errorf("encoding element %d: %w", index, err)
+		}
+
+		if index > 0 {
+			data = append([]byte(","), data...)
+		}
+
+		if _, err := w.Write(data); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, "]\n"); err != nil {
+		return fmt.Errorf("closing array: %w", err)
+	}
+
+	return nil
+}
+
+// writeForString calls write for every element of the Iterator until it fails, then closes the Iterator.
+func (i IteratorForString) writeForString(write func(index uint, item string) error) error {
+	defer i.Close()
+
+	var index uint
+
+	for item := i.Next(); item.IsSome(); item = i.Next() {
+		if err := write(index, item.Unwrap()); err != nil {
+			return err
+		}
+
+		index++
+	}
+
+	return i.Err()
+}
+
+// WriteLines writes every element of the Iterator to w on its own line, formatted by format or by fmt if format is nil.
+// Each line is written as soon as its element is yielded. It returns the first write error, or else the Iterator error.
+func (i IteratorForEntryForStringToInt) WriteLines(w io.Writer, format func(item EntryForStringToInt) string) error {
+	if format == nil {
+		format = func(item EntryForStringToInt) string { return fmt.Sprint(item) }
+	}
+
+	return i.writeForEntryForStringToInt(func(index uint, item EntryForStringToInt) error {
+		if _, err := io.WriteString(w, format(item)+"\n"); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteCSV writes every element of the Iterator to w as a CSV row built by format.
+// Each row is flushed as soon as its element is yielded. It returns the first write error, or else the Iterator error.
+func (i IteratorForEntryForStringToInt) WriteCSV(w io.Writer, format func(item EntryForStringToInt) []string) error {
+	writer := csv.NewWriter(w)
+
+	return i.writeForEntryForStringToInt(func(index uint, item EntryForStringToInt) error {
+		if err := writer.Write(format(item)); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteNDJSON writes every element of the Iterator to w as one JSON value per line.
+// Each line is written as soon as its element is yielded. It returns the first encoding or write error, or else the Iterator error.
+func (i IteratorForEntryForStringToInt) WriteNDJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+
+	return i.writeForEntryForStringToInt(func(index uint, item EntryForStringToInt) error {
+		if err := encoder.Encode(item); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteJSONArray writes the elements of the Iterator to w as a single JSON array.
+// Each value is written as soon as its element is yielded, so the array is only valid once WriteJSONArray returned nil.
+// It returns the first encoding or write error, or else the Iterator error.
+func (i IteratorForEntryForStringToInt) WriteJSONArray(w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		i.Close()
+		return fmt.Errorf("opening array: %w", err)
+	}
+
+	if err := i.writeForEntryForStringToInt(func(index uint, item EntryForStringToInt) error {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return fmt.Errorf("encoding element %d: %w", index, err)
+		}
+
+		if index > 0 {
+			data = append([]byte(","), data...)
+		}
+
+		if _, err := w.Write(data); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, "]\n"); err != nil {
+		return fmt.Errorf("closing array: %w", err)
+	}
+
+	return nil
+}
+
+// writeForEntryForStringToInt calls write for every element of the Iterator until it fails, then closes the Iterator.
+func (i IteratorForEntryForStringToInt) writeForEntryForStringToInt(write func(index uint, item EntryForStringToInt) error) error {
+	defer i.Close()
+
+	var index uint
+
+	for item := i.Next(); item.IsSome(); item = i.Next() {
+		if err := write(index, item.Unwrap()); err != nil {
+			return err
+		}
+
+		index++
+	}
+
+	return i.Err()
+}
+
+// WriteLines writes every element of the Iterator to w on its own line, formatted by format or by fmt if format is nil.
+// Each line is written as soon as its element is yielded. It returns the first write error, or else the Iterator error.
+func (i IteratorForSliceOfInt) WriteLines(w io.Writer, format func(item SliceOfInt) string) error {
+	if format == nil {
+		format = func(item SliceOfInt) string { return fmt.Sprint(item) }
+	}
+
+	return i.writeForSliceOfInt(func(index uint, item SliceOfInt) error {
+		if _, err := io.WriteString(w, format(item)+"\n"); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteCSV writes every element of the Iterator to w as a CSV row built by format.
+// Each row is flushed as soon as its element is yielded. It returns the first write error, or else the Iterator error.
+func (i IteratorForSliceOfInt) WriteCSV(w io.Writer, format func(item SliceOfInt) []string) error {
+	writer := csv.NewWriter(w)
+
+	return i.writeForSliceOfInt(func(index uint, item SliceOfInt) error {
+		if err := writer.Write(format(item)); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteNDJSON writes every element of the Iterator to w as one JSON value per line.
+// Each line is written as soon as its element is yielded. It returns the first encoding or write error, or else the Iterator error.
+func (i IteratorForSliceOfInt) WriteNDJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+
+	return i.writeForSliceOfInt(func(index uint, item SliceOfInt) error {
+		if err := encoder.Encode(item); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteJSONArray writes the elements of the Iterator to w as a single JSON array.
+// Each value is written as soon as its element is yielded, so the array is only valid once WriteJSONArray returned nil.
+// It returns the first encoding or write error, or else the Iterator error.
+func (i IteratorForSliceOfInt) WriteJSONArray(w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		i.Close()
+		return fmt.Errorf("opening array: %w", err)
+	}
+
+	if err := i.writeForSliceOfInt(func(index uint, item SliceOfInt) error {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return fmt.Errorf("encoding element %d: %w", index, err)
+		}
+
+		if index > 0 {
+			data = append([]byte(","), data...)
+		}
+
+		if _, err := w.Write(data); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, "]\n"); err != nil {
+		return fmt.Errorf("closing array: %w", err)
+	}
+
+	return nil
+}
+
+// writeForSliceOfInt calls write for every element of the Iterator until it fails, then closes the Iterator.
+func (i IteratorForSliceOfInt) writeForSliceOfInt(write func(index uint, item SliceOfInt) error) error {
+	defer i.Close()
+
+	var index uint
+
+	for item := i.Next(); item.IsSome(); item = i.Next() {
+		if err := write(index, item.Unwrap()); err != nil {
+			return err
+		}
+
+		index++
+	}
+
+	return i.Err()
+}
+
+// WriteLines writes every element of the Iterator to w on its own line, formatted by format or by fmt if format is nil.
+// Each line is written as soon as its element is yielded. It returns the first write error, or else the Iterator error.
+func (i IteratorForSliceOfString) WriteLines(w io.Writer, format func(item SliceOfString) string) error {
+	if format == nil {
+		format = func(item SliceOfString) string { return fmt.Sprint(item) }
+	}
+
+	return i.writeForSliceOfString(func(index uint, item SliceOfString) error {
+		if _, err := io.WriteString(w, format(item)+"\n"); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteCSV writes every element of the Iterator to w as a CSV row built by format.
+// Each row is flushed as soon as its element is yielded. It returns the first write error, or else the Iterator error.
+func (i IteratorForSliceOfString) WriteCSV(w io.Writer, format func(item SliceOfString) []string) error {
+	writer := csv.NewWriter(w)
+
+	return i.writeForSliceOfString(func(index uint, item SliceOfString) error {
+		if err := writer.Write(format(item)); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteNDJSON writes every element of the Iterator to w as one JSON value per line.
+// Each line is written as soon as its element is yielded. It returns the first encoding or write error, or else the Iterator error.
+func (i IteratorForSliceOfString) WriteNDJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+
+	return i.writeForSliceOfString(func(index uint, item SliceOfString) error {
+		if err := encoder.Encode(item); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteJSONArray writes the elements of the Iterator to w as a single JSON array.
+// Each value is written as soon as its element is yielded, so the array is only valid once WriteJSONArray returned nil.
+// It returns the first encoding or write error, or else the Iterator error.
+func (i IteratorForSliceOfString) WriteJSONArray(w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		i.Close()
+		return fmt.Errorf("opening array: %w", err)
+	}
+
+	if err := i.writeForSliceOfString(func(index uint, item SliceOfString) error {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return fmt.Errorf("encoding element %d: %w", index, err)
+		}
+
+		if index > 0 {
+			data = append([]byte(","), data...)
+		}
+
+		if _, err := w.Write(data); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, "]\n"); err != nil {
+		return fmt.Errorf("closing array: %w", err)
+	}
+
+	return nil
+}
+
+// writeForSliceOfString calls write for every element of the Iterator until it fails, then closes the Iterator.
+func (i IteratorForSliceOfString) writeForSliceOfString(write func(index uint, item SliceOfString) error) error {
+	defer i.Close()
+
+	var index uint
+
+	for item := i.Next(); item.IsSome(); item = i.Next() {
+		if err := write(index, item.Unwrap()); err != nil {
+			return err
+		}
+
+		index++
+	}
+
+	return i.Err()
+}
+
+// WriteLines writes every element of the Iterator to w on its own line, formatted by format or by fmt if format is nil.
+// Each line is written as soon as its element is yielded. It returns the first write error, or else the Iterator error.
+func (i IteratorForSliceOfEntryForStringToInt) WriteLines(w io.Writer, format func(item SliceOfEntryForStringToInt) string) error {
+	if format == nil {
+		format = func(item SliceOfEntryForStringToInt) string { return fmt.Sprint(item) }
+	}
+
+	return i.writeForSliceOfEntryForStringToInt(func(index uint, item SliceOfEntryForStringToInt) error {
+		if _, err := io.WriteString(w, format(item)+"\n"); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteCSV writes every element of the Iterator to w as a CSV row built by format.
+// Each row is flushed as soon as its element is yielded. It returns the first write error, or else the Iterator error.
+func (i IteratorForSliceOfEntryForStringToInt) WriteCSV(w io.Writer, format func(item SliceOfEntryForStringToInt) []string) error {
+	writer := csv.NewWriter(w)
+
+	return i.writeForSliceOfEntryForStringToInt(func(index uint, item SliceOfEntryForStringToInt) error {
+		if err := writer.Write(format(item)); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteNDJSON writes every element of the Iterator to w as one JSON value per line.
+// Each line is written as soon as its element is yielded. It returns the first encoding or write error, or else the Iterator error.
+func (i IteratorForSliceOfEntryForStringToInt) WriteNDJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+
+	return i.writeForSliceOfEntryForStringToInt(func(index uint, item SliceOfEntryForStringToInt) error {
+		if err := encoder.Encode(item); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteJSONArray writes the elements of the Iterator to w as a single JSON array.
+// Each value is written as soon as its element is yielded, so the array is only valid once WriteJSONArray returned nil.
+// It returns the first encoding or write error, or else the Iterator error.
+func (i IteratorForSliceOfEntryForStringToInt) WriteJSONArray(w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		i.Close()
+		return fmt.Errorf("opening array: %w", err)
+	}
+
+	if err := i.writeForSliceOfEntryForStringToInt(func(index uint, item SliceOfEntryForStringToInt) error {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return fmt.Errorf("encoding element %d: %w", index, err)
+		}
+
+		if index > 0 {
+			data = append([]byte(","), data...)
+		}
+
+		if _, err := w.Write(data); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, "]\n"); err != nil {
+		return fmt.Errorf("closing array: %w", err)
+	}
+
+	return nil
+}
+
+// writeForSliceOfEntryForStringToInt calls write for every element of the Iterator until it fails, then closes the Iterator.
+func (i IteratorForSliceOfEntryForStringToInt) writeForSliceOfEntryForStringToInt(write func(index uint, item SliceOfEntryForStringToInt) error) error {
+	defer i.Close()
+
+	var index uint
+
+	for item := i.Next(); item.IsSome(); item = i.Next() {
+		if err := write(index, item.Unwrap()); err != nil {
+			return err
+		}
+
+		index++
+	}
+
+	return i.Err()
+}
+
+// WriteLines writes every element of the Iterator to w on its own line, formatted by format or by fmt if format is nil.
+// Each line is written as soon as its element is yielded. It returns the first write error, or else the Iterator error.
+func (i IteratorForCSVRecord) WriteLines(w io.Writer, format func(item CSVRecord) string) error {
+	if format == nil {
+		format = func(item CSVRecord) string { return fmt.Sprint(item) }
+	}
+
+	return i.writeForCSVRecord(func(index uint, item CSVRecord) error {
+		if _, err := io.WriteString(w, format(item)+"\n"); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteCSV writes every element of the Iterator to w as a CSV row built by format.
+// Each row is flushed as soon as its element is yielded. It returns the first write error, or else the Iterator error.
+func (i IteratorForCSVRecord) WriteCSV(w io.Writer, format func(item CSVRecord) []string) error {
+	writer := csv.NewWriter(w)
+
+	return i.writeForCSVRecord(func(index uint, item CSVRecord) error {
+		if err := writer.Write(format(item)); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteNDJSON writes every element of the Iterator to w as one JSON value per line.
+// Each line is written as soon as its element is yielded. It returns the first encoding or write error, or else the Iterator error.
+func (i IteratorForCSVRecord) WriteNDJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+
+	return i.writeForCSVRecord(func(index uint, item CSVRecord) error {
+		if err := encoder.Encode(item); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteJSONArray writes the elements of the Iterator to w as a single JSON array.
+// Each value is written as soon as its element is yielded, so the array is only valid once WriteJSONArray returned nil.
+// It returns the first encoding or write error, or else the Iterator error.
+func (i IteratorForCSVRecord) WriteJSONArray(w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		i.Close()
+		return fmt.Errorf("opening array: %w", err)
+	}
+
+	if err := i.writeForCSVRecord(func(index uint, item CSVRecord) error {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return fmt.Errorf("encoding element %d: %w", index, err)
+		}
+
+		if index > 0 {
+			data = append([]byte(","), data...)
+		}
+
+		if _, err := w.Write(data); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, "]\n"); err != nil {
+		return fmt.Errorf("closing array: %w", err)
+	}
+
+	return nil
+}
+
+// writeForCSVRecord calls write for every element of the Iterator until it fails, then closes the Iterator.
+func (i IteratorForCSVRecord) writeForCSVRecord(write func(index uint, item CSVRecord) error) error {
+	defer i.Close()
+
+	var index uint
+
+	for item := i.Next(); item.IsSome(); item = i.Next() {
+		if err := write(index, item.Unwrap()); err != nil {
+			return err
+		}
+
+		index++
+	}
+
+	return i.Err()
+}
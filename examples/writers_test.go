@@ -0,0 +1,157 @@
+package iter
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+var errWrite = errors.New("write failed")
+
+// failingWriter accepts at most limit bytes before failing.
+type failingWriter struct {
+	limit int
+}
+
+func (f *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > f.limit {
+		n := f.limit
+		f.limit = 0
+
+		return n, errWrite
+	}
+
+	f.limit -= len(p)
+
+	return len(p), nil
+}
+
+func TestWriteLines(t *testing.T) {
+	testCases := map[string]string{
+		"fmt":    "1\n2\n3\n",
+		"format": "0x1\n0x2\n0x3\n",
+	}
+
+	formats := map[string]func(int) string{
+		"fmt":    nil,
+		"format": func(item int) string { return "0x" + strconv.FormatInt(int64(item), 16) },
+	}
+
+	for name, want := range testCases {
+		var buffer bytes.Buffer
+
+		err := VectorOfInt([]int{1, 2, 3}).WriteLines(&buffer, formats[name])
+
+		if got := buffer.String(); got != want || err != nil {
+			t.Errorf("case: %s; got: (%q, %v); expected: (%q, %v)", name, got, err, want, nil)
+		}
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buffer bytes.Buffer
+
+	records := []CSVRecord{{"name": "ada", "age": "36"}, {"name": "grace, rear admiral", "age": "85"}}
+	err := VectorOfCSVRecord(records).WriteCSV(&buffer, func(record CSVRecord) []string {
+		return []string{record["name"], record["age"]}
+	})
+
+	want := "ada,36\n\"grace, rear admiral\",85\n"
+	if got := buffer.String(); got != want || err != nil {
+		t.Errorf("got: (%q, %v); expected: (%q, %v)", got, err, want, nil)
+	}
+}
+
+func TestWriteNDJSON(t *testing.T) {
+	testCases := map[IteratorForInt]string{
+		VectorOfInt([]int{}):        "",
+		VectorOfInt([]int{4}):       "4\n",
+		VectorOfInt([]int{1, 2, 3}): "1\n2\n3\n",
+	}
+
+	for iter, want := range testCases {
+		var buffer bytes.Buffer
+
+		err := iter.WriteNDJSON(&buffer)
+
+		if got := buffer.String(); got != want || err != nil {
+			t.Errorf("case: %v; got: (%q, %v); expected: (%q, %v)", iter, got, err, want, nil)
+		}
+	}
+}
+
+func TestWriteJSONArray(t *testing.T) {
+	testCases := map[IteratorForInt]string{
+		VectorOfInt([]int{}):        "[]\n",
+		VectorOfInt([]int{4}):       "[4]\n",
+		VectorOfInt([]int{1, 2, 3}): "[1,2,3]\n",
+	}
+
+	for iter, want := range testCases {
+		var buffer bytes.Buffer
+
+		err := iter.WriteJSONArray(&buffer)
+
+		if got := buffer.String(); got != want || err != nil {
+			t.Errorf("case: %v; got: (%q, %v); expected: (%q, %v)", iter, got, err, want, nil)
+		}
+	}
+}
+
+func TestWriteJSONArrayRoundTrip(t *testing.T) {
+	var buffer bytes.Buffer
+
+	want := []string{"a", "b\"c", ""}
+	if err := VectorOfString(want).WriteJSONArray(&buffer); err != nil {
+		t.Fatalf("got: %v; expected: %v", err, nil)
+	}
+
+	got, err := JSONArrayForString(&buffer).TryCollect()
+	if !reflect.DeepEqual(got, want) || err != nil {
+		t.Errorf("got: (%q, %v); expected: (%q, %v)", got, err, want, nil)
+	}
+}
+
+func TestWriteError(t *testing.T) {
+	testCases := map[string]func(IteratorForInt, io.Writer) error{
+		"lines": func(iter IteratorForInt, w io.Writer) error { return iter.WriteLines(w, nil) },
+		"csv": func(iter IteratorForInt, w io.Writer) error {
+			return iter.WriteCSV(w, func(item int) []string { return []string{strconv.Itoa(item)} })
+		},
+		"ndjson": IteratorForInt.WriteNDJSON,
+		"array":  IteratorForInt.WriteJSONArray,
+	}
+
+	for name, write := range testCases {
+		source := &closeableInts{items: []int{1, 2, 3, 4}}
+		iter := FromIterableInt(source)
+
+		err := write(iter, &failingWriter{limit: 5})
+
+		if !errors.Is(err, errWrite) || !strings.Contains(err.Error(), "element 2") {
+			t.Errorf("case: %s; got: %v; expected: %v on element 2", name, err, errWrite)
+		}
+
+		if source.closes != 1 {
+			t.Errorf("case: %s; got: %d closes; expected: %d", name, source.closes, 1)
+		}
+	}
+}
+
+func TestWriteSourceError(t *testing.T) {
+	errRead := errors.New("read failed")
+
+	var buffer bytes.Buffer
+
+	err := LinesOf(io.MultiReader(strings.NewReader("a\nb\n"), iotest.ErrReader(errRead))).WriteNDJSON(&buffer)
+
+	want := "\"a\"\n\"b\"\n"
+	if got := buffer.String(); got != want || err != errRead {
+		t.Errorf("got: (%q, %v); expected: (%q, %v)", got, err, want, errRead)
+	}
+}
@@ -1,5 +1,5 @@
 module github.com/juliendoutre/go-iter
 
-go 1.15
+go 1.23
 
 require github.com/cheekybits/genny v1.0.0
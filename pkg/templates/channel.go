@@ -0,0 +1,70 @@
+package templates
+
+import "sync"
+
+// FromChannelElement builds an Iterator receiving its elements from a channel.
+// The Iterator ends when the channel is closed.
+func FromChannelElement(channel <-chan Element) IteratorForElement {
+	return IteratorForElement{
+		iter: &channelForElement{channel: channel},
+	}
+}
+
+type channelForElement struct {
+	channel <-chan Element
+}
+
+func (c *channelForElement) Next() OptionForElement {
+	item, ok := <-c.channel
+	if !ok {
+		return NoneElement()
+	}
+
+	return SomeElement(item)
+}
+
+func (c *channelForElement) SizeHint() (uint, OptionForUint) {
+	return uint(len(c.channel)), NoneUint()
+}
+
+var _ IterableForElement = &channelForElement{}
+
+// IntoChannel sends the elements of the Iterator to a channel from a new goroutine.
+// The channel and the Iterator are closed once it is exhausted or the returned cancel function is called.
+// Consumers stopping before the end must call cancel so that the goroutine does not leak.
+func (i IteratorForElement) IntoChannel(buffer int) (<-chan Element, func()) {
+	channel := make(chan Element, buffer)
+	done := make(chan Empty)
+
+	go func() {
+		defer close(channel)
+		defer i.Close()
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			item := i.Next()
+			if item.IsNone() {
+				return
+			}
+
+			select {
+			case channel <- item.Unwrap():
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+
+	return channel, func() {
+		once.Do(func() {
+			close(done)
+		})
+	}
+}
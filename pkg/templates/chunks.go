@@ -0,0 +1,249 @@
+package templates
+
+// SliceOfElement is a batch of Elements yielded by Chunks and Windows.
+type SliceOfElement []Element
+
+// Chunks returns a new Iterator yielding non-overlapping chunks of n elements.
+// The last chunk may be shorter. Chunks of a Vector are sub-slices of it.
+func (i IteratorForElement) Chunks(n uint) IteratorForSliceOfElement {
+	if n == 0 {
+		panic("Called `Chunks` with a zero size.")
+	}
+
+	return IteratorForSliceOfElement{iter: &chunksForElement{iter: i.iter, size: n, exact: false, flag: false}}
+}
+
+// ChunksExact returns a new Iterator yielding non-overlapping chunks of exactly n elements.
+// The elements left over are available through Remainder.
+func (i IteratorForElement) ChunksExact(n uint) ChunksExactForElement {
+	if n == 0 {
+		panic("Called `ChunksExact` with a zero size.")
+	}
+
+	chunks := &chunksForElement{iter: i.iter, size: n, exact: true, flag: false}
+
+	return ChunksExactForElement{IteratorForSliceOfElement: IteratorForSliceOfElement{iter: chunks}, chunks: chunks}
+}
+
+// Windows returns a new Iterator yielding overlapping windows of n elements.
+// Windows of a Vector are sub-slices of it.
+func (i IteratorForElement) Windows(n uint) IteratorForSliceOfElement {
+	if n == 0 {
+		panic("Called `Windows` with a zero size.")
+	}
+
+	return IteratorForSliceOfElement{iter: &windowsForElement{iter: i.iter, size: n, flag: false}}
+}
+
+// ChunksExactForElement is an Iterator over chunks of exactly n elements.
+type ChunksExactForElement struct {
+	IteratorForSliceOfElement
+	chunks *chunksForElement
+}
+
+// Remainder returns the last elements which did not fit in a chunk.
+// Unless the chunks are taken from a Vector, it is only known once they are all consumed.
+func (c ChunksExactForElement) Remainder() SliceOfElement {
+	if v, ok := c.chunks.iter.(*vectorForElement); ok && !c.chunks.flag {
+		remaining := uint(len(v.slice)) - v.cursor
+		return SliceOfElement(v.slice[uint(len(v.slice))-remaining%c.chunks.size : len(v.slice) : len(v.slice)])
+	}
+
+	return c.chunks.remainder
+}
+
+type chunksForElement struct {
+	iter      IterableForElement
+	size      uint
+	exact     bool
+	remainder SliceOfElement
+	flag      bool
+}
+
+func (c *chunksForElement) Next() OptionForSliceOfElement {
+	if c.flag {
+		return NoneSliceOfElement()
+	}
+
+	if v, ok := c.iter.(*vectorForElement); ok {
+		return c.nextFromVector(v)
+	}
+
+	chunk := make(SliceOfElement, 0, c.size)
+	for uint(len(chunk)) < c.size {
+		item := c.iter.Next()
+		if item.IsNone() {
+			break
+		}
+
+		chunk = append(chunk, item.Unwrap())
+	}
+
+	if uint(len(chunk)) < c.size {
+		c.flag = true
+
+		if c.exact {
+			c.remainder = chunk
+			return NoneSliceOfElement()
+		}
+
+		if len(chunk) == 0 {
+			return NoneSliceOfElement()
+		}
+	}
+
+	return SomeSliceOfElement(chunk)
+}
+
+func (c *chunksForElement) nextFromVector(v *vectorForElement) OptionForSliceOfElement {
+	start := v.cursor
+	remaining := uint(len(v.slice)) - start
+
+	if remaining < c.size {
+		c.flag = true
+		v.cursor = uint(len(v.slice))
+
+		if c.exact {
+			c.remainder = SliceOfElement(v.slice[start:len(v.slice):len(v.slice)])
+			return NoneSliceOfElement()
+		}
+
+		if remaining == 0 {
+			return NoneSliceOfElement()
+		}
+
+		return SomeSliceOfElement(SliceOfElement(v.slice[start:len(v.slice):len(v.slice)]))
+	}
+
+	v.cursor += c.size
+
+	return SomeSliceOfElement(SliceOfElement(v.slice[start:v.cursor:v.cursor]))
+}
+
+func (c *chunksForElement) SizeHint() (uint, OptionForUint) {
+	if c.flag {
+		return 0, SomeUint(0)
+	}
+
+	lower, upper := c.iter.SizeHint()
+	if upper.IsNone() {
+		return c.count(lower), NoneUint()
+	}
+
+	return c.count(lower), SomeUint(c.count(upper.Unwrap()))
+}
+
+func (c *chunksForElement) Err() error {
+	return errOf(c.iter)
+}
+
+func (c *chunksForElement) Close() error {
+	return closeOf(c.iter)
+}
+
+func (c *chunksForElement) count(n uint) uint {
+	count := n / c.size
+	if !c.exact && n%c.size != 0 {
+		count++
+	}
+
+	return count
+}
+
+var _ IterableForSliceOfElement = &chunksForElement{}
+
+type windowsForElement struct {
+	iter   IterableForElement
+	size   uint
+	window SliceOfElement
+	flag   bool
+}
+
+func (w *windowsForElement) Next() OptionForSliceOfElement {
+	if w.flag {
+		return NoneSliceOfElement()
+	}
+
+	if v, ok := w.iter.(*vectorForElement); ok {
+		return w.nextFromVector(v)
+	}
+
+	if w.window == nil {
+		window := make(SliceOfElement, 0, w.size)
+		for uint(len(window)) < w.size {
+			item := w.iter.Next()
+			if item.IsNone() {
+				w.flag = true
+				return NoneSliceOfElement()
+			}
+
+			window = append(window, item.Unwrap())
+		}
+
+		w.window = window
+
+		return SomeSliceOfElement(window)
+	}
+
+	item := w.iter.Next()
+	if item.IsNone() {
+		w.flag = true
+		return NoneSliceOfElement()
+	}
+
+	window := make(SliceOfElement, w.size)
+	copy(window, w.window[1:])
+	window[w.size-1] = item.Unwrap()
+	w.window = window
+
+	return SomeSliceOfElement(window)
+}
+
+func (w *windowsForElement) nextFromVector(v *vectorForElement) OptionForSliceOfElement {
+	start := v.cursor
+	if uint(len(v.slice))-start < w.size {
+		w.flag = true
+		v.cursor = uint(len(v.slice))
+
+		return NoneSliceOfElement()
+	}
+
+	v.cursor++
+
+	return SomeSliceOfElement(SliceOfElement(v.slice[start : start+w.size : start+w.size]))
+}
+
+func (w *windowsForElement) SizeHint() (uint, OptionForUint) {
+	if w.flag {
+		return 0, SomeUint(0)
+	}
+
+	lower, upper := w.iter.SizeHint()
+	if upper.IsNone() {
+		return w.count(lower), NoneUint()
+	}
+
+	return w.count(lower), SomeUint(w.count(upper.Unwrap()))
+}
+
+func (w *windowsForElement) Err() error {
+	return errOf(w.iter)
+}
+
+func (w *windowsForElement) Close() error {
+	return closeOf(w.iter)
+}
+
+func (w *windowsForElement) count(n uint) uint {
+	if w.window != nil {
+		return n
+	}
+
+	if n < w.size {
+		return 0
+	}
+
+	return n - w.size + 1
+}
+
+var _ IterableForSliceOfElement = &windowsForElement{}
@@ -0,0 +1,38 @@
+package templates
+
+// ToMapByMapKey returns a map indexing the elements of the Iterator by the key computed for each of them.
+// When several elements share a key, the last one is kept.
+func (i IteratorForElement) ToMapByMapKey(key func(item Element) MapKey) map[MapKey]Element {
+	lower, _ := i.SizeHint()
+	collected := make(map[MapKey]Element, lower)
+
+	i.ForEach(func(item Element) {
+		collected[key(item)] = item
+	})
+
+	return collected
+}
+
+// GroupByMapKey returns a map grouping the elements of the Iterator by the key computed for each of them.
+// The elements of a group keep the order in which they were yielded.
+func (i IteratorForElement) GroupByMapKey(key func(item Element) MapKey) map[MapKey][]Element {
+	groups := map[MapKey][]Element{}
+
+	i.ForEach(func(item Element) {
+		k := key(item)
+		groups[k] = append(groups[k], item)
+	})
+
+	return groups
+}
+
+// CountByMapKey returns a map counting the elements of the Iterator by the key computed for each of them.
+func (i IteratorForElement) CountByMapKey(key func(item Element) MapKey) map[MapKey]uint {
+	counts := map[MapKey]uint{}
+
+	i.ForEach(func(item Element) {
+		counts[key(item)]++
+	})
+
+	return counts
+}
@@ -0,0 +1,21 @@
+package templates
+
+// CollectSet returns a set containing the distinct elements of the Iterator.
+func (i IteratorForElement) CollectSet() map[Element]struct{} {
+	lower, _ := i.SizeHint()
+	set := make(map[Element]struct{}, lower)
+
+	i.ForEach(func(item Element) {
+		set[item] = struct{}{}
+	})
+
+	return set
+}
+
+// Eq checks if the Iterator yields the same elements as other, in the same order.
+// Both are consumed up to their first difference, then closed.
+func (i IteratorForElement) Eq(other IteratorForElement) bool {
+	return i.EqBy(other, func(a, b Element) bool {
+		return a == b
+	})
+}
@@ -0,0 +1,71 @@
+package templates
+
+import "context"
+
+// WithContext returns a new Iterator which stops yielding elements once ctx is done.
+// ctx is checked before each element, so a blocking Next is not interrupted.
+// The Iterator Err method then returns the context error.
+func (i IteratorForElement) WithContext(ctx context.Context) IteratorForElement {
+	return IteratorForElement{iter: &contextForElement{iter: i.iter, ctx: ctx, err: nil}}
+}
+
+// ForEachContext runs a callback for every element of the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForElement) ForEachContext(ctx context.Context, callback func(item Element)) error {
+	iter := i.WithContext(ctx)
+	iter.ForEach(callback)
+
+	return iter.Err()
+}
+
+// CollectContext returns a slice containing the elements of the Iterator yielded before ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForElement) CollectContext(ctx context.Context) ([]Element, error) {
+	iter := i.WithContext(ctx)
+	collected := iter.Collect()
+
+	return collected, iter.Err()
+}
+
+type contextForElement struct {
+	iter IterableForElement
+	ctx  context.Context
+	err  error
+}
+
+func (c *contextForElement) Next() OptionForElement {
+	if c.err != nil {
+		return NoneElement()
+	}
+
+	if err := c.ctx.Err(); err != nil {
+		c.err = err
+		return NoneElement()
+	}
+
+	return c.iter.Next()
+}
+
+func (c *contextForElement) SizeHint() (uint, OptionForUint) {
+	if c.err != nil {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := c.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (c *contextForElement) Err() error {
+	if c.err != nil {
+		return c.err
+	}
+
+	return errOf(c.iter)
+}
+
+func (c *contextForElement) Close() error {
+	return closeOf(c.iter)
+}
+
+var _ IterableForElement = &contextForElement{}
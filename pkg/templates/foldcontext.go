@@ -0,0 +1,12 @@
+package templates
+
+import "context"
+
+// FoldForAccumulatorContext applies a reducer to the Iterator until ctx is done.
+// It returns the context error if it stopped early.
+func (i IteratorForElement) FoldForAccumulatorContext(ctx context.Context, init Accumulator, reducer func(acc Accumulator, item Element) Accumulator) (Accumulator, error) {
+	iter := i.WithContext(ctx)
+	acc := iter.FoldForAccumulator(init, reducer)
+
+	return acc, iter.Err()
+}
@@ -7,6 +7,8 @@ type Accumulator generic.Type
 
 // FoldForAccumulator applies a reducer to the Iterator.
 func (i IteratorForElement) FoldForAccumulator(init Accumulator, reducer func(acc Accumulator, item Element) Accumulator) Accumulator {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -21,6 +23,8 @@ func (i IteratorForElement) FoldForAccumulator(init Accumulator, reducer func(ac
 
 // TryFoldForAccumulator folds over the Iterator and stops if it reaches the end of the Iterator or got a Break (bool, interface{}).
 func (i IteratorForElement) TryFoldForAccumulator(init Accumulator, reducer func(acc Accumulator, item Element) (Accumulator, bool)) (Accumulator, bool) {
+	defer i.Close()
+
 	acc := init
 
 	item := i.Next()
@@ -36,3 +40,29 @@ func (i IteratorForElement) TryFoldForAccumulator(init Accumulator, reducer func
 
 	return acc, true
 }
+
+// TryFoldForAccumulator applies a reducer to the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the reducer.
+func (t TryIteratorForElement) TryFoldForAccumulator(init Accumulator, reducer func(acc Accumulator, item Element) (Accumulator, error)) (Accumulator, error) {
+	defer t.Close()
+
+	acc := init
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return acc, result.Err()
+		}
+
+		r, err := reducer(acc, result.Unwrap())
+		if err != nil {
+			return r, err
+		}
+
+		acc = r
+		item = t.Next()
+	}
+
+	return acc, nil
+}
@@ -1,13 +1,41 @@
 package templates
 
-import "github.com/cheekybits/genny/generic"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cheekybits/genny/generic"
+)
 
 // Element is the type of the elements in Iterators.
 type Element generic.Type
 
 // IterableForElement describes a struct that can be iterated over.
+// Iterables holding resources can also implement io.Closer: adapters pass Close through to their sources,
+// and terminal operations close them once exhausted or short-circuited.
 type IterableForElement interface {
 	Next() OptionForElement
+	SizeHint() (lower uint, upper OptionForUint)
+}
+
+// CloneableForElement is optionally implemented by Iterables which can be copied along with their progress.
+type CloneableForElement interface {
+	Clone() (IterableForElement, bool)
+}
+
+// ResettableForElement is optionally implemented by Iterables which can rewind to their first element.
+type ResettableForElement interface {
+	Reset()
+}
+
+// SinkForElement describes a container which elements can be pushed to, such as a buffer or a ring.
+type SinkForElement interface {
+	Push(item Element)
+}
+
+// splittableForElement describes an Iterable which can be split into independent parts.
+type splittableForElement interface {
+	split(parts uint) []IterableForElement
 }
 
 // IteratorForElement embeds an Iterable and provides util functions for it.
@@ -23,6 +51,44 @@ func (i IteratorForElement) Next() OptionForElement {
 	return i.iter.Next()
 }
 
+// SizeHint returns the bounds on the number of elements left in the Iterator.
+// The upper bound is None if it is unknown or does not fit in a uint.
+func (i IteratorForElement) SizeHint() (lower uint, upper OptionForUint) {
+	return i.iter.SizeHint()
+}
+
+// Err returns the error which ended the Iterator early, if any.
+func (i IteratorForElement) Err() error {
+	return errOf(i.iter)
+}
+
+// Close releases the resources held by the Iterator sources, if any.
+func (i IteratorForElement) Close() error {
+	return closeOf(i.iter)
+}
+
+// Clone returns a copy of the Iterator which can be advanced independently from it.
+// It returns false if the Iterator is not only built on Cloneable Iterables.
+func (i IteratorForElement) Clone() (IteratorForElement, bool) {
+	clone, ok := cloneOfElement(i.iter)
+	if !ok {
+		return i, false
+	}
+
+	return IteratorForElement{iter: clone}, true
+}
+
+// Reset rewinds the Iterator to its first element.
+// It returns false if the Iterator is not directly built on a Resettable source such as a Vector or a Range.
+func (i IteratorForElement) Reset() bool {
+	r, ok := i.iter.(ResettableForElement)
+	if ok {
+		r.Reset()
+	}
+
+	return ok
+}
+
 // AdvanceBy calls Next n times.
 // It returns an error if it reached the end of the Iterator
 // before it finished to iterate.
@@ -49,31 +115,104 @@ func (i IteratorForElement) Skip(n uint) IteratorForElement {
 }
 
 // Collect returns a slice containing the elements of the Iterator.
+// The slice is preallocated with the lower bound of the Iterator SizeHint.
 func (i IteratorForElement) Collect() []Element {
-	collected := []Element{}
+	lower, _ := i.SizeHint()
+
+	return i.AppendTo(make([]Element, 0, lower))
+}
+
+// AppendTo appends the elements of the Iterator to dst and returns the extended slice.
+// dst is only reallocated if its capacity is lower than required by the lower bound of the Iterator SizeHint,
+// so that a buffer can be reused with AppendTo(buffer[:0]).
+func (i IteratorForElement) AppendTo(dst []Element) []Element {
+	defer i.Close()
+
+	if lower, _ := i.SizeHint(); uint(cap(dst)-len(dst)) < lower {
+		grown := make([]Element, len(dst), uint(len(dst))+lower)
+		copy(grown, dst)
+		dst = grown
+	}
 
 	item := i.Next()
 	for item.IsSome() {
-		collected = append(collected, item.Unwrap())
+		dst = append(dst, item.Unwrap())
 
 		item = i.Next()
 	}
 
-	return collected
+	return dst
+}
+
+// Extend pushes every element of the Iterator to sink.
+func (i IteratorForElement) Extend(sink SinkForElement) {
+	i.ForEach(sink.Push)
+}
+
+// Partition returns two slices containing the elements of the Iterator that validate a predicate and the others.
+func (i IteratorForElement) Partition(predicate func(item Element) bool) ([]Element, []Element) {
+	matching, others := []Element{}, []Element{}
+
+	i.ForEach(func(item Element) {
+		if predicate(item) {
+			matching = append(matching, item)
+		} else {
+			others = append(others, item)
+		}
+	})
+
+	return matching, others
+}
+
+// JoinWith concatenates the elements of the Iterator formatted by format, or by fmt if format is nil, separated by sep.
+// The result is preallocated for the lower bound of the Iterator SizeHint.
+func (i IteratorForElement) JoinWith(sep string, format func(item Element) string) string {
+	if format == nil {
+		format = func(item Element) string { return fmt.Sprint(item) }
+	}
+
+	var builder strings.Builder
+	if lower, _ := i.SizeHint(); lower > 0 {
+		builder.Grow(int(lower) * (len(sep) + 1))
+	}
+
+	return i.joinInto(&builder, sep, format)
+}
+
+// joinInto writes the elements of the Iterator formatted by format and separated by sep to builder, then closes the Iterator.
+func (i IteratorForElement) joinInto(builder *strings.Builder, sep string, format func(item Element) string) string {
+	defer i.Close()
+
+	for item, first := i.Next(), true; item.IsSome(); item, first = i.Next(), false {
+		if !first {
+			builder.WriteString(sep)
+		}
+
+		builder.WriteString(format(item.Unwrap()))
+	}
+
+	return builder.String()
 }
 
 // FoldFirst folds over the Iterator, using its first element as the accumulator initial value.
 func (i IteratorForElement) FoldFirst(reducer func(acc, item Element) Element) OptionForElement {
 	first := i.Next()
 	if first.IsNone() {
+		i.Close()
 		return NoneElement()
 	}
 
 	return SomeElement(i.FoldForElement(first.Unwrap(), reducer))
 }
 
-// Count returns the number of elements in the Iterator.
+// Count consumes the Iterator and returns the number of elements it yielded.
+// Vectors and Ranges are counted without iterating.
 func (i IteratorForElement) Count() uint {
+	if d, ok := i.iter.(drainable); ok {
+		defer i.Close()
+		return d.drain()
+	}
+
 	return i.FoldForUint(uint(0), func(acc uint, item Element) uint {
 		return acc + 1
 	})
@@ -140,13 +279,97 @@ func (i IteratorForElement) Position(predicate func(item Element) bool) OptionFo
 	return SomeUint(r)
 }
 
+// find returns the next element validating a predicate, without closing the Iterator.
+func (i IteratorForElement) find(predicate func(item Element) bool) OptionForElement {
+	item := i.Next()
+	for item.IsSome() {
+		if predicate(item.Unwrap()) {
+			return item
+		}
+
+		item = i.Next()
+	}
+
+	return item
+}
+
 // SkipWhile skips the next elements until it reaches one which validates predicate.
 func (i IteratorForElement) SkipWhile(predicate func(item Element) bool) IteratorForElement {
-	i.Find(predicate)
+	i.find(predicate)
 
 	return i
 }
 
+// EqBy checks if the Iterator yields elements equal to those of other according to eq, in the same order.
+// Both are consumed up to their first difference, then closed.
+func (i IteratorForElement) EqBy(other IteratorForElement, eq func(a, b Element) bool) bool {
+	defer i.Close()
+	defer other.Close()
+
+	lower, upper := i.SizeHint()
+	otherLower, otherUpper := other.SizeHint()
+
+	if (upper.IsSome() && upper.Unwrap() < otherLower) || (otherUpper.IsSome() && otherUpper.Unwrap() < lower) {
+		return false
+	}
+
+	for {
+		a, b := i.Next(), other.Next()
+		if a.IsNone() || b.IsNone() {
+			return a.IsNone() && b.IsNone()
+		}
+
+		if !eq(a.Unwrap(), b.Unwrap()) {
+			return false
+		}
+	}
+}
+
+// Cmp compares lexicographically the elements of the Iterator with those of other according to cmp,
+// which returns a negative number, zero or a positive number when its first argument is smaller, equal or greater.
+// An Iterator ending before the other is smaller. Both are consumed up to their first difference, then closed.
+func (i IteratorForElement) Cmp(other IteratorForElement, cmp func(a, b Element) int) int {
+	defer i.Close()
+	defer other.Close()
+
+	for {
+		a, b := i.Next(), other.Next()
+
+		switch {
+		case a.IsNone() && b.IsNone():
+			return 0
+		case a.IsNone():
+			return -1
+		case b.IsNone():
+			return 1
+		}
+
+		if c := cmp(a.Unwrap(), b.Unwrap()); c != 0 {
+			return c
+		}
+	}
+}
+
+// Lt checks if the Iterator is lexicographically smaller than other according to cmp.
+func (i IteratorForElement) Lt(other IteratorForElement, cmp func(a, b Element) int) bool {
+	return i.Cmp(other, cmp) < 0
+}
+
+// Le checks if the Iterator is lexicographically smaller than or equal to other according to cmp.
+func (i IteratorForElement) Le(other IteratorForElement, cmp func(a, b Element) int) bool {
+	return i.Cmp(other, cmp) <= 0
+}
+
+// Gt checks if the Iterator is lexicographically greater than other according to cmp.
+func (i IteratorForElement) Gt(other IteratorForElement, cmp func(a, b Element) int) bool {
+	return i.Cmp(other, cmp) > 0
+}
+
+// Ge checks if the Iterator is lexicographically greater than or equal to other according to cmp.
+func (i IteratorForElement) Ge(other IteratorForElement, cmp func(a, b Element) int) bool {
+	return i.Cmp(other, cmp) >= 0
+}
+
 // Map returns a new Iterator applying a mapper function to every element.
 func (i IteratorForElement) Map(mapper func(item Element) Element) IteratorForElement {
 	return IteratorForElement{iter: &mapIterableForElement{mapper: mapper, iter: i.iter}}
@@ -157,6 +380,38 @@ func (i IteratorForElement) Chain(iter IteratorForElement) IteratorForElement {
 	return IteratorForElement{iter: &chainForElement{first: i.iter, second: iter.iter, flag: false}}
 }
 
+// Cycle returns a new Iterator endlessly repeating the elements of the Iterator.
+// The elements are buffered during the first pass, unless the Iterator is a Vector.
+func (i IteratorForElement) Cycle() IteratorForElement {
+	if v, ok := i.iter.(*vectorForElement); ok {
+		buffer := v.slice[v.cursor:]
+		v.cursor = uint(len(v.slice))
+
+		return IteratorForElement{iter: &cycleForElement{iter: v, buffer: buffer, cursor: 0, flag: true}}
+	}
+
+	return IteratorForElement{iter: &cycleForElement{iter: i.iter, buffer: []Element{}, cursor: 0, flag: false}}
+}
+
+// Tee returns n Iterators each yielding all the elements of the Iterator.
+// Elements are buffered until every one of the n Iterators has yielded them.
+// The Iterator it was built on must not be used directly anymore. It is closed right away if n is zero.
+func (i IteratorForElement) Tee(n uint) []IteratorForElement {
+	if n == 0 {
+		i.Close()
+		return []IteratorForElement{}
+	}
+
+	buffer := &teeBufferForElement{iter: i.iter, items: []Element{}, offset: 0, positions: make([]uint, n), open: n, flag: false}
+
+	iterators := make([]IteratorForElement, n)
+	for k := range iterators {
+		iterators[k] = IteratorForElement{iter: &teeForElement{buffer: buffer, branch: uint(k), closed: false}}
+	}
+
+	return iterators
+}
+
 // TakeWhile returns a new Iterator yielding elements until predicate becomes false.
 func (i IteratorForElement) TakeWhile(predicate func(item Element) bool) IteratorForElement {
 	return IteratorForElement{iter: &takeWhileForElement{iter: i.iter, predicate: predicate, flag: false}}
@@ -1,5 +1,15 @@
 package templates
 
+// cloneOfElement clones an Iterable if it is Cloneable.
+func cloneOfElement(iter IterableForElement) (IterableForElement, bool) {
+	c, ok := iter.(CloneableForElement)
+	if !ok {
+		return nil, false
+	}
+
+	return c.Clone()
+}
+
 type mapIterableForElement struct {
 	iter   IterableForElement
 	mapper func(item Element) Element
@@ -14,6 +24,27 @@ func (m *mapIterableForElement) Next() OptionForElement {
 	return SomeElement(m.mapper(item.Unwrap()))
 }
 
+func (m *mapIterableForElement) SizeHint() (uint, OptionForUint) {
+	return m.iter.SizeHint()
+}
+
+func (m *mapIterableForElement) Clone() (IterableForElement, bool) {
+	iter, ok := cloneOfElement(m.iter)
+	if !ok {
+		return nil, false
+	}
+
+	return &mapIterableForElement{iter: iter, mapper: m.mapper}, true
+}
+
+func (m *mapIterableForElement) Err() error {
+	return errOf(m.iter)
+}
+
+func (m *mapIterableForElement) Close() error {
+	return closeOf(m.iter)
+}
+
 var _ IterableForElement = &mapIterableForElement{}
 
 type chainForElement struct {
@@ -36,8 +67,249 @@ func (c *chainForElement) Next() OptionForElement {
 	return item
 }
 
+func (c *chainForElement) SizeHint() (uint, OptionForUint) {
+	secondLower, secondUpper := c.second.SizeHint()
+	if c.flag {
+		return secondLower, secondUpper
+	}
+
+	firstLower, firstUpper := c.first.SizeHint()
+
+	lower := firstLower + secondLower
+	if lower < firstLower {
+		lower = ^uint(0)
+	}
+
+	if firstUpper.IsNone() || secondUpper.IsNone() {
+		return lower, NoneUint()
+	}
+
+	upper := firstUpper.Unwrap() + secondUpper.Unwrap()
+	if upper < firstUpper.Unwrap() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(upper)
+}
+
+func (c *chainForElement) Clone() (IterableForElement, bool) {
+	first, ok := cloneOfElement(c.first)
+	if !ok {
+		return nil, false
+	}
+
+	second, ok := cloneOfElement(c.second)
+	if !ok {
+		return nil, false
+	}
+
+	return &chainForElement{first: first, second: second, flag: c.flag}, true
+}
+
+func (c *chainForElement) Err() error {
+	if err := errOf(c.first); err != nil {
+		return err
+	}
+
+	return errOf(c.second)
+}
+
+func (c *chainForElement) Close() error {
+	firstErr := closeOf(c.first)
+	if err := closeOf(c.second); firstErr == nil {
+		return err
+	}
+
+	return firstErr
+}
+
 var _ IterableForElement = &chainForElement{}
 
+type cycleForElement struct {
+	iter   IterableForElement
+	buffer []Element
+	cursor uint
+	flag   bool
+}
+
+func (c *cycleForElement) Next() OptionForElement {
+	if !c.flag {
+		item := c.iter.Next()
+		if item.IsSome() {
+			c.buffer = append(c.buffer, item.Unwrap())
+			return item
+		}
+
+		c.flag = true
+	}
+
+	if len(c.buffer) == 0 {
+		return NoneElement()
+	}
+
+	item := c.buffer[c.cursor]
+	c.cursor = (c.cursor + 1) % uint(len(c.buffer))
+
+	return SomeElement(item)
+}
+
+func (c *cycleForElement) SizeHint() (uint, OptionForUint) {
+	if len(c.buffer) > 0 {
+		return ^uint(0), NoneUint()
+	}
+
+	if c.flag {
+		return 0, SomeUint(0)
+	}
+
+	lower, upper := c.iter.SizeHint()
+	if lower > 0 {
+		return ^uint(0), NoneUint()
+	}
+
+	if upper.IsSome() && upper.Unwrap() == 0 {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (c *cycleForElement) Err() error {
+	return errOf(c.iter)
+}
+
+func (c *cycleForElement) Close() error {
+	return closeOf(c.iter)
+}
+
+var _ IterableForElement = &cycleForElement{}
+
+type teeBufferForElement struct {
+	iter      IterableForElement
+	items     []Element
+	offset    uint
+	positions []uint
+	open      uint
+	flag      bool
+}
+
+func (b *teeBufferForElement) next(branch uint) OptionForElement {
+	index := b.positions[branch] - b.offset
+	if index >= uint(len(b.items)) {
+		if b.flag {
+			return NoneElement()
+		}
+
+		item := b.iter.Next()
+		if item.IsNone() {
+			b.flag = true
+			return NoneElement()
+		}
+
+		b.items = append(b.items, item.Unwrap())
+	}
+
+	item := b.items[index]
+	b.positions[branch]++
+	b.trim()
+
+	return SomeElement(item)
+}
+
+// trim drops the buffered elements which every branch has yielded.
+func (b *teeBufferForElement) trim() {
+	slowest := b.positions[0]
+	for _, position := range b.positions[1:] {
+		if position < slowest {
+			slowest = position
+		}
+	}
+
+	if end := b.offset + uint(len(b.items)); slowest > end {
+		slowest = end
+	}
+
+	if slowest == b.offset {
+		return
+	}
+
+	if drop := slowest - b.offset; drop == uint(len(b.items)) {
+		b.items = b.items[:0]
+	} else {
+		b.items = b.items[drop:]
+	}
+
+	b.offset = slowest
+}
+
+// close releases a branch and closes the source once every branch is closed.
+func (b *teeBufferForElement) close(branch uint) error {
+	b.positions[branch] = ^uint(0)
+	b.trim()
+
+	b.open--
+	if b.open == 0 {
+		return closeOf(b.iter)
+	}
+
+	return nil
+}
+
+type teeForElement struct {
+	buffer *teeBufferForElement
+	branch uint
+	closed bool
+}
+
+func (t *teeForElement) Next() OptionForElement {
+	if t.closed {
+		return NoneElement()
+	}
+
+	return t.buffer.next(t.branch)
+}
+
+func (t *teeForElement) SizeHint() (uint, OptionForUint) {
+	if t.closed {
+		return 0, SomeUint(0)
+	}
+
+	buffered := t.buffer.offset + uint(len(t.buffer.items)) - t.buffer.positions[t.branch]
+	if t.buffer.flag {
+		return buffered, SomeUint(buffered)
+	}
+
+	lower, upper := t.buffer.iter.SizeHint()
+
+	if lower+buffered < lower {
+		lower = ^uint(0)
+	} else {
+		lower += buffered
+	}
+
+	if upper.IsNone() || upper.Unwrap()+buffered < upper.Unwrap() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(upper.Unwrap() + buffered)
+}
+
+func (t *teeForElement) Err() error {
+	return errOf(t.buffer.iter)
+}
+
+func (t *teeForElement) Close() error {
+	if t.closed {
+		return nil
+	}
+
+	t.closed = true
+
+	return t.buffer.close(t.branch)
+}
+
+var _ IterableForElement = &teeForElement{}
+
 // PairForElement is a 2-tuple.
 type PairForElement struct {
 	First  Element
@@ -69,6 +341,24 @@ func (t *takeWhileForElement) Next() OptionForElement {
 	return item
 }
 
+func (t *takeWhileForElement) SizeHint() (uint, OptionForUint) {
+	if t.flag {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := t.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (t *takeWhileForElement) Err() error {
+	return errOf(t.iter)
+}
+
+func (t *takeWhileForElement) Close() error {
+	return closeOf(t.iter)
+}
+
 var _ IterableForElement = &takeWhileForElement{}
 
 type takeForElement struct {
@@ -83,13 +373,13 @@ func (t *takeForElement) Next() OptionForElement {
 		return NoneElement()
 	}
 
-	item := t.iter.Next()
-	if item.IsNone() {
+	if t.count >= t.max {
 		t.flag = true
 		return NoneElement()
 	}
 
-	if t.count >= t.max {
+	item := t.iter.Next()
+	if item.IsNone() {
 		t.flag = true
 		return NoneElement()
 	}
@@ -99,6 +389,42 @@ func (t *takeForElement) Next() OptionForElement {
 	return item
 }
 
+func (t *takeForElement) SizeHint() (uint, OptionForUint) {
+	if t.flag {
+		return 0, SomeUint(0)
+	}
+
+	remaining := t.max - t.count
+
+	lower, upper := t.iter.SizeHint()
+	if lower > remaining {
+		lower = remaining
+	}
+
+	if upper.IsSome() && upper.Unwrap() < remaining {
+		return lower, upper
+	}
+
+	return lower, SomeUint(remaining)
+}
+
+func (t *takeForElement) Clone() (IterableForElement, bool) {
+	iter, ok := cloneOfElement(t.iter)
+	if !ok {
+		return nil, false
+	}
+
+	return &takeForElement{iter: iter, max: t.max, count: t.count, flag: t.flag}, true
+}
+
+func (t *takeForElement) Err() error {
+	return errOf(t.iter)
+}
+
+func (t *takeForElement) Close() error {
+	return closeOf(t.iter)
+}
+
 var _ IterableForElement = &takeForElement{}
 
 type filterForElement struct {
@@ -107,7 +433,30 @@ type filterForElement struct {
 }
 
 func (f *filterForElement) Next() OptionForElement {
-	return f.iter.Find(f.predicate)
+	return f.iter.find(f.predicate)
+}
+
+func (f *filterForElement) SizeHint() (uint, OptionForUint) {
+	_, upper := f.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (f *filterForElement) Clone() (IterableForElement, bool) {
+	iter, ok := f.iter.Clone()
+	if !ok {
+		return nil, false
+	}
+
+	return &filterForElement{iter: iter, predicate: f.predicate}, true
+}
+
+func (f *filterForElement) Err() error {
+	return f.iter.Err()
+}
+
+func (f *filterForElement) Close() error {
+	return f.iter.Close()
 }
 
 var _ IterableForElement = &filterForElement{}
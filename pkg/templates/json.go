@@ -0,0 +1,120 @@
+package templates
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// NDJSONForElement builds a fallible Iterator decoding one JSON value per line of r into an Element.
+// r is not closed. The Iterator stops at the first error.
+func NDJSONForElement(r io.Reader) TryIteratorForElement {
+	return TryIteratorForElement{
+		iter: &ndjsonForElement{decoder: json.NewDecoder(r), index: 0, flag: false},
+	}
+}
+
+type ndjsonForElement struct {
+	decoder *json.Decoder
+	index   uint
+	flag    bool
+}
+
+func (n *ndjsonForElement) Next() OptionForResultForElement {
+	if n.flag {
+		return NoneResultForElement()
+	}
+
+	var value Element
+	if err := n.decoder.Decode(&value); err != nil {
+		n.flag = true
+
+		if err == io.EOF {
+			return NoneResultForElement()
+		}
+
+		return SomeResultForElement(ErrElement(fmt.Errorf("decoding value %d: %w", n.index, err)))
+	}
+
+	n.index++
+
+	return SomeResultForElement(OkElement(value))
+}
+
+func (n *ndjsonForElement) SizeHint() (uint, OptionForUint) {
+	if n.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+var _ TryIterableForElement = &ndjsonForElement{}
+
+// JSONArrayForElement builds a fallible Iterator decoding the values of the JSON array read from r one at a time.
+// r is not closed. The Iterator stops at the first error.
+func JSONArrayForElement(r io.Reader) TryIteratorForElement {
+	return JSONArrayAtForElement(r, "")
+}
+
+// JSONArrayAtForElement builds a fallible Iterator decoding the values of a JSON array nested in the document read from r.
+// The array is located by a JSON pointer such as "/data/items", the empty pointer designating the document itself.
+// Members preceding the array are decoded and discarded. r is not closed. The Iterator stops at the first error.
+func JSONArrayAtForElement(r io.Reader, pointer string) TryIteratorForElement {
+	return TryIteratorForElement{
+		iter: &jsonArrayForElement{decoder: json.NewDecoder(r), pointer: pointer, index: 0, started: false, flag: false},
+	}
+}
+
+type jsonArrayForElement struct {
+	decoder *json.Decoder
+	pointer string
+	index   uint
+	started bool
+	flag    bool
+}
+
+func (j *jsonArrayForElement) Next() OptionForResultForElement {
+	if j.flag {
+		return NoneResultForElement()
+	}
+
+	if !j.started {
+		j.started = true
+
+		if err := seekJSONArray(j.decoder, j.pointer); err != nil {
+			j.flag = true
+			return SomeResultForElement(ErrElement(err))
+		}
+	}
+
+	if !j.decoder.More() {
+		j.flag = true
+
+		if _, err := j.decoder.Token(); err != nil {
+			return SomeResultForElement(ErrElement(fmt.Errorf("closing array at %q: %w", j.pointer, err)))
+		}
+
+		return NoneResultForElement()
+	}
+
+	var value Element
+	if err := j.decoder.Decode(&value); err != nil {
+		j.flag = true
+		return SomeResultForElement(ErrElement(fmt.Errorf("decoding value %d of array at %q: %w", j.index, j.pointer, err)))
+	}
+
+	j.index++
+
+	return SomeResultForElement(OkElement(value))
+}
+
+func (j *jsonArrayForElement) SizeHint() (uint, OptionForUint) {
+	if j.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+var _ TryIterableForElement = &jsonArrayForElement{}
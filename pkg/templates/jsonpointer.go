@@ -0,0 +1,82 @@
+package templates
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// seekJSONArray advances a Decoder past the opening bracket of the array located by a JSON pointer.
+func seekJSONArray(decoder *json.Decoder, pointer string) error {
+	if pointer != "" && !strings.HasPrefix(pointer, "/") {
+		return fmt.Errorf("invalid JSON pointer %q", pointer)
+	}
+
+	segments := []string{}
+	if pointer != "" {
+		for _, segment := range strings.Split(pointer[1:], "/") {
+			segments = append(segments, strings.NewReplacer("~1", "/", "~0", "~").Replace(segment))
+		}
+	}
+
+	for _, segment := range segments {
+		if err := seekJSONMember(decoder, segment); err != nil {
+			return fmt.Errorf("seeking %q: %w", pointer, err)
+		}
+	}
+
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("seeking %q: %w", pointer, err)
+	}
+
+	if token != json.Delim('[') {
+		return fmt.Errorf("seeking %q: found %v instead of an array", pointer, token)
+	}
+
+	return nil
+}
+
+// seekJSONMember advances a Decoder to the value of an object member or an array item.
+func seekJSONMember(decoder *json.Decoder, segment string) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	switch token {
+	case json.Delim('{'):
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return err
+			}
+
+			if key == segment {
+				return nil
+			}
+
+			if err := decoder.Decode(&json.RawMessage{}); err != nil {
+				return err
+			}
+		}
+	case json.Delim('['):
+		index, err := strconv.Atoi(segment)
+		if err != nil {
+			return fmt.Errorf("invalid array index %q", segment)
+		}
+
+		for k := 0; decoder.More(); k++ {
+			if k == index {
+				return nil
+			}
+
+			if err := decoder.Decode(&json.RawMessage{}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return fmt.Errorf("%q not found", segment)
+}
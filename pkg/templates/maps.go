@@ -0,0 +1,100 @@
+package templates
+
+import (
+	"sort"
+
+	"github.com/cheekybits/genny/generic"
+)
+
+// MapKey is the key type of the map to iterate over.
+type MapKey generic.Type
+
+// MapValue is the value type of the map to iterate over.
+type MapValue generic.Type
+
+// EntryForMapKeyToMapValue is a key-value pair of a map.
+type EntryForMapKeyToMapValue struct {
+	Key   MapKey
+	Value MapValue
+}
+
+// KeysOfMapKeyToMapValue builds an Iterator yielding the keys of m, in no particular order.
+func KeysOfMapKeyToMapValue(m map[MapKey]MapValue) IteratorForMapKey {
+	keys := make([]MapKey, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	return VectorOfMapKey(keys)
+}
+
+// ValuesOfMapKeyToMapValue builds an Iterator yielding the values of m, in no particular order.
+func ValuesOfMapKeyToMapValue(m map[MapKey]MapValue) IteratorForMapValue {
+	values := make([]MapValue, 0, len(m))
+	for _, value := range m {
+		values = append(values, value)
+	}
+
+	return VectorOfMapValue(values)
+}
+
+// EntriesOfMapKeyToMapValue builds an Iterator yielding the entries of m, in no particular order.
+func EntriesOfMapKeyToMapValue(m map[MapKey]MapValue) IteratorForEntryForMapKeyToMapValue {
+	entries := make([]EntryForMapKeyToMapValue, 0, len(m))
+	for key, value := range m {
+		entries = append(entries, EntryForMapKeyToMapValue{Key: key, Value: value})
+	}
+
+	return VectorOfEntryForMapKeyToMapValue(entries)
+}
+
+// SortedKeysOfMapKeyToMapValue builds an Iterator yielding the keys of m in the order defined by less.
+// If less is nil, keys of an integer, float or string type are yielded in ascending order, other keys require less.
+func SortedKeysOfMapKeyToMapValue(m map[MapKey]MapValue, less func(a, b MapKey) bool) IteratorForMapKey {
+	return VectorOfMapKey(sortedKeysOfMapKeyToMapValue(m, less))
+}
+
+// SortedValuesOfMapKeyToMapValue builds an Iterator yielding the values of m in the order of their keys defined by less.
+// If less is nil, keys of an integer, float or string type are sorted in ascending order, other keys require less.
+func SortedValuesOfMapKeyToMapValue(m map[MapKey]MapValue, less func(a, b MapKey) bool) IteratorForMapValue {
+	keys := sortedKeysOfMapKeyToMapValue(m, less)
+
+	values := make([]MapValue, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, m[key])
+	}
+
+	return VectorOfMapValue(values)
+}
+
+// SortedEntriesOfMapKeyToMapValue builds an Iterator yielding the entries of m in the order of their keys defined by less.
+// If less is nil, keys of an integer, float or string type are sorted in ascending order, other keys require less.
+func SortedEntriesOfMapKeyToMapValue(m map[MapKey]MapValue, less func(a, b MapKey) bool) IteratorForEntryForMapKeyToMapValue {
+	keys := sortedKeysOfMapKeyToMapValue(m, less)
+
+	entries := make([]EntryForMapKeyToMapValue, 0, len(keys))
+	for _, key := range keys {
+		entries = append(entries, EntryForMapKeyToMapValue{Key: key, Value: m[key]})
+	}
+
+	return VectorOfEntryForMapKeyToMapValue(entries)
+}
+
+func sortedKeysOfMapKeyToMapValue(m map[MapKey]MapValue, less func(a, b MapKey) bool) []MapKey {
+	if less == nil {
+		less = ascendingForMapKey
+	}
+
+	if less == nil {
+		panic("Called a sorted map source without a less function on keys which are not ordered.")
+	}
+
+	keys := make([]MapKey, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+
+	return keys
+}
@@ -0,0 +1,6 @@
+package templates
+
+// ascendingForMapKey is the default less function of sorted map sources over MapKey keys.
+var ascendingForMapKey = func(a, b MapKey) bool {
+	return a < b
+}
@@ -0,0 +1,331 @@
+package templates
+
+import "sync"
+
+// ParMap returns a new Iterator applying a mapper function to every element from a pool of goroutines.
+// Results are yielded in input order unless the Unordered option is given.
+// At most twice as many elements as workers are processed or buffered at once.
+// A panic in the mapper is propagated to the caller of Next.
+func (i IteratorForElement) ParMap(workers int, mapper func(item Element) Element, options ...ParMapOption) IteratorForElement {
+	if workers < 1 {
+		panic("Called `ParMap` with less than one worker.")
+	}
+
+	config := newParMapConfig(options)
+
+	return IteratorForElement{iter: &parMapForElement{
+		iter:    i.iter,
+		mapper:  mapper,
+		workers: workers,
+		ordered: config.ordered,
+		pending: map[uint]parMapResultForElement{},
+	}}
+}
+
+type parMapResultForElement struct {
+	index    uint
+	value    Element
+	panicked interface{}
+}
+
+type parMapForElement struct {
+	iter    IterableForElement
+	mapper  func(item Element) Element
+	workers int
+	ordered bool
+
+	tokens  chan Empty
+	results chan parMapResultForElement
+	done    chan Empty
+
+	pending map[uint]parMapResultForElement
+	index   uint
+	lower   uint
+	upper   OptionForUint
+	yielded uint
+	started bool
+	stopped bool
+	flag    bool
+}
+
+func (p *parMapForElement) Next() OptionForElement {
+	if p.flag {
+		return NoneElement()
+	}
+
+	if !p.started {
+		p.start()
+	}
+
+	for {
+		if p.ordered {
+			if result, ok := p.pending[p.index]; ok {
+				delete(p.pending, p.index)
+				p.index++
+
+				return p.yield(result)
+			}
+		}
+
+		result, ok := <-p.results
+		if !ok {
+			p.flag = true
+			return NoneElement()
+		}
+
+		if result.panicked != nil {
+			p.flag = true
+			p.stop()
+			panic(result.panicked)
+		}
+
+		if !p.ordered {
+			return p.yield(result)
+		}
+
+		p.pending[result.index] = result
+	}
+}
+
+func (p *parMapForElement) SizeHint() (uint, OptionForUint) {
+	if p.flag {
+		return 0, SomeUint(0)
+	}
+
+	if !p.started {
+		return p.iter.SizeHint()
+	}
+
+	lower := uint(0)
+	if p.lower > p.yielded {
+		lower = p.lower - p.yielded
+	}
+
+	if p.upper.IsNone() {
+		return lower, NoneUint()
+	}
+
+	return lower, SomeUint(p.upper.Unwrap() - p.yielded)
+}
+
+func (p *parMapForElement) Err() error {
+	if p.started && !p.flag {
+		return nil
+	}
+
+	return errOf(p.iter)
+}
+
+func (p *parMapForElement) Close() error {
+	p.flag = true
+
+	if p.started {
+		p.stop()
+
+		for range p.results {
+		}
+	}
+
+	return closeOf(p.iter)
+}
+
+func (p *parMapForElement) start() {
+	p.started = true
+	p.lower, p.upper = p.iter.SizeHint()
+
+	p.tokens = make(chan Empty, 2*p.workers)
+	p.results = make(chan parMapResultForElement, 2*p.workers)
+	p.done = make(chan Empty)
+
+	jobs := make(chan parMapResultForElement, p.workers)
+
+	var wg sync.WaitGroup
+	wg.Add(p.workers + 1)
+
+	go p.dispatch(jobs, &wg)
+
+	for k := 0; k < p.workers; k++ {
+		go p.work(jobs, &wg)
+	}
+
+	go func() {
+		wg.Wait()
+		close(p.results)
+	}()
+}
+
+func (p *parMapForElement) dispatch(jobs chan<- parMapResultForElement, wg *sync.WaitGroup) {
+	defer wg.Done()
+	defer close(jobs)
+
+	defer func() {
+		if r := recover(); r != nil {
+			select {
+			case p.results <- parMapResultForElement{panicked: r}:
+			case <-p.done:
+			}
+		}
+	}()
+
+	for index := uint(0); ; index++ {
+		select {
+		case p.tokens <- Empty{}:
+		case <-p.done:
+			return
+		}
+
+		item := p.iter.Next()
+		if item.IsNone() {
+			return
+		}
+
+		select {
+		case jobs <- parMapResultForElement{index: index, value: item.Unwrap()}:
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *parMapForElement) work(jobs <-chan parMapResultForElement, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	for job := range jobs {
+		select {
+		case p.results <- p.apply(job):
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *parMapForElement) apply(job parMapResultForElement) (result parMapResultForElement) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = parMapResultForElement{index: job.index, panicked: r}
+		}
+	}()
+
+	return parMapResultForElement{index: job.index, value: p.mapper(job.value)}
+}
+
+func (p *parMapForElement) yield(result parMapResultForElement) OptionForElement {
+	<-p.tokens
+	p.yielded++
+
+	return SomeElement(result.value)
+}
+
+func (p *parMapForElement) stop() {
+	if !p.stopped {
+		p.stopped = true
+		close(p.done)
+	}
+}
+
+var _ IterableForElement = &parMapForElement{}
+
+// Synchronized returns a new Iterator which can be consumed from several goroutines at once.
+// Its source is closed once, when it is exhausted or on the first call to Close, after which it yields no more elements.
+// Use Share instead if consumers may stop early while others still pull from the source.
+// The Iterator it was built on must not be used directly anymore.
+func (i IteratorForElement) Synchronized() IteratorForElement {
+	return i.Share(1)[0]
+}
+
+// Share returns n Iterators pulling from a Synchronized queue of the Iterator, one for each of n consumers.
+// Each element is yielded by only one of them. The source is closed once, when it is exhausted
+// or when all the n Iterators are closed, so that a consumer stopping early does not close it for the others.
+// The Iterator it was built on must not be used directly anymore.
+func (i IteratorForElement) Share(n uint) []IteratorForElement {
+	queue := &synchronizedQueueForElement{iter: i.iter, open: n, closed: false, err: nil}
+	if n == 0 {
+		queue.close()
+	}
+
+	iterators := make([]IteratorForElement, n)
+	for k := range iterators {
+		iterators[k] = IteratorForElement{iter: &synchronizedForElement{queue: queue, closed: false}}
+	}
+
+	return iterators
+}
+
+type synchronizedQueueForElement struct {
+	mutex  sync.Mutex
+	iter   IterableForElement
+	open   uint
+	closed bool
+	err    error
+}
+
+// close closes the source if it was not already and returns the error it got. The mutex must be held.
+func (q *synchronizedQueueForElement) close() error {
+	if !q.closed {
+		q.closed = true
+		q.err = closeOf(q.iter)
+	}
+
+	return q.err
+}
+
+type synchronizedForElement struct {
+	queue  *synchronizedQueueForElement
+	closed bool
+}
+
+func (s *synchronizedForElement) Next() OptionForElement {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed || s.queue.closed {
+		return NoneElement()
+	}
+
+	item := s.queue.iter.Next()
+	if item.IsNone() {
+		s.queue.close()
+	}
+
+	return item
+}
+
+func (s *synchronizedForElement) SizeHint() (uint, OptionForUint) {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed || s.queue.closed {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := s.queue.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (s *synchronizedForElement) Err() error {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	return errOf(s.queue.iter)
+}
+
+func (s *synchronizedForElement) Close() error {
+	s.queue.mutex.Lock()
+	defer s.queue.mutex.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	s.closed = true
+
+	s.queue.open--
+	if s.queue.open == 0 {
+		return s.queue.close()
+	}
+
+	return nil
+}
+
+var _ IterableForElement = &synchronizedForElement{}
@@ -0,0 +1,143 @@
+package templates
+
+import "sync"
+
+// ParFoldForAccumulator applies a reducer to parts of the Iterator from workers goroutines
+// and merges their results in order with combiner.
+// Each part is folded from init, which must be neutral for combiner, and combiner must be associative.
+// Vector and Range Iterators are split in place, other Iterators are buffered in chunks.
+// A panic in the reducer is propagated to the caller once every worker has stopped.
+func (i IteratorForElement) ParFoldForAccumulator(init Accumulator, reducer func(acc Accumulator, item Element) Accumulator, combiner func(left, right Accumulator) Accumulator, workers int) Accumulator {
+	if workers < 1 {
+		panic("Called `ParFold` with less than one worker.")
+	}
+
+	defer i.Close()
+
+	var partials []Accumulator
+	var panicked interface{}
+	if s, ok := i.iter.(splittableForElement); ok {
+		partials, panicked = i.parFoldSplitForAccumulator(s.split(uint(workers)), init, reducer)
+	} else {
+		partials, panicked = i.parFoldChunksForAccumulator(init, reducer, workers)
+	}
+
+	if panicked != nil {
+		panic(panicked)
+	}
+
+	if len(partials) == 0 {
+		return init
+	}
+
+	acc := partials[0]
+	for _, partial := range partials[1:] {
+		acc = combiner(acc, partial)
+	}
+
+	return acc
+}
+
+func (i IteratorForElement) parFoldSplitForAccumulator(parts []IterableForElement, init Accumulator, reducer func(acc Accumulator, item Element) Accumulator) ([]Accumulator, interface{}) {
+	partials := make([]Accumulator, len(parts))
+	panics := make([]interface{}, len(parts))
+
+	var wg sync.WaitGroup
+	wg.Add(len(parts))
+
+	for k, part := range parts {
+		go func(k int, part IterableForElement) {
+			defer wg.Done()
+			partials[k], panics[k] = i.parFoldPartForAccumulator(part, init, reducer)
+		}(k, part)
+	}
+
+	wg.Wait()
+
+	for _, panicked := range panics {
+		if panicked != nil {
+			return partials, panicked
+		}
+	}
+
+	return partials, nil
+}
+
+func (i IteratorForElement) parFoldChunksForAccumulator(init Accumulator, reducer func(acc Accumulator, item Element) Accumulator, workers int) ([]Accumulator, interface{}) {
+	type chunk struct {
+		index uint
+		items []Element
+	}
+
+	chunks := make(chan chunk, workers)
+	partials := []Accumulator{}
+
+	var panicked interface{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for k := 0; k < workers; k++ {
+		go func() {
+			defer wg.Done()
+
+			for c := range chunks {
+				partial, p := i.parFoldPartForAccumulator(VectorOfElement(c.items).iter, init, reducer)
+
+				mutex.Lock()
+				partials[c.index] = partial
+				if panicked == nil {
+					panicked = p
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for index := uint(0); ; index++ {
+		items := make([]Element, 0, parFoldChunkSize)
+		for len(items) < parFoldChunkSize {
+			item := i.Next()
+			if item.IsNone() {
+				break
+			}
+
+			items = append(items, item.Unwrap())
+		}
+
+		if len(items) == 0 {
+			break
+		}
+
+		mutex.Lock()
+		stop := panicked != nil
+		if !stop {
+			partials = append(partials, init)
+		}
+		mutex.Unlock()
+
+		if stop {
+			break
+		}
+
+		chunks <- chunk{index: index, items: items}
+
+		if len(items) < parFoldChunkSize {
+			break
+		}
+	}
+
+	close(chunks)
+	wg.Wait()
+
+	return partials, panicked
+}
+
+// parFoldPartForAccumulator folds a part of the Iterator, recovering a panic of the reducer so that ParFold can propagate it.
+func (i IteratorForElement) parFoldPartForAccumulator(part IterableForElement, init Accumulator, reducer func(acc Accumulator, item Element) Accumulator) (partial Accumulator, panicked interface{}) {
+	defer func() {
+		panicked = recover()
+	}()
+
+	return IteratorForElement{iter: part}.FoldForAccumulator(init, reducer), nil
+}
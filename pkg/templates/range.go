@@ -4,11 +4,12 @@ package templates
 // The range start is inclusive but its end is exclusive.
 func Range(start, end, step int) IteratorForInt {
 	return IteratorForInt{
-		iter: &rangeIterable{index: start, end: end, step: step},
+		iter: &rangeIterable{start: start, index: start, end: end, step: step},
 	}
 }
 
 type rangeIterable struct {
+	start int
 	index int
 	end   int
 	step  int
@@ -25,4 +26,62 @@ func (r *rangeIterable) Next() OptionForInt {
 	return SomeInt(item)
 }
 
+func (r *rangeIterable) SizeHint() (uint, OptionForUint) {
+	if (r.index-r.end)*r.step >= 0 {
+		return 0, SomeUint(0)
+	}
+
+	var remaining uint
+	if r.step > 0 {
+		remaining = uint((r.end - r.index + r.step - 1) / r.step)
+	} else {
+		remaining = uint((r.index - r.end - r.step - 1) / -r.step)
+	}
+
+	return remaining, SomeUint(remaining)
+}
+
+func (r *rangeIterable) drain() uint {
+	remaining, _ := r.SizeHint()
+	r.index += int(remaining) * r.step
+
+	return remaining
+}
+
+func (r *rangeIterable) Clone() (IterableForInt, bool) {
+	return &rangeIterable{start: r.start, index: r.index, end: r.end, step: r.step}, true
+}
+
+func (r *rangeIterable) Reset() {
+	r.index = r.start
+}
+
+// split consumes the range and returns at most parts contiguous sub-ranges.
+func (r *rangeIterable) split(parts uint) []IterableForInt {
+	remaining, _ := r.SizeHint()
+	size := (remaining + parts - 1) / parts
+
+	split := make([]IterableForInt, 0, parts)
+	for start := uint(0); start < remaining; start += size {
+		index := r.index + int(start)*r.step
+
+		end := r.end
+		if start+size < remaining {
+			end = index + int(size)*r.step
+		}
+
+		split = append(split, &rangeIterable{start: index, index: index, end: end, step: r.step})
+	}
+
+	r.index = r.end
+
+	return split
+}
+
 var _ IterableForInt = &rangeIterable{}
+
+var _ CloneableForInt = &rangeIterable{}
+
+var _ ResettableForInt = &rangeIterable{}
+
+var _ splittableForInt = &rangeIterable{}
@@ -0,0 +1,59 @@
+package templates
+
+import "fmt"
+
+// ResultForElement holds either an Element value or an error.
+type ResultForElement struct {
+	value Element
+	err   error
+}
+
+// OkElement returns a Result holding an Element value.
+func OkElement(value Element) ResultForElement {
+	return ResultForElement{value: value, err: nil}
+}
+
+// ErrElement returns a Result holding an error.
+func ErrElement(err error) ResultForElement {
+	return ResultForElement{err: err}
+}
+
+func (r ResultForElement) IsOk() bool {
+	return r.err == nil
+}
+
+func (r ResultForElement) IsErr() bool {
+	return r.err != nil
+}
+
+func (r ResultForElement) Err() error {
+	return r.err
+}
+
+func (r ResultForElement) Expect(msg string) Element {
+	if r.err != nil {
+		panic(fmt.Sprintf("%s: %v", msg, r.err))
+	}
+
+	return r.value
+}
+
+func (r ResultForElement) Unwrap() Element {
+	return r.Expect("Called `Unwrap` on an `Err` Result")
+}
+
+func (r ResultForElement) UnwrapOr(defaultValue Element) Element {
+	if r.err != nil {
+		return defaultValue
+	}
+
+	return r.value
+}
+
+func (r ResultForElement) Map(mapper func(value Element) Element) ResultForElement {
+	if r.err != nil {
+		return r
+	}
+
+	return OkElement(mapper(r.value))
+}
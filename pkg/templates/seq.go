@@ -0,0 +1,94 @@
+package templates
+
+import "iter"
+
+// Seq returns a standard library sequence yielding the elements of the Iterator.
+func (i IteratorForElement) Seq() iter.Seq[Element] {
+	return func(yield func(Element) bool) {
+		defer i.Close()
+
+		item := i.Next()
+		for item.IsSome() {
+			if !yield(item.Unwrap()) {
+				return
+			}
+
+			item = i.Next()
+		}
+	}
+}
+
+// Seq2 returns a standard library sequence yielding the elements of the Iterator along with their position.
+func (i IteratorForElement) Seq2() iter.Seq2[int, Element] {
+	return func(yield func(int, Element) bool) {
+		defer i.Close()
+
+		position := 0
+
+		item := i.Next()
+		for item.IsSome() {
+			if !yield(position, item.Unwrap()) {
+				return
+			}
+
+			position++
+			item = i.Next()
+		}
+	}
+}
+
+// FromSeqElement builds an Iterator pulling its elements from a standard library sequence.
+// The returned stop function, like the Iterator Close method, must be called if it is not consumed until its end.
+func FromSeqElement(seq iter.Seq[Element]) (IteratorForElement, func()) {
+	return FromPullElement(iter.Pull(seq))
+}
+
+// FromPullElement builds an Iterator from next and stop functions such as the ones returned by iter.Pull.
+// stop is called once the Iterator is exhausted, or when the returned function is called.
+func FromPullElement(next func() (Element, bool), stop func()) (IteratorForElement, func()) {
+	p := &pullForElement{next: next, stop: stop, flag: false}
+
+	return IteratorForElement{iter: p}, p.release
+}
+
+type pullForElement struct {
+	next func() (Element, bool)
+	stop func()
+	flag bool
+}
+
+func (p *pullForElement) Next() OptionForElement {
+	if p.flag {
+		return NoneElement()
+	}
+
+	item, ok := p.next()
+	if !ok {
+		p.release()
+		return NoneElement()
+	}
+
+	return SomeElement(item)
+}
+
+func (p *pullForElement) SizeHint() (uint, OptionForUint) {
+	if p.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (p *pullForElement) Close() error {
+	p.release()
+	return nil
+}
+
+func (p *pullForElement) release() {
+	if !p.flag {
+		p.flag = true
+		p.stop()
+	}
+}
+
+var _ IterableForElement = &pullForElement{}
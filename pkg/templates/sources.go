@@ -0,0 +1,119 @@
+package templates
+
+// RepeatElement builds an Iterator endlessly yielding the same value.
+func RepeatElement(value Element) IteratorForElement {
+	return IteratorForElement{
+		iter: &repeatForElement{value: value},
+	}
+}
+
+// RepeatNElement builds an Iterator yielding the same value n times.
+func RepeatNElement(value Element, n uint) IteratorForElement {
+	return IteratorForElement{
+		iter: &repeatNForElement{value: value, remaining: n},
+	}
+}
+
+// OnceElement builds an Iterator yielding a single value.
+func OnceElement(value Element) IteratorForElement {
+	return RepeatNElement(value, 1)
+}
+
+// EmptyElement builds an Iterator yielding nothing.
+func EmptyElement() IteratorForElement {
+	return VectorOfElement(nil)
+}
+
+// FromIterableElement builds an Iterator from any Iterable, which may also implement io.Closer.
+func FromIterableElement(iter IterableForElement) IteratorForElement {
+	return IteratorForElement{iter: iter}
+}
+
+// FromFuncElement builds an Iterator calling f to get each of its elements.
+// The Iterator ends when f returns None.
+func FromFuncElement(f func() OptionForElement) IteratorForElement {
+	return IteratorForElement{
+		iter: &fromFuncForElement{f: f},
+	}
+}
+
+// SuccessorsElement builds an Iterator starting from first and computing each next element from the previous one.
+// The Iterator ends when next returns None.
+func SuccessorsElement(first OptionForElement, next func(item Element) OptionForElement) IteratorForElement {
+	return IteratorForElement{
+		iter: &successorsForElement{item: first, next: next},
+	}
+}
+
+type repeatForElement struct {
+	value Element
+}
+
+func (r *repeatForElement) Next() OptionForElement {
+	return SomeElement(r.value)
+}
+
+func (r *repeatForElement) SizeHint() (uint, OptionForUint) {
+	return ^uint(0), NoneUint()
+}
+
+var _ IterableForElement = &repeatForElement{}
+
+type repeatNForElement struct {
+	value     Element
+	remaining uint
+}
+
+func (r *repeatNForElement) Next() OptionForElement {
+	if r.remaining == 0 {
+		return NoneElement()
+	}
+
+	r.remaining--
+
+	return SomeElement(r.value)
+}
+
+func (r *repeatNForElement) SizeHint() (uint, OptionForUint) {
+	return r.remaining, SomeUint(r.remaining)
+}
+
+var _ IterableForElement = &repeatNForElement{}
+
+type fromFuncForElement struct {
+	f func() OptionForElement
+}
+
+func (f *fromFuncForElement) Next() OptionForElement {
+	return f.f()
+}
+
+func (f *fromFuncForElement) SizeHint() (uint, OptionForUint) {
+	return 0, NoneUint()
+}
+
+var _ IterableForElement = &fromFuncForElement{}
+
+type successorsForElement struct {
+	item OptionForElement
+	next func(item Element) OptionForElement
+}
+
+func (s *successorsForElement) Next() OptionForElement {
+	item := s.item
+	if item.IsSome() {
+		s.item = s.next(item.Unwrap())
+	}
+
+	return item
+}
+
+func (s *successorsForElement) SizeHint() (uint, OptionForUint) {
+	if s.item.IsNone() {
+		return 0, SomeUint(0)
+	}
+
+	return 1, NoneUint()
+}
+
+var _ IterableForElement = &successorsForElement{}
@@ -0,0 +1,66 @@
+package templates
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// FromRowsElement builds a fallible Iterator yielding the rows of a query result, scanned one at a time into an Element.
+// The rows are closed once the Iterator is exhausted, fails or is closed. It stops at the first scan error, or else
+// yields the error of the rows, if any, as its last result.
+func FromRowsElement(rows *sql.Rows, scan func(rows *sql.Rows) (Element, error)) TryIteratorForElement {
+	return TryIteratorForElement{
+		iter: &rowsForElement{rows: rows, scan: scan, index: 0, flag: false},
+	}
+}
+
+type rowsForElement struct {
+	rows  *sql.Rows
+	scan  func(rows *sql.Rows) (Element, error)
+	index uint
+	flag  bool
+}
+
+func (r *rowsForElement) Next() OptionForResultForElement {
+	if r.flag {
+		return NoneResultForElement()
+	}
+
+	if !r.rows.Next() {
+		err := r.rows.Err()
+		if closeErr := r.Close(); err == nil {
+			err = closeErr
+		}
+
+		if err != nil {
+			return SomeResultForElement(ErrElement(fmt.Errorf("reading row %d: %w", r.index, err)))
+		}
+
+		return NoneResultForElement()
+	}
+
+	value, err := r.scan(r.rows)
+	if err != nil {
+		r.Close()
+		return SomeResultForElement(ErrElement(fmt.Errorf("scanning row %d: %w", r.index, err)))
+	}
+
+	r.index++
+
+	return SomeResultForElement(OkElement(value))
+}
+
+func (r *rowsForElement) SizeHint() (uint, OptionForUint) {
+	if r.flag {
+		return 0, SomeUint(0)
+	}
+
+	return 0, NoneUint()
+}
+
+func (r *rowsForElement) Close() error {
+	r.flag = true
+	return r.rows.Close()
+}
+
+var _ TryIterableForElement = &rowsForElement{}
@@ -0,0 +1,24 @@
+package templates
+
+import "strings"
+
+// Join concatenates the elements of the Iterator, separated by sep.
+// A Vector is joined with a single allocation, other Iterators are preallocated for the lower bound of their SizeHint.
+func (i IteratorForString) Join(sep string) string {
+	var builder strings.Builder
+
+	if v, ok := i.iter.(*vectorForString); ok && v.cursor < uint(len(v.slice)) {
+		remaining := v.slice[v.cursor:]
+
+		size := len(sep) * (len(remaining) - 1)
+		for _, item := range remaining {
+			size += len(item)
+		}
+
+		builder.Grow(size)
+	} else if lower, _ := i.SizeHint(); lower > 0 {
+		builder.Grow(int(lower) * len(sep))
+	}
+
+	return i.joinInto(&builder, sep, func(item string) string { return item })
+}
@@ -0,0 +1,140 @@
+package templates
+
+// TryIterableForElement describes a struct that can be iterated over and may fail.
+type TryIterableForElement interface {
+	Next() OptionForResultForElement
+	SizeHint() (lower uint, upper OptionForUint)
+}
+
+// TryIteratorForElement embeds a TryIterable and provides util functions for it.
+type TryIteratorForElement struct {
+	iter TryIterableForElement
+}
+
+// TryIterator implements TryIterable.
+var _ TryIterableForElement = TryIteratorForElement{}
+
+// FromTryIterableElement builds a fallible Iterator from any TryIterable, which may also implement io.Closer.
+func FromTryIterableElement(iter TryIterableForElement) TryIteratorForElement {
+	return TryIteratorForElement{iter: iter}
+}
+
+// FromTryFuncElement builds a fallible Iterator calling f to get each of its results.
+// The Iterator ends when f returns None.
+func FromTryFuncElement(f func() OptionForResultForElement) TryIteratorForElement {
+	return TryIteratorForElement{
+		iter: &tryFromFuncForElement{f: f},
+	}
+}
+
+// Next returns the next result of the TryIterator.
+func (t TryIteratorForElement) Next() OptionForResultForElement {
+	return t.iter.Next()
+}
+
+// SizeHint returns the bounds on the number of results left in the TryIterator.
+func (t TryIteratorForElement) SizeHint() (lower uint, upper OptionForUint) {
+	return t.iter.SizeHint()
+}
+
+// Close releases the resources held by the TryIterator sources, if any.
+func (t TryIteratorForElement) Close() error {
+	return closeOf(t.iter)
+}
+
+// TryCollect returns a slice containing the elements of the TryIterator.
+// It stops at the first error and returns it along with the elements collected so far.
+func (t TryIteratorForElement) TryCollect() ([]Element, error) {
+	defer t.Close()
+
+	lower, _ := t.SizeHint()
+	collected := make([]Element, 0, lower)
+
+	item := t.Next()
+	for item.IsSome() {
+		result := item.Unwrap()
+		if result.IsErr() {
+			return collected, result.Err()
+		}
+
+		collected = append(collected, result.Unwrap())
+
+		item = t.Next()
+	}
+
+	return collected, nil
+}
+
+// TryForEach runs a callback for every element of the TryIterator.
+// It stops at the first error, whether it comes from the TryIterator or the callback.
+func (t TryIteratorForElement) TryForEach(callback func(item Element) error) error {
+	_, err := t.TryFoldForEmpty(Empty{}, func(acc Empty, item Element) (Empty, error) {
+		return acc, callback(item)
+	})
+
+	return err
+}
+
+// Unwrapped returns an Iterator yielding the elements of the TryIterator until its first error.
+// The error is then available through the Iterator Err method.
+func (t TryIteratorForElement) Unwrapped() IteratorForElement {
+	return IteratorForElement{iter: &unwrapForElement{iter: t.iter, err: nil}}
+}
+
+type tryFromFuncForElement struct {
+	f func() OptionForResultForElement
+}
+
+func (f *tryFromFuncForElement) Next() OptionForResultForElement {
+	return f.f()
+}
+
+func (f *tryFromFuncForElement) SizeHint() (uint, OptionForUint) {
+	return 0, NoneUint()
+}
+
+var _ TryIterableForElement = &tryFromFuncForElement{}
+
+type unwrapForElement struct {
+	iter TryIterableForElement
+	err  error
+}
+
+func (u *unwrapForElement) Next() OptionForElement {
+	if u.err != nil {
+		return NoneElement()
+	}
+
+	item := u.iter.Next()
+	if item.IsNone() {
+		return NoneElement()
+	}
+
+	result := item.Unwrap()
+	if result.IsErr() {
+		u.err = result.Err()
+		return NoneElement()
+	}
+
+	return SomeElement(result.Unwrap())
+}
+
+func (u *unwrapForElement) SizeHint() (uint, OptionForUint) {
+	if u.err != nil {
+		return 0, SomeUint(0)
+	}
+
+	_, upper := u.iter.SizeHint()
+
+	return 0, upper
+}
+
+func (u *unwrapForElement) Err() error {
+	return u.err
+}
+
+func (u *unwrapForElement) Close() error {
+	return closeOf(u.iter)
+}
+
+var _ IterableForElement = &unwrapForElement{}
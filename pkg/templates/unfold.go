@@ -0,0 +1,9 @@
+package templates
+
+// UnfoldElementWithAccumulator builds an Iterator from a state and a function computing each element from it.
+// The function can update the state through its pointer. The Iterator ends when it returns None.
+func UnfoldElementWithAccumulator(init Accumulator, f func(state *Accumulator) OptionForElement) IteratorForElement {
+	return FromFuncElement(func() OptionForElement {
+		return f(&init)
+	})
+}
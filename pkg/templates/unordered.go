@@ -0,0 +1,4 @@
+package templates
+
+// ascendingForMapKey is nil as MapKey is not an ordered type, so sorted map sources over its keys require a less function.
+var ascendingForMapKey func(a, b MapKey) bool
@@ -23,4 +23,52 @@ func (v *vectorForElement) Next() OptionForElement {
 	return SomeElement(item)
 }
 
+func (v *vectorForElement) SizeHint() (uint, OptionForUint) {
+	remaining := uint(len(v.slice)) - v.cursor
+
+	return remaining, SomeUint(remaining)
+}
+
+func (v *vectorForElement) drain() uint {
+	remaining := uint(len(v.slice)) - v.cursor
+	v.cursor = uint(len(v.slice))
+
+	return remaining
+}
+
+func (v *vectorForElement) Clone() (IterableForElement, bool) {
+	return &vectorForElement{slice: v.slice, cursor: v.cursor}, true
+}
+
+func (v *vectorForElement) Reset() {
+	v.cursor = 0
+}
+
+// split consumes the Vector and returns at most parts Vectors sharing its slice.
+func (v *vectorForElement) split(parts uint) []IterableForElement {
+	slice := v.slice[v.cursor:]
+	v.cursor = uint(len(v.slice))
+
+	length := uint(len(slice))
+	size := (length + parts - 1) / parts
+
+	split := make([]IterableForElement, 0, parts)
+	for start := uint(0); start < length; start += size {
+		end := start + size
+		if end > length {
+			end = length
+		}
+
+		split = append(split, &vectorForElement{slice: slice[start:end], cursor: 0})
+	}
+
+	return split
+}
+
 var _ IterableForElement = &vectorForElement{}
+
+var _ CloneableForElement = &vectorForElement{}
+
+var _ ResettableForElement = &vectorForElement{}
+
+var _ splittableForElement = &vectorForElement{}
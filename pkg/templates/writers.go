@@ -0,0 +1,109 @@
+package templates
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// WriteLines writes every element of the Iterator to w on its own line, formatted by format or by fmt if format is nil.
+// Each line is written as soon as its element is yielded. It returns the first write error, or else the Iterator error.
+func (i IteratorForElement) WriteLines(w io.Writer, format func(item Element) string) error {
+	if format == nil {
+		format = func(item Element) string { return fmt.Sprint(item) }
+	}
+
+	return i.writeForElement(func(index uint, item Element) error {
+		if _, err := io.WriteString(w, format(item)+"\n"); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteCSV writes every element of the Iterator to w as a CSV row built by format.
+// Each row is flushed as soon as its element is yielded. It returns the first write error, or else the Iterator error.
+func (i IteratorForElement) WriteCSV(w io.Writer, format func(item Element) []string) error {
+	writer := csv.NewWriter(w)
+
+	return i.writeForElement(func(index uint, item Element) error {
+		if err := writer.Write(format(item)); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteNDJSON writes every element of the Iterator to w as one JSON value per line.
+// Each line is written as soon as its element is yielded. It returns the first encoding or write error, or else the Iterator error.
+func (i IteratorForElement) WriteNDJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+
+	return i.writeForElement(func(index uint, item Element) error {
+		if err := encoder.Encode(item); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	})
+}
+
+// WriteJSONArray writes the elements of the Iterator to w as a single JSON array.
+// Each value is written as soon as its element is yielded, so the array is only valid once WriteJSONArray returned nil.
+// It returns the first encoding or write error, or else the Iterator error.
+func (i IteratorForElement) WriteJSONArray(w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		i.Close()
+		return fmt.Errorf("opening array: %w", err)
+	}
+
+	if err := i.writeForElement(func(index uint, item Element) error {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return fmt.Errorf("encoding element %d: %w", index, err)
+		}
+
+		if index > 0 {
+			data = append([]byte(","), data...)
+		}
+
+		if _, err := w.Write(data); err != nil {
+			return fmt.Errorf("writing element %d: %w", index, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, "]\n"); err != nil {
+		return fmt.Errorf("closing array: %w", err)
+	}
+
+	return nil
+}
+
+// writeForElement calls write for every element of the Iterator until it fails, then closes the Iterator.
+func (i IteratorForElement) writeForElement(write func(index uint, item Element) error) error {
+	defer i.Close()
+
+	var index uint
+
+	for item := i.Next(); item.IsSome(); item = i.Next() {
+		if err := write(index, item.Unwrap()); err != nil {
+			return err
+		}
+
+		index++
+	}
+
+	return i.Err()
+}